@@ -0,0 +1,205 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package accesslog provides structured access logging with per-resource sampling
+// and redaction, for environments that need access records without a full audit pipeline.
+package accesslog
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/endpoints/responsewriter"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/klog/v2"
+)
+
+// Redacted replaces redacted values.
+const Redacted = "REDACTED"
+
+// Record is the access log record of a single request.
+type Record struct {
+	Method    string
+	Path      string
+	Query     url.Values
+	User      string
+	Groups    []string
+	Verb      string
+	Resource  schema.GroupResource
+	Namespace string
+	Name      string
+	Code      int
+	Latency   time.Duration
+	UserAgent string
+	SourceIP  string
+}
+
+// Config configures access logging.
+type Config struct {
+	// SampleRate is the fraction of requests logged, between 0 and 1, for resources without
+	// an entry in ResourceSampleRates. Non-resource requests such as /healthz use it as well.
+	SampleRate float64
+	// ResourceSampleRates overrides SampleRate per resource.
+	ResourceSampleRates map[schema.GroupResource]float64
+	// AlwaysLogErrors logs every request answered with a status code of 400 or above, regardless of sampling.
+	AlwaysLogErrors bool
+	// RedactQueryParameters lists query parameters whose values are replaced by Redacted.
+	RedactQueryParameters []string
+	// Redact is called for every logged record and may remove further sensitive information.
+	Redact func(*Record)
+	// Logger receives the records. Defaults to the klog logger named "accesslog".
+	Logger logr.Logger
+}
+
+func (c *Config) sampleRate(gr schema.GroupResource) float64 {
+	if rate, ok := c.ResourceSampleRates[gr]; ok {
+		return rate
+	}
+
+	return c.SampleRate
+}
+
+// BuildHandlerChainFunc returns a handler chain builder that wraps the chain built by delegate
+// with access logging.
+func BuildHandlerChainFunc(c Config, delegate func(http.Handler, *genericapiserver.Config) http.Handler) func(http.Handler, *genericapiserver.Config) http.Handler {
+	return func(apiHandler http.Handler, config *genericapiserver.Config) http.Handler {
+		return WithAccessLog(delegate(withUserCapture(apiHandler), config), config.RequestInfoResolver, c)
+	}
+}
+
+type userHolderKey struct{}
+
+// userHolder transports the authenticated user from the inner end of the handler chain
+// to the access log filter, which runs before authentication.
+type userHolder struct {
+	mu     sync.Mutex
+	name   string
+	groups []string
+}
+
+func (h *userHolder) set(name string, groups []string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.name, h.groups = name, groups
+}
+
+func (h *userHolder) get() (string, []string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.name, h.groups
+}
+
+// withUserCapture records the authenticated user for WithAccessLog. It must run after authentication.
+func withUserCapture(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		holder, ok := req.Context().Value(userHolderKey{}).(*userHolder)
+		if u, found := request.UserFrom(req.Context()); ok && found {
+			holder.set(u.GetName(), u.GetGroups())
+		}
+		handler.ServeHTTP(w, req)
+	})
+}
+
+// WithAccessLog logs sampled requests handled by handler. Users are only known if the
+// inner handler chain is wrapped as done by BuildHandlerChainFunc.
+func WithAccessLog(handler http.Handler, resolver request.RequestInfoResolver, c Config) http.Handler {
+	logger := c.Logger
+	if logger.GetSink() == nil {
+		logger = klog.Background().WithName("accesslog")
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		start := time.Now()
+		holder := &userHolder{}
+		req = req.WithContext(context.WithValue(req.Context(), userHolderKey{}, holder))
+		rw := &statusRecorder{ResponseWriter: w, code: http.StatusOK}
+
+		handler.ServeHTTP(responsewriter.WrapForHTTP1Or2(rw), req)
+
+		userName, groups := holder.get()
+		record := Record{
+			Method:    req.Method,
+			Path:      req.URL.Path,
+			Query:     req.URL.Query(),
+			User:      userName,
+			Groups:    groups,
+			Code:      rw.code,
+			Latency:   time.Since(start),
+			UserAgent: req.UserAgent(),
+		}
+		if ip := utilnet.GetClientIP(req); ip != nil {
+			record.SourceIP = ip.String()
+		}
+		if resolver != nil {
+			if info, err := resolver.NewRequestInfo(req); err == nil {
+				record.Verb = info.Verb
+				record.Resource = schema.GroupResource{Group: info.APIGroup, Resource: info.Resource}
+				record.Namespace = info.Namespace
+				record.Name = info.Name
+			}
+		}
+
+		sampled := rand.Float64() < c.sampleRate(record.Resource) //nolint:gosec // sampling only
+		if !sampled && (!c.AlwaysLogErrors || record.Code < http.StatusBadRequest) {
+			return
+		}
+		for _, param := range c.RedactQueryParameters {
+			if record.Query.Has(param) {
+				record.Query.Set(param, Redacted)
+			}
+		}
+		if c.Redact != nil {
+			c.Redact(&record)
+		}
+		logger.Info("Request",
+			"method", record.Method,
+			"path", record.Path,
+			"query", record.Query.Encode(),
+			"user", record.User,
+			"groups", record.Groups,
+			"verb", record.Verb,
+			"resource", record.Resource.String(),
+			"namespace", record.Namespace,
+			"name", record.Name,
+			"code", record.Code,
+			"latency", record.Latency,
+			"userAgent", record.UserAgent,
+			"sourceIP", record.SourceIP,
+		)
+	})
+}
+
+// statusRecorder records the status code written to the response.
+type statusRecorder struct {
+	http.ResponseWriter
+	code        int
+	wroteHeader bool
+}
+
+var _ responsewriter.UserProvidedDecorator = &statusRecorder{}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	if !r.wroteHeader {
+		r.code = code
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	return r.ResponseWriter.Write(b)
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package accesslog
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-logr/logr/funcr"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WithAccessLog", func() {
+	var (
+		lines    []string
+		resolver = &request.RequestInfoFactory{
+			APIPrefixes:          sets.NewString("apis"),
+			GrouplessAPIPrefixes: sets.NewString(),
+		}
+		bars = schema.GroupResource{Group: "foo.opendefense.cloud", Resource: "bars"}
+	)
+
+	// handler emulates the generic handler chain: authentication happens between
+	// the access log filter and the user capture.
+	handler := func(c Config, code int) http.Handler {
+		c.Logger = funcr.New(func(_, args string) { lines = append(lines, args) }, funcr.Options{})
+		inner := withUserCapture(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(code)
+		}))
+		authn := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			ctx := request.WithUser(req.Context(), &user.DefaultInfo{Name: "alice", Groups: []string{"devs"}})
+			inner.ServeHTTP(w, req.WithContext(ctx))
+		})
+
+		return WithAccessLog(authn, resolver, c)
+	}
+
+	serve := func(h http.Handler, target string) int {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+
+		return rec.Code
+	}
+
+	BeforeEach(func() {
+		lines = nil
+	})
+
+	It("should log request details", func() {
+		Expect(serve(handler(Config{SampleRate: 1}, http.StatusOK), "/apis/foo.opendefense.cloud/v1alpha1/namespaces/ns/bars/b1")).To(Equal(http.StatusOK))
+		Expect(lines).To(HaveLen(1))
+		Expect(lines[0]).To(ContainSubstring(`"method"="GET"`))
+		Expect(lines[0]).To(ContainSubstring(`"user"="alice"`))
+		Expect(lines[0]).To(ContainSubstring(`"verb"="get"`))
+		Expect(lines[0]).To(ContainSubstring(`"resource"="bars.foo.opendefense.cloud"`))
+		Expect(lines[0]).To(ContainSubstring(`"namespace"="ns"`))
+		Expect(lines[0]).To(ContainSubstring(`"name"="b1"`))
+		Expect(lines[0]).To(ContainSubstring(`"code"=200`))
+	})
+
+	It("should sample per resource", func() {
+		h := handler(Config{SampleRate: 1, ResourceSampleRates: map[schema.GroupResource]float64{bars: 0}}, http.StatusOK)
+		serve(h, "/apis/foo.opendefense.cloud/v1alpha1/namespaces/ns/bars")
+		Expect(lines).To(BeEmpty())
+		serve(h, "/apis/foo.opendefense.cloud/v1alpha1/clusterbars")
+		Expect(lines).To(HaveLen(1))
+	})
+
+	It("should log errors regardless of sampling if requested", func() {
+		serve(handler(Config{SampleRate: 0}, http.StatusForbidden), "/apis/foo.opendefense.cloud/v1alpha1/clusterbars")
+		Expect(lines).To(BeEmpty())
+		serve(handler(Config{SampleRate: 0, AlwaysLogErrors: true}, http.StatusForbidden), "/apis/foo.opendefense.cloud/v1alpha1/clusterbars")
+		Expect(lines).To(HaveLen(1))
+		Expect(lines[0]).To(ContainSubstring(`"code"=403`))
+	})
+
+	It("should redact sensitive information", func() {
+		h := handler(Config{
+			SampleRate:            1,
+			RedactQueryParameters: []string{"token"},
+			Redact:                func(e *Record) { e.User = Redacted },
+		}, http.StatusOK)
+		serve(h, "/healthz?token=secret&verbose=true")
+		Expect(lines).To(HaveLen(1))
+		Expect(lines[0]).NotTo(ContainSubstring("secret"))
+		Expect(lines[0]).NotTo(ContainSubstring("alice"))
+		Expect(lines[0]).To(ContainSubstring(`"query"="token=REDACTED&verbose=true"`))
+	})
+})
@@ -0,0 +1,68 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package apitest checks the types of API servers built with the kit, e.g. in unit tests of
+// API packages, so conversions and defaults are verified without running a server.
+package apitest
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	"k8s.io/apimachinery/pkg/api/apitesting/roundtrip"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"go.opendefense.cloud/kit/apiserver"
+)
+
+// RoundTrip runs the round-trip fuzz tests of k8s.io/apimachinery for the kinds and list kinds of
+// resources, typically Builder.Resources, so every registered group version is covered:
+//
+//	func TestRoundTrip(t *testing.T) {
+//	    apitest.RoundTrip(t, scheme, newBuilder(scheme).Resources(), fuzzer.Funcs)
+//	}
+//
+// Kinds with an internal version are converted to every served version and back, all other
+// kinds are encoded and decoded in their version. Defaulting fuzzed objects of every served
+// version must be idempotent, see VerifyDefaultingIdempotent.
+// Objects are defaulted when they are decoded, so funcs have to fill the defaulted fields. The
+// fuzzer functions of metav1 types are added to funcs.
+func RoundTrip(t *testing.T, scheme *runtime.Scheme, resources []apiserver.ResourceInfo, funcs fuzzer.FuzzerFuncs) {
+	t.Helper()
+	codecs := serializer.NewCodecFactory(scheme)
+	seed := time.Now().UnixNano()
+	t.Logf("fuzzing with seed %d", seed)
+	filler := fuzzer.FuzzerFor(fuzzer.MergeFuzzerFuncs(metafuzzer.Funcs, funcs), rand.NewSource(seed), codecs)
+
+	tested := sets.New[schema.GroupVersionKind]()
+	for _, r := range resources {
+		if r.Kind == "" {
+			t.Errorf("resource %s.%s/%s has no kind registered in the scheme", r.Resource, r.Group, r.Version)
+			continue
+		}
+		for _, kind := range []string{r.Kind, r.Kind + "List"} {
+			gvk := schema.GroupVersionKind{Group: r.Group, Version: r.Version, Kind: kind}
+			if !scheme.Recognizes(gvk) || tested.Has(gvk) {
+				continue
+			}
+			tested.Insert(gvk)
+			t.Run(gvk.String(), func(t *testing.T) {
+				internal := schema.GroupVersionKind{Group: gvk.Group, Version: runtime.APIVersionInternal, Kind: kind}
+				if scheme.Recognizes(internal) {
+					roundtrip.RoundTripSpecificKindWithoutProtobuf(t, internal, scheme, codecs, filler, nil)
+				} else {
+					roundtrip.RoundTripSpecificKindWithoutProtobuf(t, gvk, scheme, codecs, filler, nil)
+				}
+				if err := verifyDefaultingIdempotent(scheme, gvk, filler); err != nil {
+					t.Error(err)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apitest
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"go.opendefense.cloud/kit/apiserver"
+	"go.opendefense.cloud/kit/apiserver/kitapi"
+)
+
+var gv = schema.GroupVersion{Group: "test.opendefense.cloud", Version: "v1"}
+
+// newScheme returns a scheme serving PartialObjectMetadata in gv, which is defaulted by fn.
+func newScheme(fn func(*metav1.PartialObjectMetadata)) *runtime.Scheme {
+	scheme, _ := kitapi.NewScheme(func(s *runtime.Scheme) {
+		kitapi.AddExternalTypes(s, gv, &metav1.PartialObjectMetadata{}, &metav1.PartialObjectMetadataList{})
+		if fn != nil {
+			s.AddTypeDefaultingFunc(&metav1.PartialObjectMetadata{}, func(obj any) { fn(obj.(*metav1.PartialObjectMetadata)) })
+		}
+	})
+
+	return scheme
+}
+
+// setLabel defaults a label idempotently.
+func setLabel(obj *metav1.PartialObjectMetadata) {
+	if obj.Labels == nil {
+		obj.Labels = map[string]string{}
+	}
+	obj.Labels["defaulted"] = "true"
+}
+
+// appendLabel changes a label on every defaulting.
+func appendLabel(obj *metav1.PartialObjectMetadata) {
+	if obj.Labels == nil {
+		obj.Labels = map[string]string{}
+	}
+	obj.Labels["defaulted"] += "x"
+}
+
+func TestRoundTrip(t *testing.T) {
+	resources := []apiserver.ResourceInfo{{Group: gv.Group, Version: gv.Version, Resource: "partialobjectmetadatas", Kind: "PartialObjectMetadata"}}
+	RoundTrip(t, newScheme(nil), resources, nil)
+}
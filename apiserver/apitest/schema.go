@@ -0,0 +1,169 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apitest
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"k8s.io/kube-openapi/pkg/common"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+)
+
+// SchemaSnapshot returns the schemas of the OpenAPI definitions whose names start with one of
+// prefixes, typically the packages of the served API versions:
+//
+//	apitest.SchemaSnapshot(openapi.GetOpenAPIDefinitions, "example.com/my-api/api/v1")
+func SchemaSnapshot(getDefinitions common.GetOpenAPIDefinitions, prefixes ...string) map[string]spec.Schema {
+	snapshot := map[string]spec.Schema{}
+	for name, def := range getDefinitions(func(path string) spec.Ref { return spec.MustCreateRef("#/definitions/" + path) }) {
+		if slices.ContainsFunc(prefixes, func(prefix string) bool { return strings.HasPrefix(name, prefix) }) {
+			snapshot[name] = def.Schema
+		}
+	}
+
+	return snapshot
+}
+
+// WriteSchemaSnapshot writes snapshot to the JSON file at path, which is committed with the API
+// types so the schemas of past revisions can be compared with ReadSchemaSnapshotAt.
+func WriteSchemaSnapshot(path string, snapshot map[string]spec.Schema) error {
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// ReadSchemaSnapshot reads the snapshot written to path by WriteSchemaSnapshot.
+func ReadSchemaSnapshot(path string) (map[string]spec.Schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeSchemaSnapshot(data)
+}
+
+// ReadSchemaSnapshotAt reads the snapshot at path as of the git revision, e.g. the tag of the
+// last release. The path is relative to the working directory, i.e. the package of a test.
+func ReadSchemaSnapshotAt(revision, path string) (map[string]spec.Schema, error) {
+	path = "./" + filepath.ToSlash(filepath.Clean(path))
+	data, err := exec.Command("git", "show", revision+":"+path).Output()
+	if err != nil {
+		return nil, fmt.Errorf("reading %s at %s: %w", path, revision, err)
+	}
+
+	return decodeSchemaSnapshot(data)
+}
+
+func decodeSchemaSnapshot(data []byte) (map[string]spec.Schema, error) {
+	snapshot := map[string]spec.Schema{}
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("decoding schema snapshot: %w", err)
+	}
+
+	return snapshot, nil
+}
+
+// VerifySchemaCompatibility fails t for every change between the snapshot at path as of the git
+// revision and the current snapshot which breaks clients, see BreakingSchemaChanges:
+//
+//	func TestSchemaCompatibility(t *testing.T) {
+//	    apitest.VerifySchemaCompatibility(t, "v1.2.0", "testdata/schemas.json",
+//	        apitest.SchemaSnapshot(openapi.GetOpenAPIDefinitions, "example.com/my-api/api/v1"))
+//	}
+func VerifySchemaCompatibility(t *testing.T, revision, path string, current map[string]spec.Schema) {
+	t.Helper()
+	previous, err := ReadSchemaSnapshotAt(revision, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, change := range BreakingSchemaChanges(previous, current) {
+		t.Errorf("breaking change since %s: %s", revision, change)
+	}
+}
+
+// BreakingSchemaChanges returns the changes from the previous to the current schemas which break
+// clients of the previous ones: removed definitions and fields, changed types and references,
+// values removed from enums or enums added to fields, and fields becoming required. Additions are
+// compatible.
+func BreakingSchemaChanges(previous, current map[string]spec.Schema) []string {
+	changes := []string{}
+	for _, name := range slices.Sorted(maps.Keys(previous)) {
+		c, ok := current[name]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("%s: definition removed", name))
+			continue
+		}
+		p := previous[name]
+		changes = compareSchemas(changes, name, &p, &c)
+	}
+
+	return changes
+}
+
+// compareSchemas appends the breaking changes between the schemas of a field at path to changes.
+func compareSchemas(changes []string, path string, previous, current *spec.Schema) []string {
+	if previous.Ref.String() != current.Ref.String() {
+		return append(changes, fmt.Sprintf("%s: reference changed from %q to %q", path, previous.Ref.String(), current.Ref.String()))
+	}
+	if !slices.Equal(previous.Type, current.Type) || previous.Format != current.Format {
+		return append(changes, fmt.Sprintf("%s: type changed from %s to %s", path, typeName(previous), typeName(current)))
+	}
+	if len(current.Enum) > 0 {
+		if len(previous.Enum) == 0 {
+			changes = append(changes, fmt.Sprintf("%s: enum added", path))
+		}
+		for _, value := range previous.Enum {
+			if !slices.ContainsFunc(current.Enum, func(v any) bool { return fmt.Sprint(v) == fmt.Sprint(value) }) {
+				changes = append(changes, fmt.Sprintf("%s: value %v removed from enum", path, value))
+			}
+		}
+	}
+	for _, name := range current.Required {
+		if !slices.Contains(previous.Required, name) {
+			changes = append(changes, fmt.Sprintf("%s.%s: field became required", path, name))
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(previous.Properties)) {
+		c, ok := current.Properties[name]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("%s.%s: field removed", path, name))
+			continue
+		}
+		p := previous.Properties[name]
+		changes = compareSchemas(changes, path+"."+name, &p, &c)
+	}
+	if previous.Items != nil && previous.Items.Schema != nil && current.Items != nil && current.Items.Schema != nil {
+		changes = compareSchemas(changes, path+"[]", previous.Items.Schema, current.Items.Schema)
+	}
+	if previous.AdditionalProperties != nil && previous.AdditionalProperties.Schema != nil &&
+		current.AdditionalProperties != nil && current.AdditionalProperties.Schema != nil {
+		changes = compareSchemas(changes, path+"{}", previous.AdditionalProperties.Schema, current.AdditionalProperties.Schema)
+	}
+
+	return changes
+}
+
+// typeName returns the type of a schema with its format, e.g. "integer/int32".
+func typeName(s *spec.Schema) string {
+	name := strings.Join(s.Type, ",")
+	if s.Format != "" {
+		name += "/" + s.Format
+	}
+	if name == "" {
+		return "untyped"
+	}
+
+	return name
+}
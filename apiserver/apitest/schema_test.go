@@ -0,0 +1,85 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apitest
+
+import (
+	"path/filepath"
+
+	"k8s.io/kube-openapi/pkg/common"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// specSchema returns the schema of a spec with a phase enum, replicas and a map of labels.
+func specSchema() spec.Schema {
+	return spec.Schema{SchemaProps: spec.SchemaProps{
+		Type: []string{"object"},
+		Properties: map[string]spec.Schema{
+			"phase":    *spec.StringProperty().WithEnum("Pending", "Ready"),
+			"replicas": *spec.Int32Property(),
+			"labels":   *spec.MapProperty(spec.StringProperty()),
+			"ports":    *spec.ArrayProperty(spec.Int32Property()),
+		},
+	}}
+}
+
+var _ = Describe("Schema compatibility", func() {
+	It("should accept compatible changes", func() {
+		current := specSchema()
+		current.Properties["phase"] = *spec.StringProperty().WithEnum("Pending", "Ready", "Failed")
+		current.Properties["paused"] = *spec.BooleanProperty()
+		Expect(BreakingSchemaChanges(
+			map[string]spec.Schema{"v1.Spec": specSchema()},
+			map[string]spec.Schema{"v1.Spec": current, "v1.Status": specSchema()},
+		)).To(BeEmpty())
+	})
+
+	It("should report breaking changes", func() {
+		current := specSchema()
+		current.Properties["phase"] = *spec.StringProperty().WithEnum("Pending")
+		current.Properties["replicas"] = *spec.Int64Property()
+		current.Properties["labels"] = *spec.MapProperty(spec.Int32Property())
+		current.Properties["ports"] = *spec.ArrayProperty(spec.StringProperty())
+		current.Properties["name"] = *spec.StringProperty().WithEnum("a")
+		current.Required = []string{"name"}
+		status := specSchema()
+		delete(status.Properties, "replicas")
+		Expect(BreakingSchemaChanges(
+			map[string]spec.Schema{"v1.Spec": specSchema(), "v1.Status": specSchema(), "v1.Removed": specSchema()},
+			map[string]spec.Schema{"v1.Spec": current, "v1.Status": status},
+		)).To(Equal([]string{
+			"v1.Removed: definition removed",
+			"v1.Spec.name: field became required",
+			"v1.Spec.labels{}: type changed from string to integer/int32",
+			"v1.Spec.phase: value Ready removed from enum",
+			"v1.Spec.ports[]: type changed from integer/int32 to string",
+			"v1.Spec.replicas: type changed from integer/int32 to integer/int64",
+			"v1.Status.replicas: field removed",
+		}))
+	})
+
+	It("should snapshot the definitions of the served packages", func() {
+		getDefinitions := func(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
+			return map[string]common.OpenAPIDefinition{
+				"example.com/api/v1.Spec":     {Schema: specSchema()},
+				"example.com/api/v1.Ref":      {Schema: spec.Schema{SchemaProps: spec.SchemaProps{Ref: ref("example.com/api/v1.Spec")}}},
+				"example.com/internal/v1.Foo": {Schema: specSchema()},
+			}
+		}
+		snapshot := SchemaSnapshot(getDefinitions, "example.com/api/")
+		Expect(snapshot).To(HaveKey("example.com/api/v1.Spec"))
+		Expect(snapshot).NotTo(HaveKey("example.com/internal/v1.Foo"))
+		ref := snapshot["example.com/api/v1.Ref"].Ref
+		Expect(ref.String()).To(Equal("#/definitions/example.com/api/v1.Spec"))
+
+		path := filepath.Join(GinkgoT().TempDir(), "schemas.json")
+		Expect(WriteSchemaSnapshot(path, snapshot)).To(Succeed())
+		read, err := ReadSchemaSnapshot(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(BreakingSchemaChanges(snapshot, read)).To(BeEmpty())
+		Expect(BreakingSchemaChanges(read, snapshot)).To(BeEmpty())
+	})
+})
@@ -0,0 +1,171 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apitest
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	"k8s.io/apimachinery/pkg/api/apitesting/roundtrip"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"sigs.k8s.io/randfill"
+
+	"go.opendefense.cloud/kit/apiserver/diff"
+)
+
+// FuzzIterations is the number of fuzzed objects verified per kind.
+const FuzzIterations = 20
+
+// VerifyDefaultingIdempotent fuzzes objects of all kinds of gv and returns an error for every
+// kind whose objects are changed when they are defaulted a second time. Objects are defaulted
+// whenever they are decoded, e.g. when they are read from storage, so defaults must not depend
+// on whether they have been applied before. funcs customize the fuzzing of the types, like the
+// fuzzer functions of round-trip tests.
+func VerifyDefaultingIdempotent(scheme *runtime.Scheme, gv schema.GroupVersion, funcs ...fuzzer.FuzzerFuncs) error {
+	filler := newFiller(scheme, funcs)
+	errs := []error{}
+	for _, kind := range kinds(scheme, gv) {
+		for range FuzzIterations {
+			if err := verifyDefaultingIdempotent(scheme, gv.WithKind(kind), filler); err != nil {
+				errs = append(errs, err)
+				break
+			}
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// VerifySymmetricConversion fuzzes objects of all kinds served in both from and to, converts
+// them to and back from to, and returns an error for every kind whose objects are changed by
+// the conversions, i.e. whose conversion loses data. Conversions go through the internal
+// version of the group if it is registered, as in the API server. funcs customize the fuzzing
+// of the types.
+func VerifySymmetricConversion(scheme *runtime.Scheme, from, to schema.GroupVersion, funcs ...fuzzer.FuzzerFuncs) error {
+	filler := newFiller(scheme, funcs)
+	errs := []error{}
+	for _, kind := range kinds(scheme, from) {
+		if !scheme.Recognizes(to.WithKind(kind)) {
+			continue
+		}
+		for range FuzzIterations {
+			if err := verifySymmetricConversion(scheme, from.WithKind(kind), to, filler); err != nil {
+				errs = append(errs, err)
+				break
+			}
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// newFiller returns a filler with the fuzzer functions of metav1 types and funcs.
+func newFiller(scheme *runtime.Scheme, funcs []fuzzer.FuzzerFuncs) *randfill.Filler {
+	return fuzzer.FuzzerFor(fuzzer.MergeFuzzerFuncs(append([]fuzzer.FuzzerFuncs{metafuzzer.Funcs}, funcs...)...),
+		rand.NewSource(time.Now().UnixNano()), serializer.NewCodecFactory(scheme))
+}
+
+// kinds returns the sorted kinds of gv, except for the options and events registered by
+// metav1.AddToGroupVersion.
+func kinds(scheme *runtime.Scheme, gv schema.GroupVersion) []string {
+	skipped := roundtrip.GlobalNonRoundTrippableTypes()
+	var kinds []string
+	for kind := range scheme.KnownTypes(gv) {
+		if !skipped.Has(kind) {
+			kinds = append(kinds, kind)
+		}
+	}
+	slices.Sort(kinds)
+
+	return kinds
+}
+
+// fuzz returns a fuzzed object of gvk without type information.
+func fuzz(scheme *runtime.Scheme, gvk schema.GroupVersionKind, filler *randfill.Filler) (runtime.Object, error) {
+	obj, err := scheme.New(gvk)
+	if err != nil {
+		return nil, err
+	}
+	filler.Fill(obj)
+	clearTypeMeta(obj)
+
+	return obj, nil
+}
+
+// clearTypeMeta removes the kind and apiVersion of obj, which conversions set.
+func clearTypeMeta(obj runtime.Object) {
+	if t, err := meta.TypeAccessor(obj); err == nil {
+		t.SetKind("")
+		t.SetAPIVersion("")
+	}
+}
+
+// verifyDefaultingIdempotent defaults a fuzzed object of gvk twice and returns an error if the
+// second defaulting changed the object.
+func verifyDefaultingIdempotent(scheme *runtime.Scheme, gvk schema.GroupVersionKind, filler *randfill.Filler) error {
+	obj, err := fuzz(scheme, gvk, filler)
+	if err != nil {
+		return err
+	}
+	scheme.Default(obj)
+	defaulted := obj.DeepCopyObject()
+	scheme.Default(defaulted)
+	d, err := diff.Objects(obj, defaulted)
+	if err != nil {
+		return err
+	}
+	if !d.Empty() {
+		return fmt.Errorf("defaulting %s is not idempotent, defaulting it again changed: %s", gvk, d)
+	}
+
+	return nil
+}
+
+// verifySymmetricConversion converts a fuzzed object of gvk to the version to and back and
+// returns an error if the object changed.
+func verifySymmetricConversion(scheme *runtime.Scheme, gvk schema.GroupVersionKind, to schema.GroupVersion, filler *randfill.Filler) error {
+	obj, err := fuzz(scheme, gvk, filler)
+	if err != nil {
+		return err
+	}
+	converted, err := convert(scheme, obj, to)
+	if err != nil {
+		return fmt.Errorf("converting %s to %s: %w", gvk, to, err)
+	}
+	back, err := convert(scheme, converted, gvk.GroupVersion())
+	if err != nil {
+		return fmt.Errorf("converting %s back from %s: %w", gvk, to, err)
+	}
+	clearTypeMeta(back)
+	d, err := diff.Objects(obj, back)
+	if err != nil {
+		return err
+	}
+	if !d.Empty() {
+		return fmt.Errorf("converting %s to %s and back is lossy, it changed: %s", gvk, to, d)
+	}
+
+	return nil
+}
+
+// convert converts obj to gv through the internal version of its group, if it is registered.
+func convert(scheme *runtime.Scheme, obj runtime.Object, gv schema.GroupVersion) (runtime.Object, error) {
+	internal := schema.GroupVersion{Group: gv.Group, Version: runtime.APIVersionInternal}
+	if len(scheme.KnownTypes(internal)) > 0 {
+		var err error
+		if obj, err = scheme.ConvertToVersion(obj, internal); err != nil {
+			return nil, err
+		}
+	}
+
+	return scheme.ConvertToVersion(obj, gv)
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apitest
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var v2 = schema.GroupVersion{Group: gv.Group, Version: "v2"}
+
+// thingV1 is served in gv, its spec is dropped in v2.
+type thingV1 struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              string `json:"spec,omitempty"`
+}
+
+func (t *thingV1) DeepCopyObject() runtime.Object {
+	out := *t
+	t.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+
+	return &out
+}
+
+// thingV2 is served in v2.
+type thingV2 struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+}
+
+func (t *thingV2) DeepCopyObject() runtime.Object {
+	out := *t
+	t.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+
+	return &out
+}
+
+// newThingScheme returns a scheme serving Thing in gv and v2, converting between them directly.
+func newThingScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypeWithName(gv.WithKind("Thing"), &thingV1{})
+	scheme.AddKnownTypeWithName(v2.WithKind("Thing"), &thingV2{})
+	Expect(scheme.AddConversionFunc((*thingV1)(nil), (*thingV2)(nil), func(a, b any, _ conversion.Scope) error {
+		a.(*thingV1).ObjectMeta.DeepCopyInto(&b.(*thingV2).ObjectMeta)
+
+		return nil
+	})).To(Succeed())
+	Expect(scheme.AddConversionFunc((*thingV2)(nil), (*thingV1)(nil), func(a, b any, _ conversion.Scope) error {
+		a.(*thingV2).ObjectMeta.DeepCopyInto(&b.(*thingV1).ObjectMeta)
+
+		return nil
+	})).To(Succeed())
+
+	return scheme
+}
+
+var _ = Describe("VerifyDefaultingIdempotent", func() {
+	It("should detect defaulting which is not idempotent", func() {
+		Expect(VerifyDefaultingIdempotent(newScheme(appendLabel), gv)).To(MatchError(ContainSubstring("is not idempotent")))
+	})
+
+	It("should accept idempotent defaulting", func() {
+		Expect(VerifyDefaultingIdempotent(newScheme(setLabel), gv)).To(Succeed())
+		Expect(VerifyDefaultingIdempotent(newScheme(nil), gv)).To(Succeed())
+	})
+})
+
+var _ = Describe("VerifySymmetricConversion", func() {
+	It("should detect lossy conversions", func() {
+		Expect(VerifySymmetricConversion(newThingScheme(), gv, v2)).To(MatchError(ContainSubstring("is lossy")))
+	})
+
+	It("should accept lossless conversions", func() {
+		Expect(VerifySymmetricConversion(newThingScheme(), v2, gv)).To(Succeed())
+	})
+
+	It("should skip kinds which are not served in both versions", func() {
+		Expect(VerifySymmetricConversion(newScheme(nil), gv, v2)).To(Succeed())
+	})
+})
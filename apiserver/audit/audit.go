@@ -0,0 +1,60 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package audit lets strategies and admission plugins attach annotations to
+// the audit event of the current request, e.g. to record why an object was mutated.
+//
+// Annotations are recorded for every audit level except None. Keys should be
+// qualified with a domain, e.g. "foo.opendefense.cloud/reason". Setting a key
+// twice to different values keeps the first value.
+package audit
+
+import (
+	"context"
+
+	"k8s.io/apiserver/pkg/audit"
+
+	"go.opendefense.cloud/kit/apiserver/diff"
+)
+
+const (
+	// MutationReasonKey records why an object was mutated by the server.
+	MutationReasonKey = "kit.opendefense.cloud/mutation-reason"
+	// DiffKey records the changes applied to an object as JSON.
+	DiffKey = "kit.opendefense.cloud/diff"
+)
+
+// AddAnnotation attaches an annotation to the audit event of the request in ctx.
+// It is a no-op if the request is not audited.
+func AddAnnotation(ctx context.Context, key, value string) {
+	audit.AddAuditAnnotation(ctx, key, value)
+}
+
+// AddAnnotations is a bulk version of AddAnnotation. keysAndValues must have an even number of items.
+func AddAnnotations(ctx context.Context, keysAndValues ...string) {
+	audit.AddAuditAnnotations(ctx, keysAndValues...)
+}
+
+// AddMutationReason records why an object was mutated, e.g. from PrepareForCreate.
+func AddMutationReason(ctx context.Context, reason string) {
+	AddAnnotation(ctx, MutationReasonKey, reason)
+}
+
+// AddDiff records the given diff as JSON under DiffKey. Empty diffs are not recorded.
+func AddDiff(ctx context.Context, d diff.Diff) {
+	if d.Empty() {
+		return
+	}
+	AddAnnotation(ctx, DiffKey, d.JSON())
+}
+
+// Annotations returns the audit annotations recorded for the request in ctx so far.
+// It returns nil if the request is not audited.
+func Annotations(ctx context.Context) map[string]string {
+	ac := audit.AuditContextFrom(ctx)
+	if !ac.Enabled() {
+		return nil
+	}
+
+	return ac.GetEventAnnotations()
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+
+	auditinternal "k8s.io/apiserver/pkg/apis/audit"
+	"k8s.io/apiserver/pkg/audit"
+
+	"go.opendefense.cloud/kit/apiserver/diff"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Annotations", func() {
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = audit.WithAuditContext(context.Background())
+	})
+
+	It("should record annotations on the audit event", func() {
+		AddAnnotation(ctx, "foo.opendefense.cloud/reason", "defaulted")
+		AddAnnotations(ctx, "a.opendefense.cloud/x", "1", "a.opendefense.cloud/y", "2")
+		AddMutationReason(ctx, "spec.replicas defaulted")
+
+		Expect(Annotations(ctx)).To(Equal(map[string]string{
+			"foo.opendefense.cloud/reason": "defaulted",
+			"a.opendefense.cloud/x":        "1",
+			"a.opendefense.cloud/y":        "2",
+			MutationReasonKey:              "spec.replicas defaulted",
+		}))
+	})
+
+	It("should keep the first value of a key", func() {
+		AddAnnotation(ctx, "foo.opendefense.cloud/reason", "first")
+		AddAnnotation(ctx, "foo.opendefense.cloud/reason", "second")
+		Expect(Annotations(ctx)).To(HaveKeyWithValue("foo.opendefense.cloud/reason", "first"))
+	})
+
+	It("should record diffs as json", func() {
+		AddDiff(ctx, diff.Diff{})
+		Expect(Annotations(ctx)).NotTo(HaveKey(DiffKey))
+
+		AddDiff(ctx, diff.Diff{{Op: diff.OpReplace, Path: "spec.message", Old: "a", New: "b"}})
+		Expect(Annotations(ctx)).To(HaveKeyWithValue(DiffKey, `[{"op":"replace","path":"spec.message","old":"a","new":"b"}]`))
+	})
+
+	It("should ignore requests that are not audited", func() {
+		AddAnnotation(context.Background(), "foo.opendefense.cloud/reason", "ignored")
+		Expect(Annotations(context.Background())).To(BeNil())
+
+		Expect(audit.AuditContextFrom(ctx).Init(audit.RequestAuditConfig{Level: auditinternal.LevelNone}, nil)).To(Succeed())
+		AddAnnotation(ctx, "foo.opendefense.cloud/reason", "ignored")
+		Expect(Annotations(ctx)).To(BeNil())
+	})
+})
@@ -0,0 +1,188 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiserverapi "k8s.io/apiserver/pkg/apis/apiserver"
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/authentication/group"
+	"k8s.io/apiserver/pkg/authentication/request/anonymous"
+	"k8s.io/apiserver/pkg/authentication/request/bearertoken"
+	"k8s.io/apiserver/pkg/authentication/request/union"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/authorization/path"
+	authorizerunion "k8s.io/apiserver/pkg/authorization/union"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/apiserver/pkg/server/dynamiccertificates"
+
+	"go.opendefense.cloud/kit/apiserver/authn"
+)
+
+// AuthenticatorFn creates an additional request authenticator once the server configuration is known.
+// ctx is cancelled when the server shuts down.
+type AuthenticatorFn func(ctx context.Context, c *genericapiserver.RecommendedConfig) (authenticator.Request, error)
+
+// standaloneAnonymousPaths may be accessed without credentials in standalone mode, so probes keep working.
+var standaloneAnonymousPaths = []string{"/healthz", "/livez", "/readyz", StartupzPath}
+
+// WithAuthenticator registers an additional request authenticator. Authenticators are tried in
+// registration order before the delegated authentication against the kube-apiserver.
+func (b *Builder) WithAuthenticator(fn AuthenticatorFn) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if fn == nil {
+		return b
+	}
+	b.authenticatorFns = append(b.authenticatorFns, fn)
+
+	return b
+}
+
+// WithClientCertExpiryWarnings warns clients whose client certificate expires within threshold,
+// e.g. 7 days, and counts their requests in kit_authn_client_certificates_expiring_total. The
+// remaining validity of all client certificates is observed in
+// kit_authn_client_certificate_expiration_seconds.
+func (b *Builder) WithClientCertExpiryWarnings(threshold time.Duration) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.clientCertExpiryThreshold = threshold
+
+	return b
+}
+
+// WithOIDCAuthentication authenticates bearer tokens issued by the given OpenID Connect provider
+// for clientID. It is typically combined with WithStandaloneMode.
+func (b *Builder) WithOIDCAuthentication(issuerURL, clientID string, opts ...authn.OIDCOption) *Builder {
+	config := authn.NewOIDCConfig(issuerURL, clientID, opts...)
+
+	return b.WithAuthenticator(func(ctx context.Context, _ *genericapiserver.RecommendedConfig) (authenticator.Request, error) {
+		return authn.NewOIDC(ctx, config)
+	})
+}
+
+// WithClientCertAuthentication authenticates client certificates signed by the CA bundle in caFile.
+// The file is reloaded when it changes. The user is determined by mapper; authn.CommonNameIdentity
+// is used if it is nil.
+func (b *Builder) WithClientCertAuthentication(caFile string, mapper authn.IdentityMapper) *Builder {
+	return b.WithAuthenticator(func(ctx context.Context, c *genericapiserver.RecommendedConfig) (authenticator.Request, error) {
+		ca, err := dynamiccertificates.NewDynamicCAContentFromFile("client-ca", caFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading client CA: %w", err)
+		}
+		go ca.Run(ctx, 1)
+		// Request client certificates signed by the CA during the TLS handshake.
+		if err := c.Authentication.ApplyClientCert(ca, c.SecureServing); err != nil {
+			return nil, err
+		}
+
+		return authn.NewClientCert(ca, mapper), nil
+	})
+}
+
+// WithTokenFileAuthentication authenticates static bearer tokens listed in the token file at path.
+// The file is reloaded when it changes, see authn.TokenFile for the format.
+func (b *Builder) WithTokenFileAuthentication(path string) *Builder {
+	return b.WithAuthenticator(func(ctx context.Context, _ *genericapiserver.RecommendedConfig) (authenticator.Request, error) {
+		tokens, err := authn.NewTokenFile(path)
+		if err != nil {
+			return nil, err
+		}
+		go tokens.Run(ctx, authn.DefaultTokenFileReloadInterval)
+
+		return bearertoken.New(tokens), nil
+	})
+}
+
+// WithStandaloneMode serves the API directly, e.g. behind an ingress, instead of registering it
+// with the kube-apiserver through an APIService. Delegated authentication and authorization,
+// admission and priority and fairness, which all require a kube-apiserver, are disabled, so
+// WithExtraAdmissionInitializers, WithDefaultingProfiles, WithMetadataInjection and modules with
+// admission plugins cannot be used. Requests are authenticated by the authenticators
+// registered with WithAuthenticator or WithOIDCAuthentication and authorized by authz, which is
+// required. Requests to the health endpoints are always allowed.
+func (b *Builder) WithStandaloneMode(authz authorizer.Authorizer) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.standalone = true
+	b.standaloneAuthorizer = authz
+
+	return b
+}
+
+// applyStandaloneOptions drops all options depending on a kube-apiserver. It fails if the
+// configuration relies on one of them.
+func (c *completedConfig) applyStandaloneOptions() error {
+	if !c.standalone {
+		return nil
+	}
+	if c.standaloneAuthorizer == nil {
+		return fmt.Errorf("standalone mode requires an authorizer")
+	}
+	if c.extraAdmissionInitializers != nil {
+		return fmt.Errorf("admission initializers are not supported in standalone mode")
+	}
+	if c.defaultingProfiles != nil {
+		return fmt.Errorf("defaulting profiles are not supported in standalone mode")
+	}
+	if len(c.admissionPlugins) > 0 {
+		return fmt.Errorf("admission plugins of modules are not supported in standalone mode")
+	}
+	if c.metadataInjection != nil {
+		return fmt.Errorf("metadata injection is not supported in standalone mode")
+	}
+	c.recommendedOptions.Authentication = nil
+	c.recommendedOptions.Authorization = nil
+	c.recommendedOptions.CoreAPI = nil
+	c.recommendedOptions.Admission = nil
+	c.recommendedOptions.Features.EnablePriorityAndFairness = false
+
+	return nil
+}
+
+// applyAuthentication installs the registered authenticators and, in standalone mode, the authorizer.
+func (c *completedConfig) applyAuthentication(ctx context.Context, rc *genericapiserver.RecommendedConfig) error {
+	authenticators := []authenticator.Request{}
+	for _, fn := range c.authenticatorFns {
+		a, err := fn(ctx, rc)
+		if err != nil {
+			return err
+		}
+		authenticators = append(authenticators, a)
+	}
+
+	if len(authenticators) > 0 {
+		chain := []authenticator.Request{group.NewAuthenticatedGroupAdder(union.New(authenticators...))}
+		if rc.Authentication.Authenticator != nil {
+			chain = append(chain, rc.Authentication.Authenticator)
+		}
+		rc.Authentication.Authenticator = union.New(chain...)
+	}
+
+	if !c.standalone {
+		return nil
+	}
+
+	conditions := []apiserverapi.AnonymousAuthCondition{}
+	for _, p := range standaloneAnonymousPaths {
+		conditions = append(conditions, apiserverapi.AnonymousAuthCondition{Path: p})
+	}
+	if rc.Authentication.Authenticator == nil {
+		rc.Authentication.Authenticator = anonymous.NewAuthenticator(conditions)
+	} else {
+		rc.Authentication.Authenticator = union.New(rc.Authentication.Authenticator, anonymous.NewAuthenticator(conditions))
+	}
+
+	// Probes are allowed regardless of the authorizer, like with delegated authorization.
+	probes, err := path.NewAuthorizer(standaloneAnonymousPaths)
+	if err != nil {
+		return err
+	}
+	rc.Authorization.Authorizer = authorizerunion.New(probes, c.standaloneAuthorizer)
+
+	return nil
+}
@@ -0,0 +1,167 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	genericoptions "k8s.io/apiserver/pkg/server/options"
+
+	"go.opendefense.cloud/kit/apiserver/injection"
+	"go.opendefense.cloud/kit/apiserver/profile"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Authentication", func() {
+	var (
+		b      *Builder
+		config *genericapiserver.RecommendedConfig
+	)
+
+	tokenAuthenticator := func(_ context.Context, _ *genericapiserver.RecommendedConfig) (authenticator.Request, error) {
+		return authenticator.RequestFunc(func(req *http.Request) (*authenticator.Response, bool, error) {
+			if req.Header.Get("Authorization") != "Bearer secret" {
+				return nil, false, nil
+			}
+
+			return &authenticator.Response{User: &user.DefaultInfo{Name: "alice"}}, true, nil
+		}), nil
+	}
+
+	authenticate := func(path string, token string) (user.Info, bool) {
+		GinkgoHelper()
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, ok, err := config.Authentication.Authenticator.AuthenticateRequest(req)
+		Expect(err).NotTo(HaveOccurred())
+		if !ok {
+			return nil, false
+		}
+
+		return resp.User, true
+	}
+
+	denyAll := authorizer.AuthorizerFunc(func(context.Context, authorizer.Attributes) (authorizer.Decision, string, error) {
+		return authorizer.DecisionDeny, "denied", nil
+	})
+
+	snapshot := func(b *Builder) *completedConfig {
+		return &completedConfig{builderConfig: b.builderConfig}
+	}
+
+	BeforeEach(func() {
+		b = NewBuilder(runtime.NewScheme())
+		config = &genericapiserver.RecommendedConfig{}
+	})
+
+	It("should keep the configuration without additional authenticators", func() {
+		Expect(snapshot(b).applyAuthentication(context.Background(), config)).To(Succeed())
+		Expect(config.Authentication.Authenticator).To(BeNil())
+		Expect(config.Authorization.Authorizer).To(BeNil())
+	})
+
+	It("should try additional authenticators before the delegated authenticator", func() {
+		config.Authentication.Authenticator = authenticator.RequestFunc(func(*http.Request) (*authenticator.Response, bool, error) {
+			return &authenticator.Response{User: &user.DefaultInfo{Name: "delegated"}}, true, nil
+		})
+		Expect(snapshot(b.WithAuthenticator(tokenAuthenticator)).applyAuthentication(context.Background(), config)).To(Succeed())
+
+		u, ok := authenticate("/apis", "secret")
+		Expect(ok).To(BeTrue())
+		Expect(u.GetName()).To(Equal("alice"))
+		Expect(u.GetGroups()).To(ContainElement(user.AllAuthenticated))
+
+		u, ok = authenticate("/apis", "")
+		Expect(ok).To(BeTrue())
+		Expect(u.GetName()).To(Equal("delegated"))
+	})
+
+	Describe("standalone mode", func() {
+		It("should drop options depending on a kube-apiserver", func() {
+			c := snapshot(b.WithStandaloneMode(denyAll))
+			c.recommendedOptions = genericoptions.NewRecommendedOptions("/registry/test", nil)
+			Expect(c.applyStandaloneOptions()).To(Succeed())
+			Expect(c.recommendedOptions.Authentication).To(BeNil())
+			Expect(c.recommendedOptions.Authorization).To(BeNil())
+			Expect(c.recommendedOptions.CoreAPI).To(BeNil())
+			Expect(c.recommendedOptions.Admission).To(BeNil())
+			Expect(c.recommendedOptions.Features.EnablePriorityAndFairness).To(BeFalse())
+		})
+
+		It("should require an authorizer", func() {
+			c := snapshot(b.WithStandaloneMode(nil))
+			c.recommendedOptions = genericoptions.NewRecommendedOptions("/registry/test", nil)
+			Expect(c.applyStandaloneOptions()).To(MatchError("standalone mode requires an authorizer"))
+		})
+
+		It("should reject admission initializers", func() {
+			c := snapshot(b.WithStandaloneMode(denyAll).WithExtraAdmissionInitializers(func(*genericapiserver.RecommendedConfig) (SharedInformerFactory, []admission.PluginInitializer, error) {
+				return nil, nil, nil
+			}))
+			c.recommendedOptions = genericoptions.NewRecommendedOptions("/registry/test", nil)
+			Expect(c.applyStandaloneOptions()).To(MatchError(ContainSubstring("admission initializers are not supported")))
+			Expect(c.recommendedOptions.Admission).NotTo(BeNil())
+		})
+
+		It("should reject defaulting profiles", func() {
+			c := snapshot(b.WithStandaloneMode(denyAll).WithDefaultingProfiles(profile.NewRegistry()))
+			c.recommendedOptions = genericoptions.NewRecommendedOptions("/registry/test", nil)
+			Expect(c.applyStandaloneOptions()).To(MatchError(ContainSubstring("defaulting profiles are not supported")))
+		})
+
+		It("should reject admission plugins of modules", func() {
+			c := snapshot(b.WithStandaloneMode(denyAll).WithModule(Module{Name: "test", AdmissionPlugins: []AdmissionPlugin{{Name: "Test", Factory: noopPlugin}}}))
+			c.recommendedOptions = genericoptions.NewRecommendedOptions("/registry/test", nil)
+			Expect(c.applyStandaloneOptions()).To(MatchError(ContainSubstring("admission plugins of modules are not supported")))
+		})
+
+		It("should reject metadata injection", func() {
+			c := snapshot(b.WithStandaloneMode(denyAll).WithMetadataInjection(injection.Config{}))
+			c.recommendedOptions = genericoptions.NewRecommendedOptions("/registry/test", nil)
+			Expect(c.applyStandaloneOptions()).To(MatchError(ContainSubstring("metadata injection is not supported")))
+		})
+
+		It("should only allow anonymous requests to health endpoints", func() {
+			Expect(snapshot(b.WithStandaloneMode(denyAll).WithAuthenticator(tokenAuthenticator)).applyAuthentication(context.Background(), config)).To(Succeed())
+
+			u, ok := authenticate("/readyz", "")
+			Expect(ok).To(BeTrue())
+			Expect(u.GetName()).To(Equal(user.Anonymous))
+
+			_, ok = authenticate("/apis", "")
+			Expect(ok).To(BeFalse())
+
+			u, ok = authenticate("/apis", "secret")
+			Expect(ok).To(BeTrue())
+			Expect(u.GetName()).To(Equal("alice"))
+		})
+
+		It("should use the given authorizer and always allow probes", func() {
+			Expect(snapshot(b.WithStandaloneMode(denyAll)).applyAuthentication(context.Background(), config)).To(Succeed())
+			decision, _, err := config.Authorization.Authorizer.Authorize(context.Background(), authorizer.AttributesRecord{
+				ResourceRequest: true, Verb: "get", Resource: "bars",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decision).To(Equal(authorizer.DecisionDeny))
+
+			decision, _, err = config.Authorization.Authorizer.Authorize(context.Background(), authorizer.AttributesRecord{
+				Verb: "get", Path: "/readyz",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decision).To(Equal(authorizer.DecisionAllow))
+		})
+	})
+})
@@ -0,0 +1,86 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package authn
+
+import (
+	"crypto/x509"
+	"fmt"
+	"slices"
+
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	x509request "k8s.io/apiserver/pkg/authentication/request/x509"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/server/dynamiccertificates"
+)
+
+// IdentityMapper maps a verified client certificate to the requesting user.
+type IdentityMapper func(cert *x509.Certificate) (user.Info, error)
+
+// CommonNameIdentity uses the common name as user name and the organizations as groups,
+// the same as the kube-apiserver does.
+func CommonNameIdentity(cert *x509.Certificate) (user.Info, error) {
+	if cert.Subject.CommonName == "" {
+		return nil, fmt.Errorf("client certificate has no common name")
+	}
+
+	return &user.DefaultInfo{
+		Name:   cert.Subject.CommonName,
+		Groups: slices.Clone(cert.Subject.Organization),
+	}, nil
+}
+
+// URIIdentity uses the first URI SAN with the given scheme as user name, e.g. a SPIFFE ID
+// for scheme "spiffe". The organizations are used as groups.
+func URIIdentity(scheme string) IdentityMapper {
+	return func(cert *x509.Certificate) (user.Info, error) {
+		for _, uri := range cert.URIs {
+			if uri.Scheme == scheme {
+				return &user.DefaultInfo{
+					Name:   uri.String(),
+					Groups: slices.Clone(cert.Subject.Organization),
+				}, nil
+			}
+		}
+
+		return nil, fmt.Errorf("client certificate has no %s URI", scheme)
+	}
+}
+
+// WithGroups returns a mapper adding the given groups to every user mapped by m.
+func WithGroups(m IdentityMapper, groups ...string) IdentityMapper {
+	return func(cert *x509.Certificate) (user.Info, error) {
+		u, err := m(cert)
+		if err != nil {
+			return nil, err
+		}
+
+		return &user.DefaultInfo{
+			Name:   u.GetName(),
+			UID:    u.GetUID(),
+			Groups: append(slices.Clone(u.GetGroups()), groups...),
+			Extra:  u.GetExtra(),
+		}, nil
+	}
+}
+
+// NewClientCert returns an authenticator for client certificates signed by ca.
+// The user is determined by mapper; CommonNameIdentity is used if it is nil.
+func NewClientCert(ca dynamiccertificates.CAContentProvider, mapper IdentityMapper) authenticator.Request {
+	if mapper == nil {
+		mapper = CommonNameIdentity
+	}
+
+	return x509request.NewDynamic(ca.VerifyOptions, x509request.UserConversionFunc(
+		func(chain []*x509.Certificate) (*authenticator.Response, bool, error) {
+			if len(chain) == 0 {
+				return nil, false, nil
+			}
+			u, err := mapper(chain[0])
+			if err != nil {
+				return nil, false, err
+			}
+
+			return &authenticator.Response{User: u}, true, nil
+		}))
+}
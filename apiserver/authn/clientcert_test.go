@@ -0,0 +1,122 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package authn
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"time"
+
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/server/dynamiccertificates"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ClientCert", func() {
+	var (
+		caCert *x509.Certificate
+		caKey  *ecdsa.PrivateKey
+		ca     dynamiccertificates.CAContentProvider
+	)
+
+	issue := func(template *x509.Certificate, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+		GinkgoHelper()
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		template.SerialNumber = big.NewInt(time.Now().UnixNano())
+		template.NotBefore = time.Now().Add(-time.Hour)
+		template.NotAfter = time.Now().Add(time.Hour)
+		if parent == nil {
+			parent, parentKey = template, key
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+		Expect(err).NotTo(HaveOccurred())
+		cert, err := x509.ParseCertificate(der)
+		Expect(err).NotTo(HaveOccurred())
+
+		return cert, key
+	}
+
+	clientCert := func(subject pkix.Name, uris ...*url.URL) *x509.Certificate {
+		GinkgoHelper()
+		cert, _ := issue(&x509.Certificate{
+			Subject:     subject,
+			URIs:        uris,
+			KeyUsage:    x509.KeyUsageDigitalSignature,
+			ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}, caCert, caKey)
+
+		return cert
+	}
+
+	authenticate := func(a authenticator.Request, cert *x509.Certificate) (*authenticator.Response, bool, error) {
+		req := httptest.NewRequest(http.MethodGet, "/apis", nil)
+		req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+
+		return a.AuthenticateRequest(req)
+	}
+
+	BeforeEach(func() {
+		caCert, caKey = issue(&x509.Certificate{
+			Subject:               pkix.Name{CommonName: "test-ca"},
+			IsCA:                  true,
+			BasicConstraintsValid: true,
+			KeyUsage:              x509.KeyUsageCertSign,
+		}, nil, nil)
+		var err error
+		ca, err = dynamiccertificates.NewStaticCAContent("test-ca", pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caCert.Raw}))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should map common name and organizations by default", func() {
+		resp, ok, err := authenticate(NewClientCert(ca, nil), clientCert(pkix.Name{CommonName: "robot", Organization: []string{"machines"}}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeTrue())
+		Expect(resp.User.GetName()).To(Equal("robot"))
+		Expect(resp.User.GetGroups()).To(Equal([]string{"machines"}))
+	})
+
+	It("should map URI SANs", func() {
+		id := &url.URL{Scheme: "spiffe", Host: "example.org", Path: "/ns/default/sa/robot"}
+		mapper := WithGroups(URIIdentity("spiffe"), "spiffe-workloads")
+		resp, ok, err := authenticate(NewClientCert(ca, mapper), clientCert(pkix.Name{CommonName: "ignored"}, id))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeTrue())
+		Expect(resp.User.GetName()).To(Equal("spiffe://example.org/ns/default/sa/robot"))
+		Expect(resp.User.GetGroups()).To(Equal([]string{"spiffe-workloads"}))
+	})
+
+	It("should reject certificates the mapper cannot map", func() {
+		_, ok, err := authenticate(NewClientCert(ca, URIIdentity("spiffe")), clientCert(pkix.Name{CommonName: "robot"}))
+		Expect(err).To(HaveOccurred())
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should reject certificates signed by another CA", func() {
+		otherCA, otherKey := issue(&x509.Certificate{
+			Subject:               pkix.Name{CommonName: "other-ca"},
+			IsCA:                  true,
+			BasicConstraintsValid: true,
+			KeyUsage:              x509.KeyUsageCertSign,
+		}, nil, nil)
+		cert, _ := issue(&x509.Certificate{
+			Subject:     pkix.Name{CommonName: "robot"},
+			ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}, otherCA, otherKey)
+		_, ok, err := authenticate(NewClientCert(ca, nil), cert)
+		Expect(err).To(HaveOccurred())
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -0,0 +1,94 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package authn
+
+import (
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"k8s.io/apiserver/pkg/endpoints/request"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/apiserver/pkg/warning"
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+	"k8s.io/klog/v2"
+)
+
+var (
+	clientCertExpiration = metrics.NewHistogram(
+		&metrics.HistogramOpts{
+			Namespace: "kit",
+			Subsystem: "authn",
+			Name:      "client_certificate_expiration_seconds",
+			Help:      "Remaining validity of the client certificates of requests, in seconds.",
+			// From expired over 30 minutes, 1, 2, 6 and 12 hours, 1, 2, 4 and 7 days, 1, 3 and 6 months to a year.
+			Buckets: []float64{
+				0, 1800, 3600, 7200, 21600, 43200, 86400, 172800, 345600, 604800, 2592000, 7776000, 15552000, 31104000,
+			},
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+	expiringClientCerts = metrics.NewCounter(
+		&metrics.CounterOpts{
+			Namespace:      "kit",
+			Subsystem:      "authn",
+			Name:           "client_certificates_expiring_total",
+			Help:           "Number of requests with a client certificate expiring within the warning threshold.",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+
+	registerMetricsOnce sync.Once
+)
+
+// RegisterMetrics registers the metrics of this package with the legacy registry,
+// which is served on /metrics. It is safe to call multiple times.
+func RegisterMetrics() {
+	registerMetricsOnce.Do(func() {
+		legacyregistry.MustRegister(clientCertExpiration, expiringClientCerts)
+	})
+}
+
+// WithClientCertExpiryWarnings observes the remaining validity of the client certificates of
+// requests, and warns clients whose certificate expires within threshold, so they are rotated in
+// time. Clients authenticated by other means than their certificate, e.g. users authenticated
+// by the front-proxy of the kube-apiserver, are not warned, the certificate is only logged.
+func WithClientCertExpiryWarnings(handler http.Handler, threshold time.Duration) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.TLS != nil && len(req.TLS.PeerCertificates) > 0 {
+			cert := req.TLS.PeerCertificates[0]
+			remaining := time.Until(cert.NotAfter)
+			clientCertExpiration.Observe(remaining.Seconds())
+			if remaining < threshold {
+				expiringClientCerts.Inc()
+				klog.FromContext(req.Context()).V(2).Info("Client certificate expires soon",
+					"subject", cert.Subject.String(), "notAfter", cert.NotAfter)
+				if u, ok := request.UserFrom(req.Context()); ok && identifies(cert, u.GetName()) {
+					warning.AddWarning(req.Context(), "", fmt.Sprintf("the client certificate %q expires in %s, rotate it",
+						cert.Subject.CommonName, remaining.Round(time.Second)))
+				}
+			}
+		}
+		handler.ServeHTTP(w, req)
+	})
+}
+
+// identifies returns true if the user name has been mapped from cert by CommonNameIdentity or
+// URIIdentity.
+func identifies(cert *x509.Certificate, name string) bool {
+	return name == cert.Subject.CommonName || slices.ContainsFunc(cert.URIs, func(uri *url.URL) bool { return uri.String() == name })
+}
+
+// BuildHandlerChainFunc returns a BuildHandlerChainFunc warning about expiring client
+// certificates after the requests have been authenticated by the handler chain built by delegate.
+func BuildHandlerChainFunc(threshold time.Duration, delegate func(http.Handler, *genericapiserver.Config) http.Handler) func(http.Handler, *genericapiserver.Config) http.Handler {
+	return func(apiHandler http.Handler, c *genericapiserver.Config) http.Handler {
+		return delegate(WithClientCertExpiryWarnings(apiHandler, threshold), c)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package authn
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/warning"
+	"k8s.io/component-base/metrics/legacyregistry"
+	"k8s.io/component-base/metrics/testutil"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// warningRecorder records the warnings added to a request.
+type warningRecorder []string
+
+func (r *warningRecorder) AddWarning(_, text string) { *r = append(*r, text) }
+
+var _ = Describe("client certificate expiry", func() {
+	var handler http.Handler
+
+	BeforeEach(func() {
+		RegisterMetrics()
+		expiringClientCerts.Reset()
+		handler = WithClientCertExpiryWarnings(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}), 24*time.Hour)
+	})
+
+	serve := func(userName string, notAfter time.Time) []string {
+		GinkgoHelper()
+		recorder := &warningRecorder{}
+		ctx := warning.WithWarningRecorder(context.Background(), recorder)
+		ctx = request.WithUser(ctx, &user.DefaultInfo{Name: userName})
+		req := httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx)
+		req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "alice"}, NotAfter: notAfter}}}
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+
+		return *recorder
+	}
+
+	expiring := func() float64 {
+		GinkgoHelper()
+		v, err := testutil.GetCounterMetricValue(expiringClientCerts)
+		Expect(err).NotTo(HaveOccurred())
+
+		return v
+	}
+
+	observed := func() uint64 {
+		GinkgoHelper()
+		vec, err := testutil.GetHistogramVecFromGatherer(legacyregistry.DefaultGatherer, "kit_authn_client_certificate_expiration_seconds", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		return vec.GetAggregatedSampleCount()
+	}
+
+	It("should warn clients whose certificate expires soon", func() {
+		// The remaining validity is rounded to seconds.
+		warnings := serve("alice", time.Now().Add(time.Hour))
+		Expect(warnings).To(ConsistOf(`the client certificate "alice" expires in 1h0m0s, rotate it`))
+		Expect(expiring()).To(Equal(1.0))
+	})
+
+	It("should not warn clients whose certificate is valid long enough", func() {
+		before := observed()
+		Expect(serve("alice", time.Now().Add(48*time.Hour))).To(BeEmpty())
+		Expect(expiring()).To(BeZero())
+		Expect(observed()).To(Equal(before + 1))
+	})
+
+	It("should only count certificates of proxies", func() {
+		Expect(serve("bob", time.Now().Add(time.Hour))).To(BeEmpty())
+		Expect(expiring()).To(Equal(1.0))
+	})
+})
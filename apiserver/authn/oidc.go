@@ -0,0 +1,125 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package authn provides request authenticators for kit API servers which are
+// not only reachable through the kube-apiserver aggregation layer.
+package authn
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apiserver/pkg/apis/apiserver"
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/authentication/request/bearertoken"
+	"k8s.io/apiserver/pkg/server/dynamiccertificates"
+	"k8s.io/apiserver/plugin/pkg/authenticator/token/oidc"
+)
+
+// OIDCConfig configures an OpenID Connect bearer token authenticator.
+type OIDCConfig struct {
+	// IssuerURL is the URL of the provider, it must use https.
+	IssuerURL string
+	// ClientID is the audience tokens must be issued for.
+	ClientID string
+	// UsernameClaim is the claim used as user name. Defaults to "sub".
+	UsernameClaim string
+	// UsernamePrefix is prepended to user names. Defaults to IssuerURL followed by "#"
+	// unless UsernameClaim is set explicitly.
+	UsernamePrefix string
+	// GroupsClaim is the claim used as groups. Groups are not mapped if empty.
+	GroupsClaim string
+	// GroupsPrefix is prepended to group names.
+	GroupsPrefix string
+	// CAFile is the PEM encoded CA bundle used to verify the provider. The host's
+	// root CAs are used if empty.
+	CAFile string
+	// SigningAlgs are the accepted token signing algorithms. Defaults to RS256.
+	SigningAlgs []string
+}
+
+// OIDCOption customizes an OIDCConfig.
+type OIDCOption func(*OIDCConfig)
+
+// UsernameClaim maps user names from the given claim, prepending prefix.
+func UsernameClaim(claim, prefix string) OIDCOption {
+	return func(c *OIDCConfig) {
+		c.UsernameClaim = claim
+		c.UsernamePrefix = prefix
+	}
+}
+
+// GroupsClaim maps groups from the given claim, prepending prefix.
+func GroupsClaim(claim, prefix string) OIDCOption {
+	return func(c *OIDCConfig) {
+		c.GroupsClaim = claim
+		c.GroupsPrefix = prefix
+	}
+}
+
+// IssuerCAFile verifies the provider using the CA bundle in path.
+func IssuerCAFile(path string) OIDCOption {
+	return func(c *OIDCConfig) {
+		c.CAFile = path
+	}
+}
+
+// SigningAlgs sets the accepted token signing algorithms.
+func SigningAlgs(algs ...string) OIDCOption {
+	return func(c *OIDCConfig) {
+		c.SigningAlgs = algs
+	}
+}
+
+// NewOIDCConfig returns an OIDCConfig with defaults applied.
+func NewOIDCConfig(issuerURL, clientID string, opts ...OIDCOption) OIDCConfig {
+	c := OIDCConfig{
+		IssuerURL:      issuerURL,
+		ClientID:       clientID,
+		UsernameClaim:  "sub",
+		UsernamePrefix: issuerURL + "#",
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return c
+}
+
+// NewOIDC returns an authenticator verifying bearer tokens issued by the
+// configured provider. The provider keys are fetched in the background until
+// ctx is done.
+func NewOIDC(ctx context.Context, c OIDCConfig) (authenticator.Request, error) {
+	jwt := apiserver.JWTAuthenticator{
+		Issuer: apiserver.Issuer{
+			URL:                 c.IssuerURL,
+			Audiences:           []string{c.ClientID},
+			AudienceMatchPolicy: apiserver.AudienceMatchPolicyMatchAny,
+		},
+		ClaimMappings: apiserver.ClaimMappings{
+			Username: apiserver.PrefixedClaimOrExpression{Claim: c.UsernameClaim, Prefix: &c.UsernamePrefix},
+		},
+	}
+	if c.GroupsClaim != "" {
+		jwt.ClaimMappings.Groups = apiserver.PrefixedClaimOrExpression{Claim: c.GroupsClaim, Prefix: &c.GroupsPrefix}
+	}
+
+	opts := oidc.Options{
+		JWTAuthenticator:     jwt,
+		SupportedSigningAlgs: c.SigningAlgs,
+	}
+	if c.CAFile != "" {
+		ca, err := dynamiccertificates.NewDynamicCAContentFromFile("oidc-ca", c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading OIDC issuer CA: %w", err)
+		}
+		opts.CAContentProvider = ca
+	}
+
+	tokenAuthenticator, err := oidc.New(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("creating OIDC authenticator: %w", err)
+	}
+
+	return bearertoken.New(tokenAuthenticator), nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package authn
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OIDC", func() {
+	It("should default the username claim and prefix", func() {
+		c := NewOIDCConfig("https://issuer.example.com", "kit")
+		Expect(c.UsernameClaim).To(Equal("sub"))
+		Expect(c.UsernamePrefix).To(Equal("https://issuer.example.com#"))
+		Expect(c.GroupsClaim).To(BeEmpty())
+	})
+
+	It("should apply options", func() {
+		c := NewOIDCConfig("https://issuer.example.com", "kit",
+			UsernameClaim("email", ""), GroupsClaim("groups", "oidc:"), IssuerCAFile("/ca.pem"), SigningAlgs("ES256"))
+		Expect(c.UsernameClaim).To(Equal("email"))
+		Expect(c.UsernamePrefix).To(BeEmpty())
+		Expect(c.GroupsClaim).To(Equal("groups"))
+		Expect(c.GroupsPrefix).To(Equal("oidc:"))
+		Expect(c.CAFile).To(Equal("/ca.pem"))
+		Expect(c.SigningAlgs).To(Equal([]string{"ES256"}))
+	})
+
+	It("should reject invalid configuration", func() {
+		ctx := context.Background()
+		_, err := NewOIDC(ctx, NewOIDCConfig("http://issuer.example.com", "kit"))
+		Expect(err).To(MatchError(ContainSubstring("URL scheme must be https")))
+
+		_, err = NewOIDC(ctx, NewOIDCConfig("https://issuer.example.com", "kit", SigningAlgs("none")))
+		Expect(err).To(MatchError(ContainSubstring("unsupported signing alg")))
+
+		_, err = NewOIDC(ctx, NewOIDCConfig("https://issuer.example.com", "kit", IssuerCAFile(filepath.Join(GinkgoT().TempDir(), "missing.pem"))))
+		Expect(err).To(MatchError(ContainSubstring("loading OIDC issuer CA")))
+	})
+
+	It("should verify the provider with the CA file and reject invalid tokens", func() {
+		var issuer string
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/.well-known/openid-configuration":
+				_ = json.NewEncoder(w).Encode(map[string]string{"issuer": issuer, "jwks_uri": issuer + "/keys"})
+			case "/keys":
+				_, _ = w.Write([]byte(`{"keys": []}`))
+			default:
+				http.NotFound(w, r)
+			}
+		}))
+		DeferCleanup(server.Close)
+		issuer = server.URL
+
+		caFile := filepath.Join(GinkgoT().TempDir(), "ca.pem")
+		caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+		Expect(os.WriteFile(caFile, caPEM, 0o600)).To(Succeed())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		DeferCleanup(cancel)
+		a, err := NewOIDC(ctx, NewOIDCConfig(issuer, "kit", IssuerCAFile(caFile)))
+		Expect(err).NotTo(HaveOccurred())
+
+		req := httptest.NewRequest(http.MethodGet, "/apis", nil)
+		_, ok, err := a.AuthenticateRequest(req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeFalse())
+
+		req.Header.Set("Authorization", "Bearer not-a-jwt")
+		_, ok, err = a.AuthenticateRequest(req)
+		Expect(err).To(HaveOccurred())
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -0,0 +1,16 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package authn
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAuthn(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Authn Suite")
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package authn
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/authentication/token/tokenfile"
+)
+
+// DefaultTokenFileReloadInterval is the interval in which token files are checked for changes.
+const DefaultTokenFileReloadInterval = 30 * time.Second
+
+// TokenFile authenticates static bearer tokens for environments where TokenReview delegation
+// is not available. The file uses the kube-apiserver token file format, one token per line:
+//
+//	token,user,uid,"group1,group2"
+//
+// The file is reloaded when its content changes, so tokens can be rotated without a restart,
+// e.g. by updating a mounted Secret.
+type TokenFile struct {
+	path string
+
+	mu            sync.RWMutex
+	checksum      []byte
+	authenticator *tokenfile.TokenAuthenticator
+}
+
+var _ authenticator.Token = &TokenFile{}
+
+// NewTokenFile loads the tokens from path.
+func NewTokenFile(path string) (*TokenFile, error) {
+	t := &TokenFile{path: path}
+	if err := t.Reload(); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// Reload reads the token file if its content changed. On error the previously loaded tokens are kept.
+func (t *TokenFile) Reload() error {
+	data, err := os.ReadFile(t.path)
+	if err != nil {
+		return fmt.Errorf("reading token file: %w", err)
+	}
+	checksum := sha256.Sum256(data)
+
+	t.mu.RLock()
+	unchanged := bytes.Equal(t.checksum, checksum[:])
+	t.mu.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	a, err := tokenfile.NewCSV(t.path)
+	if err != nil {
+		return fmt.Errorf("parsing token file: %w", err)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.checksum = checksum[:]
+	t.authenticator = a
+
+	return nil
+}
+
+// Run reloads the token file every interval until ctx is done.
+func (t *TokenFile) Run(ctx context.Context, interval time.Duration) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := t.Reload(); err != nil {
+			utilruntime.HandleErrorWithContext(ctx, err, "Failed to reload token file", "path", t.path)
+		}
+	}, interval)
+}
+
+// AuthenticateToken implements authenticator.Token.
+func (t *TokenFile) AuthenticateToken(ctx context.Context, token string) (*authenticator.Response, bool, error) {
+	t.mu.RLock()
+	a := t.authenticator
+	t.mu.RUnlock()
+
+	return a.AuthenticateToken(ctx, token)
+}
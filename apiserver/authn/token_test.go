@@ -0,0 +1,80 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package authn
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TokenFile", func() {
+	var path string
+
+	write := func(content string) {
+		GinkgoHelper()
+		Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+	}
+
+	authenticate := func(t *TokenFile, token string) (string, []string, bool) {
+		GinkgoHelper()
+		resp, ok, err := t.AuthenticateToken(context.Background(), token)
+		Expect(err).NotTo(HaveOccurred())
+		if !ok {
+			return "", nil, false
+		}
+
+		return resp.User.GetName(), resp.User.GetGroups(), true
+	}
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "tokens.csv")
+	})
+
+	It("should authenticate listed tokens", func() {
+		write("secret,robot,1,\"machines,readers\"\n")
+		t, err := NewTokenFile(path)
+		Expect(err).NotTo(HaveOccurred())
+
+		name, groups, ok := authenticate(t, "secret")
+		Expect(ok).To(BeTrue())
+		Expect(name).To(Equal("robot"))
+		Expect(groups).To(Equal([]string{"machines", "readers"}))
+
+		_, _, ok = authenticate(t, "other")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should pick up rotated tokens on reload", func() {
+		write("old,robot,1\n")
+		t, err := NewTokenFile(path)
+		Expect(err).NotTo(HaveOccurred())
+
+		write("new,robot,1\n")
+		Expect(t.Reload()).To(Succeed())
+		_, _, ok := authenticate(t, "old")
+		Expect(ok).To(BeFalse())
+		_, _, ok = authenticate(t, "new")
+		Expect(ok).To(BeTrue())
+	})
+
+	It("should keep the previous tokens if the file becomes invalid", func() {
+		write("secret,robot,1\n")
+		t, err := NewTokenFile(path)
+		Expect(err).NotTo(HaveOccurred())
+
+		write("invalid\n")
+		Expect(t.Reload()).NotTo(Succeed())
+		_, _, ok := authenticate(t, "secret")
+		Expect(ok).To(BeTrue())
+	})
+
+	It("should fail for a missing file", func() {
+		_, err := NewTokenFile(path)
+		Expect(err).To(HaveOccurred())
+	})
+})
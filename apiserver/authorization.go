@@ -0,0 +1,95 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+)
+
+// SubresourceVerb authorizes requests to a subresource with a custom verb instead of the verb of
+// the request, e.g. approve for the approve subresource, so RBAC can grant it separately:
+//
+//	rules:
+//	- apiGroups: ["example.com"]
+//	  resources: ["bars/approve"]
+//	  verbs: ["approve"]
+type SubresourceVerb struct {
+	Resource    schema.GroupResource
+	Subresource string
+	// RequestVerbs are the verbs of the requests which are authorized with Verb, e.g. update and
+	// patch. Empty matches all requests to the subresource.
+	RequestVerbs []string
+	Verb         string
+}
+
+// WithSubresourceVerbs authorizes requests to the given subresources with custom verbs. Requests
+// to other subresources are authorized with the verb of the request and the subresource, e.g.
+// update of bars/status.
+func (b *Builder) WithSubresourceVerbs(verbs ...SubresourceVerb) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, v := range verbs {
+		v.RequestVerbs = slices.Clone(v.RequestVerbs)
+		b.subresourceVerbs = append(b.subresourceVerbs, v)
+	}
+
+	return b
+}
+
+// validateSubresourceVerbs returns an error if a subresource verb is incomplete.
+func (c *completedConfig) validateSubresourceVerbs() error {
+	errs := []error{}
+	for _, v := range c.subresourceVerbs {
+		if v.Resource.Resource == "" || v.Subresource == "" || v.Verb == "" {
+			errs = append(errs, fmt.Errorf("subresource verb %q of %s/%s requires a resource, subresource and verb",
+				v.Verb, v.Resource, v.Subresource))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// applySubresourceVerbs decorates the authorizer of the server with the subresource verbs.
+func (c *completedConfig) applySubresourceVerbs(rc *genericapiserver.RecommendedConfig) {
+	if len(c.subresourceVerbs) == 0 || rc.Authorization.Authorizer == nil {
+		return
+	}
+	rc.Authorization.Authorizer = &subresourceVerbAuthorizer{delegate: rc.Authorization.Authorizer, verbs: c.subresourceVerbs}
+}
+
+// subresourceVerbAuthorizer authorizes requests to subresources with their custom verbs.
+type subresourceVerbAuthorizer struct {
+	delegate authorizer.Authorizer
+	verbs    []SubresourceVerb
+}
+
+func (a *subresourceVerbAuthorizer) Authorize(ctx context.Context, attrs authorizer.Attributes) (authorizer.Decision, string, error) {
+	if attrs.IsResourceRequest() && attrs.GetSubresource() != "" {
+		for _, v := range a.verbs {
+			if v.Resource.Group == attrs.GetAPIGroup() && v.Resource.Resource == attrs.GetResource() &&
+				v.Subresource == attrs.GetSubresource() && (len(v.RequestVerbs) == 0 || slices.Contains(v.RequestVerbs, attrs.GetVerb())) {
+				attrs = verbAttributes{Attributes: attrs, verb: v.Verb}
+
+				break
+			}
+		}
+	}
+
+	return a.delegate.Authorize(ctx, attrs)
+}
+
+// verbAttributes overrides the verb of authorization attributes.
+type verbAttributes struct {
+	authorizer.Attributes
+	verb string
+}
+
+func (a verbAttributes) GetVerb() string { return a.verb }
@@ -0,0 +1,89 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"context"
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/endpoints/filters"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	basecompatibility "k8s.io/component-base/compatibility"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// recordingAuthorizer allows all requests and records their attributes.
+type recordingAuthorizer struct {
+	attrs authorizer.Attributes
+}
+
+func (a *recordingAuthorizer) Authorize(_ context.Context, attrs authorizer.Attributes) (authorizer.Decision, string, error) {
+	a.attrs = attrs
+
+	return authorizer.DecisionAllow, "", nil
+}
+
+var _ = Describe("Subresource verbs", func() {
+	var (
+		recorder *recordingAuthorizer
+		authz    authorizer.Authorizer
+	)
+
+	// authorize authorizes a request as the authorization filter of the server does.
+	authorize := func(method, path string) authorizer.Attributes {
+		resolver := &request.RequestInfoFactory{APIPrefixes: sets.NewString("apis"), GrouplessAPIPrefixes: sets.NewString()}
+		req, err := http.NewRequest(method, path, nil)
+		Expect(err).NotTo(HaveOccurred())
+		info, err := resolver.NewRequestInfo(req)
+		Expect(err).NotTo(HaveOccurred())
+		attrs, err := filters.GetAuthorizerAttributes(request.WithRequestInfo(context.Background(), info))
+		Expect(err).NotTo(HaveOccurred())
+		_, _, err = authz.Authorize(context.Background(), attrs)
+		Expect(err).NotTo(HaveOccurred())
+
+		return recorder.attrs
+	}
+
+	BeforeEach(func() {
+		recorder = &recordingAuthorizer{}
+		authz = &subresourceVerbAuthorizer{delegate: recorder, verbs: []SubresourceVerb{{
+			Resource:     schema.GroupResource{Group: "test.opendefense.cloud", Resource: "bars"},
+			Subresource:  "approve",
+			RequestVerbs: []string{"update", "patch"},
+			Verb:         "approve",
+		}}}
+	})
+
+	It("should authorize subresources with the verb of the request by default", func() {
+		attrs := authorize(http.MethodPut, "/apis/test.opendefense.cloud/v1/namespaces/default/bars/a/status")
+		Expect(attrs.GetResource()).To(Equal("bars"))
+		Expect(attrs.GetSubresource()).To(Equal("status"))
+		Expect(attrs.GetVerb()).To(Equal("update"))
+	})
+
+	It("should authorize subresources with their custom verbs", func() {
+		attrs := authorize(http.MethodPut, "/apis/test.opendefense.cloud/v1/namespaces/default/bars/a/approve")
+		Expect(attrs.GetSubresource()).To(Equal("approve"))
+		Expect(attrs.GetVerb()).To(Equal("approve"))
+		Expect(attrs.GetName()).To(Equal("a"))
+
+		Expect(authorize(http.MethodGet, "/apis/test.opendefense.cloud/v1/namespaces/default/bars/a/approve").GetVerb()).
+			To(Equal("get"))
+		Expect(authorize(http.MethodPut, "/apis/other.opendefense.cloud/v1/namespaces/default/bars/a/approve").GetVerb()).
+			To(Equal("update"))
+	})
+
+	It("should reject incomplete subresource verbs", func() {
+		b := NewBuilder(runtime.NewScheme()).WithSubresourceVerbs(SubresourceVerb{Subresource: "approve", Verb: "approve"})
+		b.componentGlobalsRegistry = basecompatibility.NewComponentGlobalsRegistry()
+		_, err := b.complete()
+		Expect(err).To(MatchError(ContainSubstring("requires a resource, subresource and verb")))
+	})
+})
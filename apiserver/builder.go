@@ -4,31 +4,39 @@
 package apiserver
 
 import (
+	"cmp"
+	"context"
 	"fmt"
 	"maps"
-	"net"
+	"net/http"
+	"os"
+	"slices"
+	"sync"
+	"time"
 
-	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
-	utilerrors "k8s.io/apimachinery/pkg/util/errors"
-	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/version"
 	"k8s.io/apiserver/pkg/admission"
-	"k8s.io/apiserver/pkg/endpoints/openapi"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
 	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/apiserver/pkg/server/healthz"
 	genericoptions "k8s.io/apiserver/pkg/server/options"
-	"k8s.io/apiserver/pkg/util/compatibility"
-	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/component-base/cli"
 	basecompatibility "k8s.io/component-base/compatibility"
 	"k8s.io/component-base/featuregate"
-	baseversion "k8s.io/component-base/version"
 	openapicommon "k8s.io/kube-openapi/pkg/common"
-	netutils "k8s.io/utils/net"
 
+	"go.opendefense.cloud/kit/apiserver/accesslog"
+	"go.opendefense.cloud/kit/apiserver/chaos"
+	"go.opendefense.cloud/kit/apiserver/clientstats"
+	"go.opendefense.cloud/kit/apiserver/injection"
+	"go.opendefense.cloud/kit/apiserver/kitapi"
+	"go.opendefense.cloud/kit/apiserver/mirror"
+	"go.opendefense.cloud/kit/apiserver/profile"
+	"go.opendefense.cloud/kit/apiserver/reload"
 	"go.opendefense.cloud/kit/apiserver/rest"
 )
 
@@ -38,10 +46,11 @@ type ExtraAdmissionInitializers func(*genericapiserver.RecommendedConfig) (Share
 // RecommendedConfigFn is a callback that modifies the RecommendedConfig before the server starts.
 type RecommendedConfigFn func(*genericapiserver.RecommendedConfig)
 
-// SharedInformerFactory is used to start informer watching for resource changes.
+// SharedInformerFactory is used to start informer watching for resource changes. Factories of
+// client-go, which are started with a stop channel, are adapted with InformerFactory.
 type SharedInformerFactory interface {
-	// Start begins watching resources and blocks until stopCh is closed.
-	Start(stopCh <-chan struct{})
+	// Start begins watching resources until ctx is cancelled. It must not block.
+	Start(ctx context.Context)
 }
 
 type AddFlagsFn func(*pflag.FlagSet)
@@ -49,75 +58,242 @@ type AddFlagsFn func(*pflag.FlagSet)
 // APIGroupFn returns an APIGroupInfo for installing an API group into the server.
 type APIGroupFn func(scheme *runtime.Scheme, codecs serializer.CodecFactory, c *genericapiserver.CompletedConfig) genericapiserver.APIGroupInfo
 
+// newAPIGroupFn returns an APIGroupFn and the post-start hooks depending on its storage. It is called
+// once per completion, so servers built by the same Builder do not share the state of their API groups.
+type newAPIGroupFn func() (APIGroupFn, []postStartHook)
+
 // Builder constructs and runs a Kubernetes API server with custom resource groups.
 // It handles schema registration, storage configuration, admission, and lifecycle hooks.
+// A Builder is safe for concurrent use; Execute works on a snapshot of its configuration.
 type Builder struct {
+	// mu guards builderConfig.
+	mu sync.Mutex
+	builderConfig
+}
+
+// builderConfig holds the configuration collected by the Builder.
+type builderConfig struct {
 	componentName                          string
 	alternateDNS                           []string
 	scheme                                 *runtime.Scheme
 	codecs                                 serializer.CodecFactory
 	groupVersions                          []schema.GroupVersion
+	groupResources                         []schema.GroupResource
 	skipDefaultComponentGlobalsRegistrySet bool
 	extraAdmissionInitializers             ExtraAdmissionInitializers
 	sharedInformerFactories                []SharedInformerFactory
 	recommendedOptions                     *genericoptions.RecommendedOptions
 	componentGlobalsRegistry               basecompatibility.ComponentGlobalsRegistry
-	recommendedConfigFns                   []RecommendedConfigFn
-	apiGroupFns                            []APIGroupFn
+	configMutators                         []configMutator
+	apiGroupFns                            []newAPIGroupFn
+	openAPITitle                           string
+	openAPIVersion                         string
+	openAPIDefinitions                     []openapicommon.GetOpenAPIDefinitions
+	groupVersionLifecycles                 map[schema.GroupVersion]GroupVersionLifecycle
+	resourceValidateFns                    []func(*runtime.Scheme) error
+	resourceInfoFns                        []func(*runtime.Scheme) []ResourceInfo
 	addFlagsFns                            []AddFlagsFn
+	storageFaultInjector                   *chaos.Injector
+	postStartHooks                         []postStartHook
+	authenticatorFns                       []AuthenticatorFn
+	standalone                             bool
+	standaloneAuthorizer                   authorizer.Authorizer
+	accessLog                              *accesslog.Config
+	clientStats                            *clientstats.Config
+	requestMirror                          *mirror.Config
+	selfDescription                        bool
+	tlsPolicy                              *TLSPolicy
+	clientCertExpiryThreshold              time.Duration
+	delegatedAuth                          *DelegatedAuth
+	subresourceVerbs                       []SubresourceVerb
+	defaultingProfiles                     *profile.Registry
+	metadataInjection                      *injection.Config
+	continueTokenLifetime                  time.Duration
+	etcdOptionsFns                         []func(*genericoptions.EtcdOptions)
+	inMemoryStorage                        bool
+	identityFn                             rest.IdentityFunc
+	importMode                             bool
+	featureGates                           map[featuregate.Feature]featuregate.VersionedSpecs
+	binaryVersion                          string
+	emulationVersion                       string
+	minCompatibilityVersion                string
+	kubeVersionMapping                     func(*version.Version) *version.Version
+	groupInstallParallelism                int
+	alphaResourcesOptIn                    bool
+	livezChecks                            []healthz.HealthChecker
+	readyzChecks                           []healthz.HealthChecker
+	startupChecks                          []healthz.HealthChecker
+	livezExcludes                          []string
+	livezGracePeriod                       time.Duration
+	reload                                 bool
+	reloadFns                              map[string]reload.Func
+	admissionPlugins                       []modulePlugin
+	unknownModules                         []string
+}
+
+// postStartHook is a named hook run after the server has started.
+type postStartHook struct {
+	name string
+	fn   genericapiserver.PostStartHookFunc
+	// after are the names of the hooks which must complete before the hook is run.
+	after []string
+	// failurePolicy defines whether the server fails if the hook fails.
+	failurePolicy FailurePolicy
 }
 
 // NewBuilder creates a new API server builder with the given runtime scheme.
+// The types required by the generic API server are added to the scheme, see kitapi.AddServerTypes.
 func NewBuilder(scheme *runtime.Scheme) *Builder {
-	return &Builder{
+	kitapi.AddServerTypes(scheme)
+
+	return &Builder{builderConfig: builderConfig{
 		scheme:                  scheme,
 		codecs:                  serializer.NewCodecFactory(scheme),
 		sharedInformerFactories: []SharedInformerFactory{},
-		apiGroupFns:             []APIGroupFn{},
+		apiGroupFns:             []newAPIGroupFn{},
 		groupVersions:           []schema.GroupVersion{},
 		addFlagsFns:             []AddFlagsFn{},
-	}
+	}}
 }
 
 // WithComponentName sets the component name used for server identification and logging.
 func (b *Builder) WithComponentName(n string) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
 	b.componentName = n
+
+	return b
+}
+
+// WithFeatureGates adds feature gates to the component, which are enabled depending on the
+// emulation version of the component unless set by --feature-gates=<component>:<feature>=true:
+//
+//	builder.WithFeatureGates(map[featuregate.Feature]featuregate.VersionedSpecs{
+//	    "BanFlunder": {
+//	        {Version: version.MustParse("1.0"), Default: false, PreRelease: featuregate.Alpha},
+//	        {Version: version.MustParse("1.1"), Default: true, PreRelease: featuregate.Beta},
+//	    },
+//	})
+//
+// Strategies and admission plugins check them with the feature gate of the component in the
+// registry of k8s.io/apiserver/pkg/util/compatibility:
+//
+//	compatibility.DefaultComponentGlobalsRegistry.FeatureGateFor("foo").Enabled("BanFlunder")
+//
+// Later calls add further gates and replace gates of the same name.
+func (b *Builder) WithFeatureGates(specs map[featuregate.Feature]featuregate.VersionedSpecs) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.featureGates == nil {
+		b.featureGates = map[featuregate.Feature]featuregate.VersionedSpecs{}
+	}
+	maps.Copy(b.featureGates, specs)
+
 	return b
 }
 
 // WithOpenAPIDefinitions configures OpenAPI (Swagger) documentation for the API server.
+// Definitions registered with kitapi.RegisterOpenAPIDefinitions are served as well, so
+// defs may be nil to only set the title and version of the documentation.
 func (b *Builder) WithOpenAPIDefinitions(name, version string, defs openapicommon.GetOpenAPIDefinitions) *Builder {
-	b.recommendedConfigFns = append(b.recommendedConfigFns, func(config *genericapiserver.RecommendedConfig) {
-		config.OpenAPIConfig = genericapiserver.DefaultOpenAPIConfig(defs, openapi.NewDefinitionNamer(b.scheme))
-		config.OpenAPIConfig.Info.Title = name
-		config.OpenAPIConfig.Info.Version = version
-
-		config.OpenAPIV3Config = genericapiserver.DefaultOpenAPIV3Config(defs, openapi.NewDefinitionNamer(b.scheme))
-		config.OpenAPIV3Config.Info.Title = name
-		config.OpenAPIV3Config.Info.Version = name
-	})
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.openAPITitle = name
+	b.openAPIVersion = version
+	if defs != nil {
+		b.openAPIDefinitions = append(b.openAPIDefinitions, defs)
+	}
 
 	return b
 }
 
 // WithAPIGroupFn registers an APIGroupFn to install an API group into the server.
 func (b *Builder) WithAPIGroupFn(fn APIGroupFn) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
 	if fn == nil {
 		return b
 	}
-	b.apiGroupFns = append(b.apiGroupFns, fn)
+	b.apiGroupFns = append(b.apiGroupFns, func() (APIGroupFn, []postStartHook) {
+		return fn, nil
+	})
 
 	return b
 }
 
-// With registers a ResourceHandler's API group and group versions.
+// With registers a ResourceHandler's API group, group versions and post-start hooks.
+// Later changes to the ResourceHandler do not affect the Builder. Each resource must only be
+// registered once, otherwise completing the Builder fails.
 func (b *Builder) With(rh ResourceHandler) *Builder {
-	_ = b.WithAPIGroupFn(rh.apiGroupFn)
-	return b.WithGroupVersions(rh.groupVersions...)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	opts := rh.options.clone()
+	if rh.newAPIGroup != nil {
+		b.apiGroupFns = append(b.apiGroupFns, func() (APIGroupFn, []postStartHook) {
+			return rh.newAPIGroup(opts.clone())
+		})
+	}
+	if rh.validateFn != nil {
+		b.resourceValidateFns = append(b.resourceValidateFns, func(scheme *runtime.Scheme) error {
+			return rh.validateFn(scheme, opts)
+		})
+	}
+	if rh.infoFn != nil {
+		b.resourceInfoFns = append(b.resourceInfoFns, func(scheme *runtime.Scheme) []ResourceInfo {
+			return rh.infoFn(scheme, opts)
+		})
+	}
+	if !rh.groupResource.Empty() {
+		b.groupResources = append(b.groupResources, rh.groupResource)
+	}
+	b.groupVersions = appendGroupVersions(b.groupVersions, rh.groupVersions...)
+
+	return b
+}
+
+// Resources returns the resources registered with With, e.g. to generate RBAC rules or
+// documentation, sorted by group, version and resource. API groups registered with
+// WithAPIGroupFn are not included. Whether the resources are served is only known once the
+// server runs, as group versions may be disabled by the emulation version or --runtime-config.
+func (b *Builder) Resources() []ResourceInfo {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	infos := []ResourceInfo{}
+	for _, fn := range b.resourceInfoFns {
+		infos = append(infos, fn(b.scheme)...)
+	}
+	slices.SortStableFunc(infos, func(a, b ResourceInfo) int {
+		return cmp.Or(cmp.Compare(a.Group, b.Group), cmp.Compare(a.Version, b.Version), cmp.Compare(a.Resource, b.Resource))
+	})
+
+	return infos
+}
+
+// WithPostStartHook registers a hook which is run once the server has started.
+// Hook names must be unique. Options declare the hooks it runs after and its failure policy:
+//
+//	builder.WithPostStartHook("sync-inventory", syncInventory,
+//	    apiserver.RunAfter(apiserver.PostStartHookInformersSynced),
+//	    apiserver.OnFailure(apiserver.FailurePolicyIgnore))
+func (b *Builder) WithPostStartHook(name string, fn genericapiserver.PostStartHookFunc, opts ...PostStartHookOption) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if fn == nil {
+		return b
+	}
+	hook := postStartHook{name: name, fn: fn}
+	for _, opt := range opts {
+		opt(&hook)
+	}
+	b.postStartHooks = append(b.postStartHooks, hook)
+
+	return b
 }
 
 // WithExtraAdmissionInitializers sets custom admission plugin initialization logic.
 func (b *Builder) WithExtraAdmissionInitializers(f ExtraAdmissionInitializers) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
 	if f == nil {
 		return b
 	}
@@ -128,6 +304,8 @@ func (b *Builder) WithExtraAdmissionInitializers(f ExtraAdmissionInitializers) *
 
 // WithSharedInformerFactory registers a SharedInformerFactory to be started when the server starts.
 func (b *Builder) WithSharedInformerFactory(f SharedInformerFactory) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
 	if f == nil {
 		return b
 	}
@@ -138,6 +316,8 @@ func (b *Builder) WithSharedInformerFactory(f SharedInformerFactory) *Builder {
 
 // WithFlags registers AddFlagsFn functions to be called when creating the command.
 func (b *Builder) WithFlags(fns ...AddFlagsFn) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
 	for _, fn := range fns {
 		if fn == nil {
 			continue
@@ -148,210 +328,198 @@ func (b *Builder) WithFlags(fns ...AddFlagsFn) *Builder {
 	return b
 }
 
+// WithAccessLog enables structured access logging of sampled requests.
+func (b *Builder) WithAccessLog(c accesslog.Config) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.accessLog = &c
+
+	return b
+}
+
+// WithClientStats counts the requests of clients to the resources of the server by user, user
+// agent, verb and resource, and serves the clients with the most requests at clientstats.Path,
+// e.g. to identify controllers hammering the server.
+func (b *Builder) WithClientStats(c clientstats.Config) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.clientStats = &c
+
+	return b
+}
+
+// WithRequestMirroring sends copies of sampled get and list requests to a secondary server, e.g.
+// to validate a new version of the server before migrating to it, see mirror.Config.
+func (b *Builder) WithRequestMirroring(c mirror.Config) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.requestMirror = &c
+
+	return b
+}
+
+// WithDefaultingProfiles defaults created objects by the profile selected for their namespace,
+// see profile.Registry.Select. The profiles are applied by an admission plugin running after
+// the namespace lifecycle plugin and before admission webhooks, which requires a kube-apiserver.
+func (b *Builder) WithDefaultingProfiles(r *profile.Registry) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.defaultingProfiles = r
+
+	return b
+}
+
+// WithMetadataInjection adds standard labels and annotations to the objects created in all
+// resources of the server, e.g. app.kubernetes.io/managed-by, see injection.Config. They are
+// added by an admission plugin running after the defaulting profiles and the admission plugins
+// of modules, so labels and annotations set by them or by clients are kept.
+func (b *Builder) WithMetadataInjection(c injection.Config) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.metadataInjection = &c
+
+	return b
+}
+
+// WithContinueTokenLifetime keeps the continue tokens of paginated lists valid for at least d,
+// instead of the default etcd compaction interval of 5 minutes. A token expires once the
+// revision it has been issued at is compacted, which happens in the interval configured by
+// --etcd-compaction-interval. Lists continued with an expired token fail with an Expired
+// status, and clients have to restart the list. Longer lifetimes keep more revisions in etcd.
+func (b *Builder) WithContinueTokenLifetime(d time.Duration) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.continueTokenLifetime = d
+
+	return b
+}
+
+// WithEtcdOptions configures the etcd options of the server with fn, e.g. the storage prefix,
+// which defaults to /registry/<group> of the first registered group, timeouts or TLS:
+//
+//	builder.WithEtcdOptions(func(o *genericoptions.EtcdOptions) {
+//	    o.StorageConfig.Prefix = "/foo"
+//	    o.StorageConfig.Transport.TrustedCAFile = "/etc/foo/etcd-ca.crt"
+//	    o.StorageConfig.DBMetricPollInterval = time.Minute
+//	})
+//
+// The functions are applied in the order they are added, after WithContinueTokenLifetime. The
+// values set by them are the defaults of the --etcd-* flags, which take precedence. The encoding
+// of the stored objects is always configured by the Builder.
+func (b *Builder) WithEtcdOptions(fn func(*genericoptions.EtcdOptions)) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.etcdOptionsFns = append(b.etcdOptionsFns, fn)
+
+	return b
+}
+
+// WithInMemoryStorage keeps the objects of all resources in memory instead of etcd, so the
+// server runs locally without etcd, e.g. for demos and development. All objects are lost when
+// the server stops. The --etcd-* flags are removed and the etcd options are ignored. See
+// package memstorage.
+func (b *Builder) WithInMemoryStorage() *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.inMemoryStorage = true
+
+	return b
+}
+
+// WithObjectIdentity sets the identity of objects of all resources with fn when they are
+// created, e.g. deterministic UIDs when importing objects from another server, so references by
+// UID like owner references stay valid. See rest.IdentityFunc.
+func (b *Builder) WithObjectIdentity(fn rest.IdentityFunc) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.identityFn = fn
+
+	return b
+}
+
+// WithGroupInstallParallelism builds the storage of up to n registered API groups concurrently
+// at startup, to reduce the boot time of servers serving many resources. API groups are still
+// installed one after another in the order of their registration, and errors are reported in
+// that order. Values below 2 build the API groups sequentially, the default. Functions
+// registered with WithAPIGroupFn must be safe to run concurrently; those of Resource are.
+func (b *Builder) WithGroupInstallParallelism(n int) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.groupInstallParallelism = n
+
+	return b
+}
+
 // WithGroupVersions appends the  group versions to configure storage
 // encoding/decoding for the API server. This must be provided by callers
 // so that the storage codec matches the registered types in the scheme.
+// Group versions which have been added before are skipped.
 func (b *Builder) WithGroupVersions(gvs ...schema.GroupVersion) *Builder {
-	b.groupVersions = append(b.groupVersions, gvs...)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.groupVersions = appendGroupVersions(b.groupVersions, gvs...)
+
 	return b
 }
 
-// Execute builds and runs the API server, returning an exit code suitable for os.Exit().
-// It configures storage, admission, informers, and launches the server with all registered resources.
-func (b *Builder) Execute() int {
-	// Validate that all group versions belong to the same API group.
-	groupName := ""
-	for _, gv := range b.groupVersions {
-		if groupName != "" && groupName != gv.Group {
-			panic("all exposed resources expected to have the same group")
-		}
-		groupName = gv.Group
-	}
-	// Get the ordered group versions to ensure storage encoding matches the registered types.
-	orderedGroupVersions := b.scheme.PrioritizedVersionsForGroup(groupName)
-
-	// Set up default recommended options if not already configured.
-	if b.recommendedOptions == nil {
-		b.recommendedOptions = genericoptions.NewRecommendedOptions(
-			fmt.Sprintf("/registry/%s", groupName),
-			b.codecs.LegacyCodec(orderedGroupVersions...),
-		)
-	}
-	// Configure storage to use the ordered group versions for encoding.
-	b.recommendedOptions.Etcd.StorageConfig.EncodeVersioner = schema.GroupVersions(orderedGroupVersions)
-	// Wire up admission initializers if provided.
-	if b.extraAdmissionInitializers != nil {
-		b.recommendedOptions.ExtraAdmissionInitializers = func(c *genericapiserver.RecommendedConfig) ([]admission.PluginInitializer, error) {
-			informerFactory, pluginInitialisers, err := b.extraAdmissionInitializers(c)
-			if err != nil {
-				return nil, err
-			}
-			// Collect informer factories from admission setup.
-			b.sharedInformerFactories = append(b.sharedInformerFactories, informerFactory)
-
-			return pluginInitialisers, nil
+// appendGroupVersions appends the group versions of add which are not in gvs yet.
+func appendGroupVersions(gvs []schema.GroupVersion, add ...schema.GroupVersion) []schema.GroupVersion {
+	for _, gv := range add {
+		if !slices.Contains(gvs, gv) {
+			gvs = append(gvs, gv)
 		}
 	}
-	// Set up TLS certificates for secure serving if possible and not otherwise provided.
-	_ = b.recommendedOptions.SecureServing.MaybeDefaultWithSelfSignedCerts("localhost", b.alternateDNS, []net.IP{netutils.ParseIPSloppy("127.0.0.1")})
 
-	// Use default component registry if not provided.
-	if b.componentGlobalsRegistry == nil {
-		b.componentGlobalsRegistry = compatibility.DefaultComponentGlobalsRegistry
-	}
+	return gvs
+}
 
-	ctx := genericapiserver.SetupSignalContext()
-	cmd := &cobra.Command{
-		Short: "Launch API server",
-		Long:  "Launch API server",
-		PersistentPreRunE: func(*cobra.Command, []string) error {
-			if b.skipDefaultComponentGlobalsRegistrySet {
-				return nil
-			}
-
-			return b.componentGlobalsRegistry.Set()
-		},
-		RunE: func(c *cobra.Command, args []string) error {
-			// Validate essential builder configuration early to provide a helpful error
-			if len(orderedGroupVersions) == 0 {
-				return fmt.Errorf("orderedGroupVersions not set on Builder; call WithGroupVersions(...) before Execute")
-			}
-			// Collect and validate all configuration.
-			errors := []error{}
-			errors = append(errors, b.recommendedOptions.Validate()...)
-			errors = append(errors, b.componentGlobalsRegistry.Validate()...)
-			if err := utilerrors.NewAggregate(errors); err != nil {
-				return err
-			}
-
-			serverConfig := genericapiserver.NewRecommendedConfig(b.codecs)
-
-			// Apply custom configuration functions.
-			for _, fn := range b.recommendedConfigFns {
-				fn(serverConfig)
-			}
-
-			// Set feature gates and versioning.
-			serverConfig.FeatureGate = b.componentGlobalsRegistry.FeatureGateFor(basecompatibility.DefaultKubeComponent)
-			serverConfig.EffectiveVersion = b.componentGlobalsRegistry.EffectiveVersionFor(b.componentName)
-
-			// Apply recommended options (TLS, etcd, admission, etc.).
-			if err := b.recommendedOptions.ApplyTo(serverConfig); err != nil {
-				return err
-			}
-
-			// Create the fully configured API server.
-			completedConfig := serverConfig.Complete()
-			server, err := completedConfig.New(fmt.Sprintf("%s-apiserver", b.componentName), genericapiserver.NewEmptyDelegate())
-			if err != nil {
-				return err
-			}
-
-			// Build API groups from registered handlers and install them into the server.
-			apiGroupMap := map[string]*genericapiserver.APIGroupInfo{}
-			for _, fn := range b.apiGroupFns {
-				apiGroupInfo := fn(b.scheme, b.codecs, &completedConfig)
-				groupName := ""
-				for _, gv := range apiGroupInfo.PrioritizedVersions {
-					groupName = gv.Group
-					break
-				}
-				if groupName == "" {
-					return fmt.Errorf("empty group name is not allowed")
-				}
-
-				// Merge resources from multiple handlers for the same group.
-				if apiGroupInfoPrev, ok := apiGroupMap[groupName]; ok {
-					apiGroupInfoPrev.VersionedResourcesStorageMap = mergeVersionedResourcesStorageMap(apiGroupInfoPrev.VersionedResourcesStorageMap, apiGroupInfo.VersionedResourcesStorageMap)
-				} else {
-					apiGroupMap[groupName] = &apiGroupInfo
-				}
-
-			}
-
-			// Install all API groups into the server.
-			for _, apiGroupInfo := range apiGroupMap {
-				if err := server.InstallAPIGroup(apiGroupInfo); err != nil {
-					return err
-				}
-			}
-
-			// Register post-start hook to start informers once server is ready.
-			server.AddPostStartHookOrDie(fmt.Sprintf("start-%s-server-informers", b.componentName), func(context genericapiserver.PostStartHookContext) error {
-				// Defensive: the SharedInformerFactory may not be set by the recommended options
-				// in all call sites (callers may provide their own factories via WithSharedInformerFactory).
-				// Avoid a nil-pointer panic by checking for nil before starting.
-				if serverConfig.SharedInformerFactory != nil {
-					serverConfig.SharedInformerFactory.Start(context.Done())
-				}
-				for _, sharedInformerFactory := range b.sharedInformerFactories {
-					sharedInformerFactory.Start(context.Done())
-				}
-
-				return nil
-			})
-
-			return server.PrepareRun().RunWithContext(ctx)
-		},
-	}
-	cmd.SetContext(ctx)
-
-	flags := cmd.Flags()
-	b.recommendedOptions.AddFlags(flags)
-
-	// Register component versions and feature gates with the global registry.
-	// TODO: expose to builder
-	defaultVersion := "1.2"
-	// Register the "ARC" component with the global component registry,
-	// associating it with its effective version and feature gate configuration.
-	// Will skip if the component has been registered, like in the integration test.
-	_, _ = b.componentGlobalsRegistry.ComponentGlobalsOrRegister(
-		b.componentName, basecompatibility.NewEffectiveVersionFromString(defaultVersion, "", ""),
-		featuregate.NewVersionedFeatureGate(version.MustParse(defaultVersion)))
-
-	// Add versioned feature specifications for the "BanFlunder" feature.
-	// These specifications, together with the effective version, determine if the feature is enabled.
-	// TODO: expose to builder
-	// utilruntime.Must(arcFeatureGate.AddVersioned(map[featuregate.Feature]featuregate.VersionedSpecs{
-	// 	"BanFlunder": {
-	// 		{Version: version.MustParse("1.0"), Default: false, PreRelease: featuregate.Alpha},
-	// 		{Version: version.MustParse("1.1"), Default: true, PreRelease: featuregate.Beta},
-	// 		{Version: version.MustParse("1.2"), Default: true, PreRelease: featuregate.GA, LockToDefault: true},
-	// 	},
-	// }))
-
-	// Register the default kube component if not already present in the global registry.
-	_, _ = b.componentGlobalsRegistry.ComponentGlobalsOrRegister(basecompatibility.DefaultKubeComponent,
-		basecompatibility.NewEffectiveVersionFromString(baseversion.DefaultKubeBinaryVersion, "", ""), utilfeature.DefaultMutableFeatureGate)
-
-	// Set the emulation version mapping from the "ARC" component to the kube component.
-	// This ensures that the emulation version of the latter is determined by the emulation version of the former.
-
-	versionToKubeVersion := func(ver *version.Version) *version.Version {
-		if ver.Major() != 1 {
-			return nil
-		}
-		kubeVer := version.MustParse(baseversion.DefaultKubeBinaryVersion)
-		// nolint:gosec
-		offset := int(ver.Minor()) - 2
-		mappedVer := kubeVer.OffsetMinor(offset)
-		if mappedVer.GreaterThan(kubeVer) {
-			return kubeVer
-		}
+// Execute builds and runs the API server from the command line flags in os.Args, returning an exit
+// code suitable for os.Exit(). The server is stopped on SIGTERM or SIGINT. Errors are logged once
+// logging has been initialized from the flags, and printed to stderr before.
+func (b *Builder) Execute() int {
+	s, err := b.Complete()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 
-		return mappedVer
+		return 1
 	}
-	utilruntime.Must(b.componentGlobalsRegistry.SetVersionMapping(b.componentName, basecompatibility.DefaultKubeComponent, versionToKubeVersion))
+	cmd := s.config.command(genericapiserver.SetupSignalContext())
+	cmd.SetArgs(os.Args[1:])
 
-	b.componentGlobalsRegistry.AddFlags(flags)
+	return cli.Run(cmd)
+}
 
-	for _, addFlags := range b.addFlagsFns {
-		addFlags(flags)
+// ExecuteContext builds the API server from the command line arguments args, without the program
+// name, and runs it until ctx is done. It configures storage, admission, informers, and launches the
+// server with all registered resources. The server is built from a snapshot of the Builder's
+// configuration. Errors are returned instead of printed.
+func (b *Builder) ExecuteContext(ctx context.Context, args []string) error {
+	s, err := b.Complete()
+	if err != nil {
+		return err
+	}
+	cmd := s.config.command(ctx)
+	// An empty slice prevents cobra from falling back to os.Args.
+	if args == nil {
+		args = []string{}
 	}
+	cmd.SetArgs(args)
 
-	// TODO: add kube version compatibility matrix and feature gates
+	return cli.RunNoErrOutput(cmd)
+}
 
-	return cli.Run(cmd)
+// withUserAgent wraps the handler chain built by delegate to store the user agent of each request
+// and whether the client opted into canary implementations in its context.
+func withUserAgent(delegate func(http.Handler, *genericapiserver.Config) http.Handler) func(http.Handler, *genericapiserver.Config) http.Handler {
+	return func(apiHandler http.Handler, c *genericapiserver.Config) http.Handler {
+		handler := delegate(apiHandler, c)
+
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			ctx := rest.WithUserAgent(req.Context(), req.UserAgent())
+			ctx = rest.WithCanaryRequested(ctx, req.Header.Get(rest.CanaryHeader) == "true")
+			handler.ServeHTTP(w, req.WithContext(ctx))
+		})
+	}
 }
 
 // mergeVersionedResourcesStorageMap combines two versioned storage maps, allowing multiple
@@ -4,10 +4,35 @@
 package apiserver
 
 import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/apiserver/pkg/admission/plugin/namespace/lifecycle"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	genericoptions "k8s.io/apiserver/pkg/server/options"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/storagebackend"
+	"k8s.io/apiserver/pkg/storage/storagebackend/factory"
+	"k8s.io/client-go/tools/cache"
+	basecompatibility "k8s.io/component-base/compatibility"
+	"k8s.io/component-base/featuregate"
+	baseversion "k8s.io/component-base/version"
+	openapicommon "k8s.io/kube-openapi/pkg/common"
+
+	"go.opendefense.cloud/kit/apiserver/injection"
+	"go.opendefense.cloud/kit/apiserver/mirror"
+	"go.opendefense.cloud/kit/apiserver/profile"
+	"go.opendefense.cloud/kit/apiserver/reload"
 	"go.opendefense.cloud/kit/apiserver/rest"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -219,6 +244,218 @@ func (m *mockStorage) GetObjectKind() schema.ObjectKind {
 	return schema.EmptyObjectKind
 }
 
+var _ = Describe("complete", func() {
+	var b *Builder
+
+	BeforeEach(func() {
+		gv := schema.GroupVersion{Group: "test.opendefense.cloud", Version: "v1"}
+		scheme := runtime.NewScheme()
+		// The group versions are ordered by the priority of the versions registered in the scheme.
+		scheme.AddKnownTypeWithName(gv.WithKind("MockResourceList"), &mockResourceList{})
+		b = NewBuilder(scheme).
+			WithComponentName("test").
+			WithGroupVersions(gv)
+		b.componentGlobalsRegistry = basecompatibility.NewComponentGlobalsRegistry()
+	})
+
+	It("should add the types required by the generic API server to the scheme", func() {
+		Expect(b.scheme.Recognizes(schema.GroupVersion{Version: "v1"}.WithKind("ListOptions"))).To(BeTrue())
+	})
+
+	It("should complete the same builder multiple times", func() {
+		first, err := b.complete()
+		Expect(err).NotTo(HaveOccurred())
+		second, err := b.complete()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(first.recommendedOptions).NotTo(BeIdenticalTo(second.recommendedOptions))
+		Expect(first.componentGlobalsRegistry.EffectiveVersionFor("test")).NotTo(BeNil())
+	})
+
+	It("should not be affected by later changes to the builder", func() {
+		c, err := b.complete()
+		Expect(err).NotTo(HaveOccurred())
+		b.WithComponentName("changed").
+			WithGroupVersions(schema.GroupVersion{Group: "test.opendefense.cloud", Version: "v2"})
+		Expect(c.componentName).To(Equal("test"))
+		Expect(c.groupVersions).To(ConsistOf(schema.GroupVersion{Group: "test.opendefense.cloud", Version: "v1"}))
+	})
+
+	It("should parse the given arguments instead of os.Args", func() {
+		Expect(b.ExecuteContext(context.Background(), []string{"--unknown"})).To(MatchError(ContainSubstring("unknown flag: --unknown")))
+		Expect(b.ExecuteContext(context.Background(), []string{"--runtime-config=unknown.opendefense.cloud/v1=false"})).
+			To(MatchError(ContainSubstring("unknown.opendefense.cloud")))
+		// os.Args holds the flags of the test binary, which would be rejected as well.
+		Expect(b.ExecuteContext(context.Background(), nil)).To(MatchError(ContainSubstring("--etcd-servers")))
+	})
+
+	It("should validate the completed server", func() {
+		s, err := b.Complete()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(s.Validate()).To(MatchError(ContainSubstring("--etcd-servers")))
+
+		s.config.recommendedOptions.Etcd.StorageConfig.Transport.ServerList = []string{"http://127.0.0.1:2379"}
+		Expect(s.Validate()).To(Succeed())
+	})
+
+	It("should fail validation without group versions", func() {
+		s, err := NewBuilder(runtime.NewScheme()).Complete()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(s.Validate()).To(MatchError(ContainSubstring("WithGroupVersions")))
+	})
+
+	It("should configure OpenAPI definitions", func() {
+		defs := func(openapicommon.ReferenceCallback) map[string]openapicommon.OpenAPIDefinition {
+			return map[string]openapicommon.OpenAPIDefinition{"test.Test": {}}
+		}
+		c, err := b.WithOpenAPIDefinitions("Test API", "v0.1.0", defs).complete()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.configMutators).NotTo(BeEmpty())
+		Expect(c.configMutators[0].name).To(Equal(ConfigMutatorOpenAPI))
+
+		config := genericapiserver.NewRecommendedConfig(c.codecs)
+		c.configMutators[0].fn(config)
+		Expect(config.OpenAPIConfig.Info.Title).To(Equal("Test API"))
+		Expect(config.OpenAPIV3Config.Info.Version).To(Equal("v0.1.0"))
+		Expect(config.OpenAPIConfig.GetDefinitions(nil)).To(HaveKey("test.Test"))
+	})
+
+	It("should serve multiple groups", func() {
+		test := schema.GroupVersion{Group: "test.opendefense.cloud", Version: "v1"}
+		other := schema.GroupVersion{Group: "other.opendefense.cloud", Version: "v1alpha1"}
+		b.scheme.AddKnownTypes(test, &metav1.Status{})
+		b.scheme.AddKnownTypes(other, &metav1.Status{})
+		b.WithGroupVersions(other)
+
+		c, err := b.complete()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.orderedGroupVersions).To(Equal([]schema.GroupVersion{test, other}))
+		Expect(c.recommendedOptions.Etcd.StorageConfig.Prefix).To(Equal("/registry/test.opendefense.cloud"))
+	})
+
+	It("should skip group versions added before", func() {
+		gv := schema.GroupVersion{Group: "test.opendefense.cloud", Version: "v1"}
+		v2 := schema.GroupVersion{Group: gv.Group, Version: "v2"}
+		b.WithGroupVersions(gv, v2, v2)
+		Expect(b.groupVersions).To(Equal([]schema.GroupVersion{gv, v2}))
+	})
+
+	It("should keep continue tokens valid for their lifetime", func() {
+		c, err := b.complete()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.recommendedOptions.Etcd.StorageConfig.CompactionInterval).To(Equal(5 * time.Minute))
+
+		c, err = b.WithContinueTokenLifetime(time.Hour).complete()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.recommendedOptions.Etcd.StorageConfig.CompactionInterval).To(Equal(time.Hour))
+	})
+
+	It("should apply the etcd options in order", func() {
+		c, err := b.WithContinueTokenLifetime(time.Hour).
+			WithEtcdOptions(func(o *genericoptions.EtcdOptions) {
+				o.StorageConfig.Prefix = "/foo"
+				o.StorageConfig.CompactionInterval = 2 * time.Hour
+			}).
+			WithEtcdOptions(func(o *genericoptions.EtcdOptions) { o.StorageConfig.Prefix += "/bar" }).
+			complete()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.recommendedOptions.Etcd.StorageConfig.Prefix).To(Equal("/foo/bar"))
+		Expect(c.recommendedOptions.Etcd.StorageConfig.CompactionInterval).To(Equal(2 * time.Hour))
+	})
+
+	It("should add the feature gates to the component", func() {
+		b.WithFeatureGates(map[featuregate.Feature]featuregate.VersionedSpecs{
+			"BanFlunder": {
+				{Version: version.MustParse("1.0"), Default: false, PreRelease: featuregate.Alpha},
+				{Version: version.MustParse("1.1"), Default: true, PreRelease: featuregate.Beta},
+			},
+			"Frobnicate": {{Version: version.MustParse("1.3"), Default: false, PreRelease: featuregate.Alpha}},
+		})
+		c, err := b.complete()
+		Expect(err).NotTo(HaveOccurred())
+		gate := c.componentGlobalsRegistry.FeatureGateFor("test")
+		Expect(gate.Enabled("BanFlunder")).To(BeTrue())
+		Expect(gate.Enabled("Frobnicate")).To(BeFalse())
+		// Completing again keeps the gates added before.
+		_, err = b.complete()
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should set the effective version of the component", func() {
+		c, err := b.WithEffectiveVersion("1.4", "1.3", "").complete()
+		Expect(err).NotTo(HaveOccurred())
+		v := c.componentGlobalsRegistry.EffectiveVersionFor("test")
+		Expect(v.BinaryVersion().String()).To(Equal("1.4"))
+		Expect(v.EmulationVersion().String()).To(Equal("1.3"))
+		Expect(v.MinCompatibilityVersion().String()).To(Equal("1.2"))
+
+		_, err = b.WithEffectiveVersion("1.4", "1.5", "").complete()
+		Expect(err).To(MatchError(ContainSubstring("invalid effective version")))
+	})
+
+	It("should map the binary version of the component to the Kubernetes version", func() {
+		kubeVersion := version.MustParse(baseversion.DefaultKubeBinaryVersion)
+		mapping := defaultKubeVersionMapping(version.MustParse("1.4"))
+		Expect(mapping(version.MustParse("1.4")).String()).To(Equal(kubeVersion.String()))
+		Expect(mapping(version.MustParse("1.3")).String()).To(Equal(kubeVersion.SubtractMinor(1).String()))
+		Expect(mapping(version.MustParse("1.5")).String()).To(Equal(kubeVersion.String()))
+		Expect(mapping(version.MustParse("2.0"))).To(BeNil())
+	})
+
+	It("should drop the etcd options when keeping objects in memory", func() {
+		c, err := b.WithInMemoryStorage().complete()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.recommendedOptions.Etcd).To(BeNil())
+	})
+
+	It("should reject resources registered more than once", func() {
+		obj := &mockResourceObject{gr: schema.GroupResource{Group: "test.opendefense.cloud", Resource: "testresources"}}
+		gv := schema.GroupVersion{Group: "test.opendefense.cloud", Version: "v1"}
+		b.With(Resource(obj, gv)).With(Resource(obj, gv))
+		_, err := b.complete()
+		Expect(err).To(MatchError(ContainSubstring("resource testresources.test.opendefense.cloud is registered more than once")))
+	})
+
+	It("should apply defaulting profiles after the namespace lifecycle", func() {
+		c, err := b.WithDefaultingProfiles(profile.NewRegistry()).complete()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.recommendedOptions.Admission.Plugins.Registered()).To(ContainElement(profile.PluginName))
+		order := c.recommendedOptions.Admission.RecommendedPluginOrder
+		Expect(order[:2]).To(Equal([]string{lifecycle.PluginName, profile.PluginName}))
+	})
+
+	It("should inject metadata after the defaulting profiles", func() {
+		c, err := b.WithDefaultingProfiles(profile.NewRegistry()).
+			WithMetadataInjection(injection.Config{Labels: map[string]string{injection.LabelManagedBy: "test"}}).
+			complete()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.recommendedOptions.Admission.Plugins.Registered()).To(ContainElement(injection.PluginName))
+		order := c.recommendedOptions.Admission.RecommendedPluginOrder
+		Expect(order[:3]).To(Equal([]string{lifecycle.PluginName, profile.PluginName, injection.PluginName}))
+	})
+
+	It("should reject invalid metadata injection", func() {
+		_, err := b.WithMetadataInjection(injection.Config{Labels: map[string]string{"invalid key": "test"}}).complete()
+		Expect(err).To(MatchError(ContainSubstring("invalid metadata injection")))
+	})
+
+	It("should reject invalid request mirroring", func() {
+		_, err := b.WithRequestMirroring(mirror.Config{URL: "foo-apiserver-next", SampleRate: 0.1}).complete()
+		Expect(err).To(MatchError(ContainSubstring("invalid request mirroring")))
+	})
+
+	It("should make the audit policy reloadable", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "policy.yaml")
+		Expect(os.WriteFile(path, []byte("apiVersion: audit.k8s.io/v1\nkind: Policy\nrules:\n- level: Metadata\n"), 0o600)).To(Succeed())
+		c, err := b.WithReload(nil).complete()
+		Expect(err).NotTo(HaveOccurred())
+		c.recommendedOptions.Audit.PolicyFile = path
+
+		rc := genericapiserver.NewRecommendedConfig(c.codecs)
+		Expect(c.applyReload(GinkgoT().Context(), rc)).To(Succeed())
+		Expect(rc.AuditPolicyRuleEvaluator).To(BeAssignableToTypeOf(&reload.AuditPolicy{}))
+	})
+})
+
 var _ = Describe("Resource with interfaces", func() {
 	Describe("Resource with SingularNameProvider", func() {
 		It("should set singular name on the store", func() {
@@ -260,6 +497,30 @@ var _ = Describe("Resource with interfaces", func() {
 		})
 	})
 
+	Describe("Resource with restricted verbs", func() {
+		It("should record the enabled verbs", func() {
+			obj := &mockResourceObject{
+				gr: schema.GroupResource{Group: "test.example.com", Resource: "testresources"},
+			}
+			handler := Resource(obj, schema.GroupVersion{Group: "test.example.com", Version: "v1"}).
+				WithVerbs(rest.VerbGet, rest.VerbList, rest.VerbWatch)
+
+			Expect(handler.options.verbs).To(ConsistOf(rest.VerbGet, rest.VerbList, rest.VerbWatch))
+		})
+
+		It("should not modify copies of the handler", func() {
+			obj := &mockResourceObject{
+				gr: schema.GroupResource{Group: "test.example.com", Resource: "testresources"},
+			}
+			handler := Resource(obj, schema.GroupVersion{Group: "test.example.com", Version: "v1"})
+			readOnly := handler.WithVerbs(rest.VerbGet)
+
+			Expect(handler.options.verbs).To(BeEmpty())
+			Expect(readOnly.options.verbs).To(ConsistOf(rest.VerbGet))
+			Expect(ResourceHandler{}.WithVerbs(rest.VerbGet).options.verbs).To(ConsistOf(rest.VerbGet))
+		})
+	})
+
 	Describe("Resource with no custom interfaces", func() {
 		It("should work without implementing ShortNamesProvider or SingularNameProvider", func() {
 			obj := &mockResourceObject{
@@ -272,6 +533,328 @@ var _ = Describe("Resource with interfaces", func() {
 	})
 })
 
+var _ = Describe("Resources", func() {
+	It("should describe the registered resources", func() {
+		gv := schema.GroupVersion{Group: "test.example.com", Version: "v1"}
+		v2 := schema.GroupVersion{Group: gv.Group, Version: "v2"}
+		scheme := runtime.NewScheme()
+		scheme.AddKnownTypeWithName(gv.WithKind("MockResource"), &mockResourceObject{})
+		b := NewBuilder(scheme).
+			With(Resource(&mockResourceObject{gr: schema.GroupResource{Group: gv.Group, Resource: "zs"}}, gv)).
+			With(Resource(&mockResourceObject{gr: schema.GroupResource{Group: gv.Group, Resource: "as"}}, v2, gv).WithSoftDelete(time.Hour))
+
+		Expect(b.Resources()).To(Equal([]ResourceInfo{
+			{Group: gv.Group, Version: "v1", Resource: "as", Kind: "MockResource", Namespaced: true, Subresources: []string{"undelete"}, Stability: StabilityStable},
+			{Group: gv.Group, Version: "v1", Resource: "zs", Kind: "MockResource", Namespaced: true, Stability: StabilityStable},
+			{Group: gv.Group, Version: "v2", Resource: "as", Kind: "MockResource", Namespaced: true, Subresources: []string{"undelete"}, Stability: StabilityStable},
+		}))
+	})
+})
+
+var _ = Describe("validateListKind", func() {
+	var (
+		gv     = schema.GroupVersion{Group: "test.example.com", Version: "v1"}
+		scheme *runtime.Scheme
+		obj    *mockResourceObject
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		obj = &mockResourceObject{gr: schema.GroupResource{Group: "test.example.com", Resource: "testresources"}}
+	})
+
+	It("should accept a list registered for all versions", func() {
+		scheme.AddKnownTypeWithName(gv.WithKind("MockResourceList"), &mockResourceList{})
+		Expect(validateListKind(scheme, obj, []schema.GroupVersion{gv})).To(Succeed())
+	})
+
+	It("should reject an unregistered list type", func() {
+		Expect(validateListKind(scheme, obj, []schema.GroupVersion{gv})).To(MatchError(ContainSubstring("not registered in the scheme")))
+	})
+
+	It("should reject a list missing for a served version", func() {
+		scheme.AddKnownTypeWithName(gv.WithKind("MockResourceList"), &mockResourceList{})
+		v2 := schema.GroupVersion{Group: gv.Group, Version: "v2"}
+		Expect(validateListKind(scheme, obj, []schema.GroupVersion{gv, v2})).To(MatchError(ContainSubstring("for test.example.com/v2")))
+	})
+
+	It("should reject a list with items of another type", func() {
+		scheme.AddKnownTypeWithName(gv.WithKind("MockResourceList"), &mockResourceList{})
+		other := &mockOtherObject{mockResourceObject: *obj}
+		Expect(validateListKind(scheme, other, []schema.GroupVersion{gv})).To(MatchError(ContainSubstring("has items of type")))
+	})
+
+	It("should reject the status subresource without status field", func() {
+		scheme.AddKnownTypeWithName(gv.WithKind("MockResourceList"), &mockResourceList{})
+		b := NewBuilder(scheme).With(Resource(obj, gv).WithStatusSubResource())
+		_, err := b.Complete()
+		Expect(err).To(MatchError(ContainSubstring("no field named Status")))
+	})
+
+	It("should reject soft delete retentions below a second", func() {
+		scheme.AddKnownTypeWithName(gv.WithKind("MockResourceList"), &mockResourceList{})
+		b := NewBuilder(scheme).With(Resource(obj, gv).WithSoftDelete(time.Millisecond))
+		_, err := b.Complete()
+		Expect(err).To(MatchError(ContainSubstring("must be at least 1s")))
+	})
+
+	It("should reject negative page sizes", func() {
+		scheme.AddKnownTypeWithName(gv.WithKind("MockResourceList"), &mockResourceList{})
+		b := NewBuilder(scheme).With(Resource(obj, gv).WithMaxPageSize(-1))
+		_, err := b.Complete()
+		Expect(err).To(MatchError(ContainSubstring("max page size of testresources.test.example.com must not be negative")))
+	})
+
+	It("should reject soft delete for objects deleted gracefully", func() {
+		graceful := &mockGracefulObject{mockResourceObject: *obj}
+		b := NewBuilder(scheme).With(Resource(graceful, gv).WithSoftDelete(time.Hour))
+		_, err := b.Complete()
+		Expect(err).To(MatchError(ContainSubstring("cannot be soft-deleted")))
+	})
+
+	It("should register a counter of legacy objects for migrating resources", func() {
+		migrating := &mockMigratingObject{mockResourceObject: *obj}
+		handler := Resource(migrating, gv)
+		_, hooks := handler.newAPIGroup(handler.options.clone())
+		Expect(hooks).To(HaveLen(1))
+		Expect(hooks[0].name).To(Equal("count-legacy-testresources.test.example.com"))
+	})
+
+	It("should register a reaper for soft-deleted objects", func() {
+		handler := Resource(obj, gv).WithSoftDelete(time.Hour)
+		_, hooks := handler.newAPIGroup(handler.options.clone())
+		Expect(hooks).To(HaveLen(1))
+		Expect(hooks[0].name).To(Equal("reap-testresources.test.example.com"))
+	})
+
+	It("should fail completing the builder", func() {
+		b := NewBuilder(runtime.NewScheme()).With(Resource(obj, gv))
+		_, err := b.Complete()
+		Expect(err).To(MatchError(ContainSubstring("not registered in the scheme")))
+	})
+})
+
+var _ = Describe("Singleton", func() {
+	gv := schema.GroupVersion{Group: "test.example.com", Version: "v1"}
+
+	It("should restrict verbs and register a bootstrap hook", func() {
+		obj := &mockSingletonObject{mockResourceObject: mockResourceObject{
+			gr: schema.GroupResource{Group: "test.example.com", Resource: "clusterconfigs"},
+		}}
+		handler := Singleton(obj, gv)
+
+		Expect(handler.options.verbs).To(ConsistOf(rest.VerbGet, rest.VerbList, rest.VerbWatch, rest.VerbUpdate, rest.VerbPatch))
+		_, hooks := handler.newAPIGroup(handler.options.clone())
+		Expect(hooks).To(HaveLen(1))
+		Expect(hooks[0].name).To(Equal("bootstrap-clusterconfigs.test.example.com"))
+
+		scheme := runtime.NewScheme()
+		scheme.AddKnownTypeWithName(gv.WithKind("MockResourceList"), &mockResourceList{})
+		b := NewBuilder(scheme).WithComponentName("test").With(handler)
+		b.componentGlobalsRegistry = basecompatibility.NewComponentGlobalsRegistry()
+		c, err := b.complete()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.postStartHooks).To(HaveLen(1))
+	})
+
+	It("should not share state between servers built by the same Builder", func() {
+		var (
+			scheme    = runtime.NewScheme()
+			validator = &rest.ExternalValidator{
+				Name: "policy",
+				Validate: func(context.Context, runtime.Object, runtime.Object) (field.ErrorList, error) {
+					return nil, nil
+				},
+			}
+			stores = make(chan rest.Storage, 2)
+		)
+		scheme.AddKnownTypeWithName(gv.WithKind("MockResourceList"), &mockResourceList{})
+		obj := &mockSingletonObject{mockResourceObject: mockResourceObject{
+			gr: schema.GroupResource{Group: "test.example.com", Resource: "clusterconfigs"},
+		}}
+		b := NewBuilder(scheme).WithComponentName("test").With(Singleton(obj, gv).
+			WithExternalValidators(validator).
+			WithStorageHook(func(s rest.Storage) error {
+				stores <- s
+				return nil
+			}))
+		b.componentGlobalsRegistry = basecompatibility.NewComponentGlobalsRegistry()
+
+		// Build the API groups of two servers concurrently, which is checked by the race detector.
+		var wg sync.WaitGroup
+		for range 2 {
+			wg.Go(func() {
+				defer GinkgoRecover()
+				c, err := b.complete()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(c.postStartHooks).To(HaveLen(1))
+
+				config := &genericapiserver.Config{
+					ExternalAddress:   "127.0.0.1:443",
+					EffectiveVersion:  c.componentGlobalsRegistry.EffectiveVersionFor("test"),
+					RESTOptionsGetter: fakeRESTOptions(),
+				}
+				completed := config.Complete(nil)
+				for _, fn := range c.apiGroups {
+					info := fn(c.scheme, c.codecs, &completed)
+					Expect(info.VersionedResourcesStorageMap).To(HaveKey("v1"))
+				}
+			})
+		}
+		wg.Wait()
+
+		Expect(stores).To(HaveLen(2))
+		Expect(<-stores).NotTo(BeIdenticalTo(<-stores))
+		Expect(validator.Timeout).To(BeZero())
+	})
+
+	It("should panic for namespaced resources", func() {
+		obj := &mockSingletonObject{namespaced: true}
+		Expect(func() { Singleton(obj, gv) }).To(Panic())
+	})
+})
+
+var _ = Describe("buildAPIGroups", func() {
+	var (
+		mu               sync.Mutex
+		running, maxSeen int
+	)
+
+	// groupFn returns an APIGroupFn serving resource in group, which takes some time.
+	groupFn := func(group, resource string) APIGroupFn {
+		return func(scheme *runtime.Scheme, _ serializer.CodecFactory, _ *genericapiserver.CompletedConfig) genericapiserver.APIGroupInfo {
+			mu.Lock()
+			running++
+			maxSeen = max(maxSeen, running)
+			mu.Unlock()
+			time.Sleep(20 * time.Millisecond)
+			mu.Lock()
+			running--
+			mu.Unlock()
+
+			return genericapiserver.APIGroupInfo{
+				PrioritizedVersions:          []schema.GroupVersion{{Group: group, Version: "v1"}},
+				VersionedResourcesStorageMap: map[string]map[string]rest.Storage{"v1": {resource: nil}},
+				Scheme:                       scheme,
+			}
+		}
+	}
+
+	BeforeEach(func() {
+		running, maxSeen = 0, 0
+	})
+
+	It("should build the API groups concurrently in the order of their registration", func() {
+		c := &completedConfig{
+			builderConfig: builderConfig{scheme: runtime.NewScheme(), groupInstallParallelism: 2},
+			apiGroups: []APIGroupFn{
+				groupFn("b.opendefense.cloud", "bars"), groupFn("a.opendefense.cloud", "foos"),
+				groupFn("b.opendefense.cloud", "bazs"), groupFn("c.opendefense.cloud", "quxs"),
+			},
+		}
+		infos, err := c.buildAPIGroups(&genericapiserver.CompletedConfig{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(maxSeen).To(Equal(2))
+		groups := []string{}
+		for _, info := range infos {
+			groups = append(groups, info.PrioritizedVersions[0].Group)
+		}
+		Expect(groups).To(Equal([]string{"b.opendefense.cloud", "a.opendefense.cloud", "c.opendefense.cloud"}))
+		Expect(infos[0].VersionedResourcesStorageMap["v1"]).To(HaveKey("bars"))
+		Expect(infos[0].VersionedResourcesStorageMap["v1"]).To(HaveKey("bazs"))
+	})
+
+	It("should build the API groups sequentially by default", func() {
+		c := &completedConfig{
+			builderConfig: builderConfig{scheme: runtime.NewScheme()},
+			apiGroups:     []APIGroupFn{groupFn("a.opendefense.cloud", "foos"), groupFn("", "bars")},
+		}
+		_, err := c.buildAPIGroups(&genericapiserver.CompletedConfig{})
+		Expect(err).To(MatchError("empty group name is not allowed"))
+		Expect(maxSeen).To(Equal(1))
+	})
+
+	It("should panic once all API groups have been built", func() {
+		c := &completedConfig{
+			builderConfig: builderConfig{scheme: runtime.NewScheme(), groupInstallParallelism: 2},
+			apiGroups: []APIGroupFn{
+				func(*runtime.Scheme, serializer.CodecFactory, *genericapiserver.CompletedConfig) genericapiserver.APIGroupInfo {
+					panic("broken")
+				},
+				groupFn("a.opendefense.cloud", "foos"),
+			},
+		}
+		Expect(func() { _, _ = c.buildAPIGroups(&genericapiserver.CompletedConfig{}) }).To(PanicWith("broken"))
+		Expect(running).To(BeZero())
+	})
+})
+
+// fakeRESTOptions returns REST options for stores without a storage backend.
+func fakeRESTOptions() generic.RESTOptions {
+	return generic.RESTOptions{
+		StorageConfig:  &storagebackend.ConfigForResource{},
+		ResourcePrefix: "/resources",
+		Decorator: func(*storagebackend.ConfigForResource, string, func(runtime.Object) (string, error),
+			func() runtime.Object, func() runtime.Object, storage.AttrFunc, storage.IndexerFuncs,
+			*cache.Indexers) (storage.Interface, factory.DestroyFunc, error) {
+			return nil, func() {}, nil
+		},
+	}
+}
+
+// mockSingletonObject is a cluster-scoped resource implementing rest.Singleton.
+type mockSingletonObject struct {
+	mockResourceObject
+	namespaced bool
+}
+
+func (m *mockSingletonObject) NamespaceScoped() bool {
+	return m.namespaced
+}
+
+func (m *mockSingletonObject) SingletonName() string {
+	return "cluster"
+}
+
+func (m *mockSingletonObject) DeepCopyInto(out *mockSingletonObject) {
+	*out = *m
+}
+
+func (m *mockSingletonObject) DeepCopyObject() runtime.Object {
+	if m == nil {
+		return nil
+	}
+	outCopy := &mockSingletonObject{}
+	m.DeepCopyInto(outCopy)
+
+	return outCopy
+}
+
+// mockOtherObject is a resource sharing the list type of mockResourceObject by mistake.
+type mockOtherObject struct {
+	mockResourceObject
+}
+
+func (m *mockOtherObject) New() runtime.Object {
+	return &mockOtherObject{}
+}
+
+type mockGracefulObject struct {
+	mockResourceObject
+}
+
+func (m *mockGracefulObject) CheckGracefulDelete(ctx context.Context, options *metav1.DeleteOptions) bool {
+	return true
+}
+
+type mockMigratingObject struct {
+	mockResourceObject
+}
+
+func (m *mockMigratingObject) NeedsMigration() bool { return false }
+
+func (m *mockMigratingObject) Migrate() {}
+
 type mockResourceObject struct {
 	gr           schema.GroupResource
 	singularName string
@@ -0,0 +1,131 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"runtime/debug"
+	"strings"
+
+	genericapiserver "k8s.io/apiserver/pkg/server"
+)
+
+// BuildInfoPath is the path serving the BuildInfo of the server to authorized clients, e.g.
+// security scanners verifying the provenance of deployed servers.
+const BuildInfoPath = "/buildinfo"
+
+// BuildInfo is the provenance of the server binary as recorded by the Go toolchain.
+type BuildInfo struct {
+	GoVersion    string        `json:"goVersion"`
+	Main         BuildModule   `json:"main"`
+	Dependencies []BuildModule `json:"dependencies"`
+	VCS          *VCSInfo      `json:"vcs,omitempty"`
+	// Settings are the build flags, e.g. -tags, CGO_ENABLED or GOARCH.
+	Settings map[string]string `json:"settings"`
+}
+
+// BuildModule is a Go module linked into the server binary.
+type BuildModule struct {
+	Path    string       `json:"path"`
+	Version string       `json:"version"`
+	Sum     string       `json:"sum,omitempty"`
+	Replace *BuildModule `json:"replace,omitempty"`
+}
+
+// VCSInfo is the version control state the server binary has been built from.
+type VCSInfo struct {
+	System   string `json:"system"`
+	Revision string `json:"revision"`
+	Time     string `json:"time,omitempty"`
+	Modified bool   `json:"modified"`
+}
+
+// ReadBuildInfo returns the BuildInfo of the running binary. It fails for binaries built
+// without module support.
+func ReadBuildInfo() (*BuildInfo, error) {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return nil, errors.New("build information is not available")
+	}
+
+	return newBuildInfo(info), nil
+}
+
+// printBuildInfo writes the BuildInfo of the running binary to w as indented JSON.
+func printBuildInfo(w io.Writer) error {
+	info, err := ReadBuildInfo()
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(info)
+}
+
+// newBuildInfo converts the build information of the Go runtime.
+func newBuildInfo(info *debug.BuildInfo) *BuildInfo {
+	b := &BuildInfo{
+		GoVersion:    info.GoVersion,
+		Main:         newBuildModule(&info.Main),
+		Dependencies: make([]BuildModule, 0, len(info.Deps)),
+		Settings:     map[string]string{},
+	}
+	for _, dep := range info.Deps {
+		b.Dependencies = append(b.Dependencies, newBuildModule(dep))
+	}
+	for _, s := range info.Settings {
+		if !strings.HasPrefix(s.Key, "vcs") {
+			b.Settings[s.Key] = s.Value
+			continue
+		}
+		if b.VCS == nil {
+			b.VCS = &VCSInfo{}
+		}
+		switch s.Key {
+		case "vcs":
+			b.VCS.System = s.Value
+		case "vcs.revision":
+			b.VCS.Revision = s.Value
+		case "vcs.time":
+			b.VCS.Time = s.Value
+		case "vcs.modified":
+			b.VCS.Modified = s.Value == "true"
+		}
+	}
+
+	return b
+}
+
+func newBuildModule(m *debug.Module) BuildModule {
+	module := BuildModule{Path: m.Path, Version: m.Version, Sum: m.Sum}
+	if m.Replace != nil {
+		replace := newBuildModule(m.Replace)
+		module.Replace = &replace
+	}
+
+	return module
+}
+
+// installBuildInfo installs BuildInfoPath into the server, if the build information is available.
+// Unlike the health endpoints it is subject to authorization.
+func installBuildInfo(server *genericapiserver.GenericAPIServer) error {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return nil
+	}
+	body, err := json.Marshal(newBuildInfo(info))
+	if err != nil {
+		return err
+	}
+	server.Handler.NonGoRestfulMux.HandleFunc(BuildInfoPath, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	})
+
+	return nil
+}
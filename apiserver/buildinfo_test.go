@@ -0,0 +1,64 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"runtime/debug"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	basecompatibility "k8s.io/component-base/compatibility"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("build info", func() {
+	It("should convert the build information of the Go runtime", func() {
+		info := newBuildInfo(&debug.BuildInfo{
+			GoVersion: "go1.26.4",
+			Main:      debug.Module{Path: "example.com/foo", Version: "v1.2.3"},
+			Deps: []*debug.Module{
+				{Path: "k8s.io/apiserver", Version: "v0.36.2", Sum: "h1:abc"},
+				{Path: "go.opendefense.cloud/kit", Version: "v0.1.0", Replace: &debug.Module{Path: "../kit", Version: "(devel)"}},
+			},
+			Settings: []debug.BuildSetting{
+				{Key: "-tags", Value: "netgo"},
+				{Key: "CGO_ENABLED", Value: "0"},
+				{Key: "vcs", Value: "git"},
+				{Key: "vcs.revision", Value: "6f21a57"},
+				{Key: "vcs.time", Value: "2026-10-16T12:00:00Z"},
+				{Key: "vcs.modified", Value: "true"},
+			},
+		})
+		Expect(info).To(Equal(&BuildInfo{
+			GoVersion: "go1.26.4",
+			Main:      BuildModule{Path: "example.com/foo", Version: "v1.2.3"},
+			Dependencies: []BuildModule{
+				{Path: "k8s.io/apiserver", Version: "v0.36.2", Sum: "h1:abc"},
+				{Path: "go.opendefense.cloud/kit", Version: "v0.1.0", Replace: &BuildModule{Path: "../kit", Version: "(devel)"}},
+			},
+			VCS:      &VCSInfo{System: "git", Revision: "6f21a57", Time: "2026-10-16T12:00:00Z", Modified: true},
+			Settings: map[string]string{"-tags": "netgo", "CGO_ENABLED": "0"},
+		}))
+	})
+
+	It("should print the build information with --build-info", func() {
+		b := NewBuilder(runtime.NewScheme()).WithComponentName("test")
+		b.componentGlobalsRegistry = basecompatibility.NewComponentGlobalsRegistry()
+		c, err := b.complete()
+		Expect(err).NotTo(HaveOccurred())
+		cmd := c.command(context.Background())
+		out := &bytes.Buffer{}
+		cmd.SetOut(out)
+		cmd.SetArgs([]string{"--build-info"})
+		Expect(cmd.Execute()).To(Succeed())
+
+		info := &BuildInfo{}
+		Expect(json.Unmarshal(out.Bytes(), info)).To(Succeed())
+		Expect(info.GoVersion).NotTo(BeEmpty())
+	})
+})
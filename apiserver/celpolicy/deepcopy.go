@@ -0,0 +1,82 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package celpolicy
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ValidatingPolicySpec) DeepCopyInto(out *ValidatingPolicySpec) {
+	*out = *in
+	if in.Resources != nil {
+		out.Resources = make([]PolicyResource, len(in.Resources))
+		copy(out.Resources, in.Resources)
+	}
+	if in.Validations != nil {
+		out.Validations = make([]Validation, len(in.Validations))
+		copy(out.Validations, in.Validations)
+	}
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ValidatingPolicy) DeepCopyInto(out *ValidatingPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy returns a deep copy of the receiver.
+func (in *ValidatingPolicy) DeepCopy() *ValidatingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidatingPolicy)
+	in.DeepCopyInto(out)
+
+	return out
+}
+
+// DeepCopyObject implements runtime.Object.
+func (in *ValidatingPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *ValidatingPolicyList) DeepCopyInto(out *ValidatingPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		out.Items = make([]ValidatingPolicy, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+}
+
+// DeepCopy returns a deep copy of the receiver.
+func (in *ValidatingPolicyList) DeepCopy() *ValidatingPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidatingPolicyList)
+	in.DeepCopyInto(out)
+
+	return out
+}
+
+// DeepCopyObject implements runtime.Object.
+func (in *ValidatingPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+
+	return nil
+}
@@ -0,0 +1,283 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package celpolicy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apimachinery/pkg/util/version"
+	celconfig "k8s.io/apiserver/pkg/apis/cel"
+	"k8s.io/apiserver/pkg/cel/environment"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	k8srest "k8s.io/apiserver/pkg/registry/rest"
+
+	"go.opendefense.cloud/kit/apiserver/resource"
+	"go.opendefense.cloud/kit/apiserver/rest"
+)
+
+// errNotLoaded is returned while the policies cannot be read from storage yet.
+var errNotLoaded = errors.New("validating policies are not loaded yet")
+
+// envSet returns the CEL environments declaring the variables available to expressions.
+var envSet = sync.OnceValues(func() (*environment.EnvSet, error) {
+	return environment.MustBaseEnvSet(environment.DefaultCompatibilityVersion()).Extend(environment.VersionedOptions{
+		IntroducedVersion: version.MajorMinor(1, 0),
+		EnvOptions: []cel.EnvOption{
+			cel.Variable("object", cel.DynType),
+			cel.Variable("oldObject", cel.DynType),
+			cel.Variable("request", cel.DynType),
+		},
+	})
+})
+
+// compile compiles a boolean expression. New expressions are checked with the NewExpressions
+// environment, stored ones are compiled with the more lenient StoredExpressions environment.
+func compile(expression string, stored bool) (cel.Program, error) {
+	set, err := envSet()
+	if err != nil {
+		return nil, err
+	}
+	env := set.NewExpressionsEnv()
+	if stored {
+		env = set.StoredExpressionsEnv()
+	}
+	ast, issues := env.Compile(expression)
+	if issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	// Fields of objects are dynamically typed, so their type is only checked on evaluation.
+	if t := ast.OutputType(); !t.IsExactType(cel.BoolType) && !t.IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("expression must evaluate to bool, got %s", t)
+	}
+
+	return env.Program(ast,
+		cel.CostLimit(celconfig.PerCallLimit),
+		cel.InterruptCheckFrequency(celconfig.CheckFrequency),
+	)
+}
+
+// compiledPolicy is a policy with the programs of its validations.
+type compiledPolicy struct {
+	policy   *ValidatingPolicy
+	programs []cel.Program
+	// err is set if an expression of the stored policy does not compile anymore.
+	err error
+}
+
+// Evaluator evaluates the ValidatingPolicy objects read from storage. Compiled policies
+// are cached until the policy changes.
+type Evaluator struct {
+	mu       sync.Mutex
+	lister   k8srest.Lister
+	compiled map[types.UID]*compiledPolicy
+}
+
+// NewEvaluator returns an Evaluator. It evaluates policies once the storage of the
+// ValidatingPolicy resource has been passed to SetStorage.
+func NewEvaluator() *Evaluator {
+	return &Evaluator{compiled: map[types.UID]*compiledPolicy{}}
+}
+
+// SetStorage sets the storage the policies are read from. It is passed to
+// ResourceHandler.WithStorageHook of the ValidatingPolicy resource.
+func (e *Evaluator) SetStorage(storage rest.Storage) error {
+	lister, ok := storage.(k8srest.Lister)
+	if !ok {
+		return fmt.Errorf("validating policy storage %T does not support list", storage)
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.lister = lister
+
+	return nil
+}
+
+// Validator returns an ExternalValidator evaluating the policies, which is added to resources
+// with ResourceHandler.WithExternalValidators.
+func (e *Evaluator) Validator() *rest.ExternalValidator {
+	return &rest.ExternalValidator{
+		Name:     "cel-policies",
+		Validate: e.Evaluate,
+	}
+}
+
+// Evaluate evaluates the policies matching the resource of obj. old is nil on create.
+// Every failed validation is returned as a Forbidden error.
+func (e *Evaluator) Evaluate(ctx context.Context, obj, old runtime.Object) (field.ErrorList, error) {
+	r, ok := obj.(resource.Object)
+	if !ok {
+		return nil, nil
+	}
+	gr := r.GetGroupResource()
+	if gr.Group == GroupName {
+		// Policies must not lock out changes of themselves.
+		return nil, nil
+	}
+	policies, err := e.policies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var activation map[string]any
+	errs := field.ErrorList{}
+	for _, p := range policies {
+		if !p.policy.matches(gr) {
+			continue
+		}
+		if activation == nil {
+			if activation, err = newActivation(ctx, obj, old); err != nil {
+				return nil, err
+			}
+		}
+		errs = append(errs, p.evaluate(ctx, activation)...)
+	}
+
+	return errs, nil
+}
+
+// evaluate runs the validations of the policy.
+func (p *compiledPolicy) evaluate(ctx context.Context, activation map[string]any) field.ErrorList {
+	name := p.policy.Name
+	if p.err != nil {
+		if p.policy.Spec.FailurePolicy == FailurePolicyIgnore {
+			return nil
+		}
+
+		return field.ErrorList{field.InternalError(nil, fmt.Errorf("ValidatingPolicy %q: %w", name, p.err))}
+	}
+
+	errs := field.ErrorList{}
+	for i, prog := range p.programs {
+		v := p.policy.Spec.Validations[i]
+		admitted, err := eval(ctx, prog, activation)
+		if err != nil {
+			if p.policy.Spec.FailurePolicy != FailurePolicyIgnore {
+				errs = append(errs, field.InternalError(nil, fmt.Errorf("ValidatingPolicy %q: expression %q: %w", name, v.Expression, err)))
+			}
+			continue
+		}
+		if admitted {
+			continue
+		}
+		msg := v.Message
+		if msg == "" {
+			msg = fmt.Sprintf("failed expression: %s", v.Expression)
+		}
+		errs = append(errs, field.Forbidden(nil, fmt.Sprintf("ValidatingPolicy %q: %s", name, msg)))
+	}
+
+	return errs
+}
+
+// eval runs prog and returns its boolean result.
+func eval(ctx context.Context, prog cel.Program, activation map[string]any) (bool, error) {
+	out, _, err := prog.ContextEval(ctx, activation)
+	if err != nil {
+		return false, err
+	}
+	admitted, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression must evaluate to bool, got %s", out.Type())
+	}
+
+	return admitted, nil
+}
+
+// policies reads the policies from storage and compiles new or changed ones.
+func (e *Evaluator) policies(ctx context.Context) ([]*compiledPolicy, error) {
+	e.mu.Lock()
+	lister := e.lister
+	e.mu.Unlock()
+	if lister == nil {
+		return nil, errNotLoaded
+	}
+	// Policies are cluster-scoped, reading them from the watch cache is sufficient.
+	list, err := lister.List(genericapirequest.WithNamespace(ctx, ""), &metainternalversion.ListOptions{ResourceVersion: "0"})
+	if err != nil {
+		return nil, fmt.Errorf("listing validating policies: %w", err)
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	seen := map[types.UID]bool{}
+	policies := make([]*compiledPolicy, 0, len(items))
+	for _, item := range items {
+		policy, ok := item.(*ValidatingPolicy)
+		if !ok {
+			continue
+		}
+		seen[policy.UID] = true
+		c, ok := e.compiled[policy.UID]
+		if !ok || c.policy.ResourceVersion != policy.ResourceVersion {
+			c = compilePolicy(policy)
+			e.compiled[policy.UID] = c
+		}
+		policies = append(policies, c)
+	}
+	for uid := range e.compiled {
+		if !seen[uid] {
+			delete(e.compiled, uid)
+		}
+	}
+
+	return policies, nil
+}
+
+// compilePolicy compiles all validations of policy.
+func compilePolicy(policy *ValidatingPolicy) *compiledPolicy {
+	c := &compiledPolicy{policy: policy}
+	for _, v := range policy.Spec.Validations {
+		prog, err := compile(v.Expression, true)
+		if err != nil {
+			c.err = fmt.Errorf("expression %q: %w", v.Expression, err)
+			return c
+		}
+		c.programs = append(c.programs, prog)
+	}
+
+	return c
+}
+
+// newActivation returns the variables of the expressions.
+func newActivation(ctx context.Context, obj, old runtime.Object) (map[string]any, error) {
+	object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	request := map[string]any{"operation": "CREATE"}
+	var oldObject map[string]any
+	if old != nil {
+		request["operation"] = "UPDATE"
+		if oldObject, err = runtime.DefaultUnstructuredConverter.ToUnstructured(old); err != nil {
+			return nil, err
+		}
+	}
+	if u, ok := genericapirequest.UserFrom(ctx); ok {
+		groups := make([]any, 0, len(u.GetGroups()))
+		for _, g := range u.GetGroups() {
+			groups = append(groups, g)
+		}
+		request["userInfo"] = map[string]any{"username": u.GetName(), "groups": groups}
+	}
+
+	activation := map[string]any{"object": object, "request": request, "oldObject": nil}
+	if oldObject != nil {
+		activation["oldObject"] = oldObject
+	}
+
+	return activation, nil
+}
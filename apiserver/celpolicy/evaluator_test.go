@@ -0,0 +1,206 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package celpolicy
+
+import (
+	"context"
+
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fakeStorage serves a fixed list of policies.
+type fakeStorage struct {
+	list *ValidatingPolicyList
+}
+
+func (f *fakeStorage) New() runtime.Object     { return &ValidatingPolicy{} }
+func (f *fakeStorage) Destroy()                {}
+func (f *fakeStorage) NewList() runtime.Object { return &ValidatingPolicyList{} }
+
+func (f *fakeStorage) List(ctx context.Context, options *metainternalversion.ListOptions) (runtime.Object, error) {
+	return f.list.DeepCopy(), nil
+}
+
+func (f *fakeStorage) ConvertToTable(ctx context.Context, obj runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
+	return &metav1.Table{}, nil
+}
+
+// getOnlyStorage cannot list policies.
+type getOnlyStorage struct{}
+
+func (*getOnlyStorage) New() runtime.Object { return &ValidatingPolicy{} }
+func (*getOnlyStorage) Destroy()            {}
+
+// widget is a kit resource the policies are evaluated against.
+type widget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              widgetSpec `json:"spec"`
+}
+
+type widgetSpec struct {
+	Replicas int64 `json:"replicas"`
+}
+
+func (w *widget) DeepCopyObject() runtime.Object {
+	clone := *w
+
+	return &clone
+}
+
+func (w *widget) GetObjectMeta() *metav1.ObjectMeta { return &w.ObjectMeta }
+func (w *widget) NamespaceScoped() bool             { return true }
+func (w *widget) New() runtime.Object               { return &widget{} }
+func (w *widget) NewList() runtime.Object           { return nil }
+
+func (w *widget) GetGroupResource() schema.GroupResource {
+	return schema.GroupResource{Group: "test.opendefense.cloud", Resource: "widgets"}
+}
+
+func newPolicy(name string, failurePolicy FailurePolicyType, validations ...Validation) ValidatingPolicy {
+	return ValidatingPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: name, UID: types.UID(name), ResourceVersion: "1"},
+		Spec: ValidatingPolicySpec{
+			Resources:     []PolicyResource{{Group: "test.opendefense.cloud", Resource: "widgets"}},
+			Validations:   validations,
+			FailurePolicy: failurePolicy,
+		},
+	}
+}
+
+var _ = Describe("ValidatingPolicy", func() {
+	It("should default the failure policy", func() {
+		p := &ValidatingPolicy{}
+		p.PrepareForCreate(context.Background())
+		Expect(p.Spec.FailurePolicy).To(Equal(FailurePolicyFail))
+	})
+
+	It("should accept valid policies", func() {
+		p := newPolicy("replicas", FailurePolicyFail, Validation{Expression: "object.spec.replicas <= 5"})
+		Expect(p.Validate(context.Background())).To(BeEmpty())
+	})
+
+	It("should reject policies without resources or validations", func() {
+		p := &ValidatingPolicy{Spec: ValidatingPolicySpec{FailurePolicy: "Sometimes"}}
+		Expect(p.Validate(context.Background())).To(ConsistOf(
+			HaveField("Field", "spec.resources"),
+			HaveField("Field", "spec.validations"),
+			HaveField("Field", "spec.failurePolicy"),
+		))
+	})
+
+	It("should reject expressions which do not compile or evaluate to bool", func() {
+		p := newPolicy("broken", FailurePolicyFail,
+			Validation{Expression: "object.spec.replicas <="},
+			Validation{Expression: "1 + 1"},
+		)
+		Expect(p.Validate(context.Background())).To(ConsistOf(
+			SatisfyAll(HaveField("Field", "spec.validations[0].expression"), HaveField("Type", field.ErrorTypeInvalid)),
+			SatisfyAll(HaveField("Field", "spec.validations[1].expression"), HaveField("Detail", ContainSubstring("must evaluate to bool"))),
+		))
+	})
+})
+
+var _ = Describe("Evaluator", func() {
+	var (
+		ctx     = context.Background()
+		storage *fakeStorage
+		e       *Evaluator
+	)
+
+	BeforeEach(func() {
+		storage = &fakeStorage{list: &ValidatingPolicyList{}}
+		e = NewEvaluator()
+		Expect(e.SetStorage(storage)).To(Succeed())
+	})
+
+	It("should fail until the policies can be read", func() {
+		_, err := NewEvaluator().Evaluate(ctx, &widget{}, nil)
+		Expect(err).To(MatchError(errNotLoaded))
+	})
+
+	It("should reject storage which cannot list policies", func() {
+		Expect(NewEvaluator().SetStorage(&getOnlyStorage{})).To(MatchError(ContainSubstring("does not support list")))
+	})
+
+	It("should admit objects passing all validations", func() {
+		storage.list.Items = []ValidatingPolicy{newPolicy("replicas", FailurePolicyFail, Validation{Expression: "object.spec.replicas <= 5"})}
+		Expect(e.Evaluate(ctx, &widget{Spec: widgetSpec{Replicas: 3}}, nil)).To(BeEmpty())
+	})
+
+	It("should reject objects failing a validation", func() {
+		storage.list.Items = []ValidatingPolicy{
+			newPolicy("replicas", FailurePolicyFail,
+				Validation{Expression: "object.spec.replicas <= 5", Message: "at most 5 replicas are allowed"},
+				Validation{Expression: "object.metadata.name != 'forbidden'"},
+			),
+		}
+		w := &widget{ObjectMeta: metav1.ObjectMeta{Name: "forbidden"}, Spec: widgetSpec{Replicas: 10}}
+		errs, err := e.Evaluate(ctx, w, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(errs).To(ConsistOf(
+			SatisfyAll(HaveField("Type", field.ErrorTypeForbidden), HaveField("Detail", `ValidatingPolicy "replicas": at most 5 replicas are allowed`)),
+			HaveField("Detail", `ValidatingPolicy "replicas": failed expression: object.metadata.name != 'forbidden'`),
+		))
+	})
+
+	It("should provide the old object and the request", func() {
+		storage.list.Items = []ValidatingPolicy{newPolicy("scale-down", FailurePolicyFail, Validation{
+			Expression: "request.operation == 'CREATE' || request.userInfo.username == 'admin' || object.spec.replicas >= oldObject.spec.replicas",
+		})}
+		old := &widget{Spec: widgetSpec{Replicas: 3}}
+		Expect(e.Evaluate(ctx, &widget{Spec: widgetSpec{Replicas: 1}}, nil)).To(BeEmpty())
+
+		userCtx := genericapirequest.WithUser(ctx, &user.DefaultInfo{Name: "alice"})
+		Expect(e.Evaluate(userCtx, &widget{Spec: widgetSpec{Replicas: 1}}, old)).To(ConsistOf(HaveField("Type", field.ErrorTypeForbidden)))
+
+		adminCtx := genericapirequest.WithUser(ctx, &user.DefaultInfo{Name: "admin"})
+		Expect(e.Evaluate(adminCtx, &widget{Spec: widgetSpec{Replicas: 1}}, old)).To(BeEmpty())
+	})
+
+	It("should only evaluate policies of the resource", func() {
+		p := newPolicy("deny-all", FailurePolicyFail, Validation{Expression: "false"})
+		p.Spec.Resources = []PolicyResource{{Group: "other.opendefense.cloud", Resource: "*"}}
+		storage.list.Items = []ValidatingPolicy{p}
+		Expect(e.Evaluate(ctx, &widget{}, nil)).To(BeEmpty())
+
+		storage.list.Items[0].Spec.Resources = []PolicyResource{{Group: "*", Resource: "*"}}
+		storage.list.Items[0].ResourceVersion = "2"
+		Expect(e.Evaluate(ctx, &widget{}, nil)).To(HaveLen(1))
+		Expect(e.Evaluate(ctx, &ValidatingPolicy{}, nil)).To(BeEmpty())
+	})
+
+	It("should handle evaluation errors according to the failure policy", func() {
+		validation := Validation{Expression: "object.spec.missing == 1"}
+		storage.list.Items = []ValidatingPolicy{newPolicy("strict", FailurePolicyFail, validation)}
+		Expect(e.Evaluate(ctx, &widget{}, nil)).To(ConsistOf(HaveField("Type", field.ErrorTypeInternal)))
+
+		storage.list.Items = []ValidatingPolicy{newPolicy("lenient", FailurePolicyIgnore, validation)}
+		Expect(e.Evaluate(ctx, &widget{}, nil)).To(BeEmpty())
+	})
+
+	It("should recompile changed policies", func() {
+		storage.list.Items = []ValidatingPolicy{newPolicy("replicas", FailurePolicyFail, Validation{Expression: "object.spec.replicas <= 5"})}
+		Expect(e.Evaluate(ctx, &widget{Spec: widgetSpec{Replicas: 3}}, nil)).To(BeEmpty())
+
+		storage.list.Items[0].Spec.Validations[0].Expression = "object.spec.replicas <= 2"
+		storage.list.Items[0].ResourceVersion = "2"
+		Expect(e.Evaluate(ctx, &widget{Spec: widgetSpec{Replicas: 3}}, nil)).To(HaveLen(1))
+		Expect(e.compiled).To(HaveLen(1))
+
+		storage.list.Items = nil
+		Expect(e.Evaluate(ctx, &widget{Spec: widgetSpec{Replicas: 3}}, nil)).To(BeEmpty())
+		Expect(e.compiled).To(BeEmpty())
+	})
+})
@@ -0,0 +1,178 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package celpolicy
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kube-openapi/pkg/common"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+
+	"go.opendefense.cloud/kit/apiserver/kitapi"
+)
+
+// openAPIModelPackage is the prefix of the OpenAPI model names of the policy types.
+const openAPIModelPackage = "cloud.opendefense.kit.policy.v1alpha1."
+
+func init() {
+	kitapi.RegisterOpenAPIDefinitions(GetOpenAPIDefinitions)
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (ValidatingPolicy) OpenAPIModelName() string {
+	return openAPIModelPackage + "ValidatingPolicy"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (ValidatingPolicyList) OpenAPIModelName() string {
+	return openAPIModelPackage + "ValidatingPolicyList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (ValidatingPolicySpec) OpenAPIModelName() string {
+	return openAPIModelPackage + "ValidatingPolicySpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (PolicyResource) OpenAPIModelName() string {
+	return openAPIModelPackage + "PolicyResource"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (Validation) OpenAPIModelName() string {
+	return openAPIModelPackage + "Validation"
+}
+
+// GetOpenAPIDefinitions returns the OpenAPI definitions of the policy types.
+func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
+	return map[string]common.OpenAPIDefinition{
+		ValidatingPolicy{}.OpenAPIModelName():     schemaValidatingPolicy(ref),
+		ValidatingPolicyList{}.OpenAPIModelName(): schemaValidatingPolicyList(ref),
+		ValidatingPolicySpec{}.OpenAPIModelName(): schemaValidatingPolicySpec(ref),
+		PolicyResource{}.OpenAPIModelName():       schemaPolicyResource(),
+		Validation{}.OpenAPIModelName():           schemaValidation(),
+	}
+}
+
+func stringProperty(description string) spec.Schema {
+	return spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			Description: description,
+			Type:        []string{"string"},
+		},
+	}
+}
+
+func refProperty(ref common.ReferenceCallback, name string) spec.Schema {
+	return spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			Default: map[string]any{},
+			Ref:     ref(name),
+		},
+	}
+}
+
+func arrayProperty(ref common.ReferenceCallback, description, item string) spec.Schema {
+	itemSchema := refProperty(ref, item)
+
+	return spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			Description: description,
+			Type:        []string{"array"},
+			Items:       &spec.SchemaOrArray{Schema: &itemSchema},
+		},
+	}
+}
+
+func typeMetaProperties() map[string]spec.Schema {
+	return map[string]spec.Schema{
+		"kind":       stringProperty("Kind is a string value representing the REST resource this object represents."),
+		"apiVersion": stringProperty("APIVersion defines the versioned schema of this representation of an object."),
+	}
+}
+
+func schemaValidatingPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	props := typeMetaProperties()
+	props["metadata"] = refProperty(ref, metav1.ObjectMeta{}.OpenAPIModelName())
+	props["spec"] = refProperty(ref, ValidatingPolicySpec{}.OpenAPIModelName())
+
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ValidatingPolicy validates objects of kit resources with CEL expressions.",
+				Type:        []string{"object"},
+				Properties:  props,
+				Required:    []string{"spec"},
+			},
+		},
+		Dependencies: []string{metav1.ObjectMeta{}.OpenAPIModelName(), ValidatingPolicySpec{}.OpenAPIModelName()},
+	}
+}
+
+func schemaValidatingPolicyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	props := typeMetaProperties()
+	props["metadata"] = refProperty(ref, metav1.ListMeta{}.OpenAPIModelName())
+	props["items"] = arrayProperty(ref, "", ValidatingPolicy{}.OpenAPIModelName())
+
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ValidatingPolicyList is a list of ValidatingPolicy objects.",
+				Type:        []string{"object"},
+				Properties:  props,
+				Required:    []string{"items"},
+			},
+		},
+		Dependencies: []string{metav1.ListMeta{}.OpenAPIModelName(), ValidatingPolicy{}.OpenAPIModelName()},
+	}
+}
+
+func schemaValidatingPolicySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ValidatingPolicySpec is the specification of a ValidatingPolicy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resources":     arrayProperty(ref, "Resources selects the resources the policy applies to.", PolicyResource{}.OpenAPIModelName()),
+					"validations":   arrayProperty(ref, "Validations are evaluated for every created or updated object of the resources.", Validation{}.OpenAPIModelName()),
+					"failurePolicy": stringProperty("FailurePolicy defines how errors evaluating the expressions are handled, Fail or Ignore. Defaults to Fail."),
+				},
+				Required: []string{"resources", "validations"},
+			},
+		},
+		Dependencies: []string{PolicyResource{}.OpenAPIModelName(), Validation{}.OpenAPIModelName()},
+	}
+}
+
+func schemaPolicyResource() common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PolicyResource selects a resource a policy applies to.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group":    stringProperty("Group is the API group of the resource, \"*\" matches all groups."),
+					"resource": stringProperty("Resource is the plural name of the resource, \"*\" matches all resources."),
+				},
+				Required: []string{"resource"},
+			},
+		},
+	}
+}
+
+func schemaValidation() common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Validation is a CEL expression which must evaluate to true for the request to be admitted.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"expression": stringProperty("Expression is the CEL expression."),
+					"message":    stringProperty("Message is returned if the expression evaluates to false. Defaults to the expression."),
+				},
+				Required: []string{"expression"},
+			},
+		},
+	}
+}
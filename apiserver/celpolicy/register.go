@@ -0,0 +1,46 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package celpolicy
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+)
+
+// GroupName is the API group of the policy resources.
+const GroupName = "policy.kit.opendefense.cloud"
+
+// SchemeGroupVersion is the served version of the policy resources.
+var SchemeGroupVersion = schema.GroupVersion{Group: GroupName, Version: "v1alpha1"}
+
+// internalGroupVersion is the internal version. The policy types are used for both
+// versions, so no conversion is needed.
+var internalGroupVersion = schema.GroupVersion{Group: GroupName, Version: runtime.APIVersionInternal}
+
+var (
+	// SchemeBuilder is the scheme builder with scheme init functions to run for this API package
+	SchemeBuilder = runtime.NewSchemeBuilder(addKnownTypes)
+	// AddToScheme is a common registration function for mapping packaged scoped group & version keys to a scheme
+	AddToScheme = SchemeBuilder.AddToScheme
+)
+
+// Install registers the policy types with scheme. It is a kitapi.InstallFunc.
+func Install(scheme *runtime.Scheme) {
+	utilruntime.Must(AddToScheme(scheme))
+	utilruntime.Must(scheme.SetVersionPriority(SchemeGroupVersion))
+}
+
+func addKnownTypes(scheme *runtime.Scheme) error {
+	for _, gv := range []schema.GroupVersion{internalGroupVersion, SchemeGroupVersion} {
+		scheme.AddKnownTypes(gv,
+			&ValidatingPolicy{},
+			&ValidatingPolicyList{},
+		)
+	}
+	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
+
+	return nil
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package celpolicy evaluates CEL validation policies against kit resources in-process, similar
+// to ValidatingAdmissionPolicy. The policies are ValidatingPolicy objects stored in the kit server
+// itself, so administrators can add rules without recompiling the server.
+package celpolicy
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"go.opendefense.cloud/kit/apiserver/resource"
+)
+
+// FailurePolicyType defines how errors evaluating a policy are handled.
+type FailurePolicyType string
+
+const (
+	// FailurePolicyFail rejects the request if an expression cannot be evaluated.
+	FailurePolicyFail FailurePolicyType = "Fail"
+	// FailurePolicyIgnore skips expressions which cannot be evaluated.
+	FailurePolicyIgnore FailurePolicyType = "Ignore"
+)
+
+// PolicyResource selects a resource a policy applies to.
+type PolicyResource struct {
+	// Group is the API group of the resource, "*" matches all groups.
+	Group string `json:"group"`
+	// Resource is the plural name of the resource, "*" matches all resources.
+	Resource string `json:"resource"`
+}
+
+// Validation is a CEL expression which must evaluate to true for the request to be admitted.
+// The expression may access the variables object, oldObject (null on create) and request,
+// which contains operation (CREATE or UPDATE) and userInfo (username and groups).
+type Validation struct {
+	// Expression is the CEL expression.
+	Expression string `json:"expression"`
+	// Message is returned if the expression evaluates to false. Defaults to the expression.
+	Message string `json:"message,omitempty"`
+}
+
+// ValidatingPolicySpec is the specification of a ValidatingPolicy.
+type ValidatingPolicySpec struct {
+	// Resources selects the resources the policy applies to.
+	Resources []PolicyResource `json:"resources"`
+	// Validations are evaluated for every created or updated object of the resources.
+	Validations []Validation `json:"validations"`
+	// FailurePolicy defines how errors evaluating the expressions are handled, Fail or Ignore. Defaults to Fail.
+	FailurePolicy FailurePolicyType `json:"failurePolicy,omitempty"`
+}
+
+// ValidatingPolicy validates objects of kit resources with CEL expressions.
+type ValidatingPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ValidatingPolicySpec `json:"spec"`
+}
+
+// ValidatingPolicyList is a list of ValidatingPolicy objects.
+type ValidatingPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ValidatingPolicy `json:"items"`
+}
+
+var _ resource.ObjectWithDeepCopy[*ValidatingPolicy] = &ValidatingPolicy{}
+
+func (p *ValidatingPolicy) GetObjectMeta() *metav1.ObjectMeta {
+	return &p.ObjectMeta
+}
+
+func (p *ValidatingPolicy) NamespaceScoped() bool {
+	return false
+}
+
+func (p *ValidatingPolicy) New() runtime.Object {
+	return &ValidatingPolicy{}
+}
+
+func (p *ValidatingPolicy) NewList() runtime.Object {
+	return &ValidatingPolicyList{}
+}
+
+func (p *ValidatingPolicy) GetGroupResource() schema.GroupResource {
+	return SchemeGroupVersion.WithResource("validatingpolicies").GroupResource()
+}
+
+// PrepareForCreate defaults the failure policy.
+func (p *ValidatingPolicy) PrepareForCreate(ctx context.Context) {
+	if p.Spec.FailurePolicy == "" {
+		p.Spec.FailurePolicy = FailurePolicyFail
+	}
+}
+
+// PrepareForUpdate defaults the failure policy.
+func (p *ValidatingPolicy) PrepareForUpdate(ctx context.Context, old runtime.Object) {
+	p.PrepareForCreate(ctx)
+}
+
+// Validate checks that the policy selects resources and that all expressions compile.
+func (p *ValidatingPolicy) Validate(ctx context.Context) field.ErrorList {
+	spec := field.NewPath("spec")
+	errs := field.ErrorList{}
+	if len(p.Spec.Resources) == 0 {
+		errs = append(errs, field.Required(spec.Child("resources"), "at least one resource is required"))
+	}
+	for i, r := range p.Spec.Resources {
+		if r.Resource == "" {
+			errs = append(errs, field.Required(spec.Child("resources").Index(i).Child("resource"), ""))
+		}
+	}
+	if len(p.Spec.Validations) == 0 {
+		errs = append(errs, field.Required(spec.Child("validations"), "at least one validation is required"))
+	}
+	for i, v := range p.Spec.Validations {
+		path := spec.Child("validations").Index(i).Child("expression")
+		if v.Expression == "" {
+			errs = append(errs, field.Required(path, ""))
+			continue
+		}
+		if _, err := compile(v.Expression, false); err != nil {
+			errs = append(errs, field.Invalid(path, v.Expression, fmt.Sprintf("compilation failed: %v", err)))
+		}
+	}
+	switch p.Spec.FailurePolicy {
+	case FailurePolicyFail, FailurePolicyIgnore:
+	default:
+		errs = append(errs, field.NotSupported(spec.Child("failurePolicy"), p.Spec.FailurePolicy, []FailurePolicyType{FailurePolicyFail, FailurePolicyIgnore}))
+	}
+
+	return errs
+}
+
+// ValidateUpdate validates the updated policy like a new one.
+func (p *ValidatingPolicy) ValidateUpdate(ctx context.Context, old runtime.Object) field.ErrorList {
+	return p.Validate(ctx)
+}
+
+// matches returns true if the policy applies to gr.
+func (p *ValidatingPolicy) matches(gr schema.GroupResource) bool {
+	for _, r := range p.Spec.Resources {
+		if (r.Group == "*" || r.Group == gr.Group) && (r.Resource == "*" || r.Resource == gr.Resource) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,21 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build chaos
+
+package apiserver
+
+import (
+	"go.opendefense.cloud/kit/apiserver/chaos"
+)
+
+// WithStorageFaultInjection wraps the storage of all resources with the given
+// fault injector. It is only available with the chaos build tag, e.g. in
+// go test -tags chaos, so production servers cannot enable it.
+func (b *Builder) WithStorageFaultInjection(i *chaos.Injector) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.storageFaultInjector = i
+
+	return b
+}
@@ -0,0 +1,234 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package chaos provides fault injection for the storage layer of kit API servers.
+// It is intended for resilience testing of controllers consuming kit APIs and
+// must not be enabled in production.
+package chaos
+
+import (
+	"context"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/storagebackend"
+	"k8s.io/apiserver/pkg/storage/storagebackend/factory"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Config describes the faults injected into storage operations.
+type Config struct {
+	// ErrorRate is the probability between 0 and 1 that a storage operation fails.
+	ErrorRate float64
+	// Latency is added to every storage operation.
+	Latency time.Duration
+	// LatencyJitter adds a random delay of up to this duration on top of Latency.
+	LatencyJitter time.Duration
+	// WatchDropRate is the probability between 0 and 1 that a watch event is dropped.
+	WatchDropRate float64
+	// Resources restricts fault injection to the given resources.
+	// All resources are affected if empty.
+	Resources []schema.GroupResource
+	// Seed initializes the random source so that injected faults are reproducible.
+	Seed uint64
+}
+
+// Injector decides which storage operations fail, are delayed or lose watch events.
+// The configuration can be replaced at runtime with Update.
+type Injector struct {
+	mu     sync.Mutex
+	config Config
+	rand   *rand.Rand
+}
+
+// NewInjector creates an Injector with the given configuration.
+func NewInjector(config Config) *Injector {
+	i := &Injector{}
+	i.Update(config)
+
+	return i
+}
+
+// Update replaces the configuration and reseeds the random source.
+func (i *Injector) Update(config Config) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.config = config
+	i.rand = rand.New(rand.NewPCG(config.Seed, config.Seed)) //nolint:gosec // deterministic on purpose
+}
+
+func (i *Injector) applies(gr schema.GroupResource) bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return len(i.config.Resources) == 0 || slices.Contains(i.config.Resources, gr)
+}
+
+// roll returns true with the given probability.
+func (i *Injector) roll(rate func(Config) float64) bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	r := rate(i.config)
+	if r <= 0 {
+		return false
+	}
+
+	return i.rand.Float64() < r
+}
+
+func (i *Injector) delay() time.Duration {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	d := i.config.Latency
+	if i.config.LatencyJitter > 0 {
+		d += time.Duration(i.rand.Int64N(int64(i.config.LatencyJitter)))
+	}
+
+	return d
+}
+
+// fault delays the operation and returns an error if the operation should fail.
+func (i *Injector) fault(ctx context.Context, gr schema.GroupResource, key string) error {
+	if !i.applies(gr) {
+		return nil
+	}
+	if d := i.delay(); d > 0 {
+		t := time.NewTimer(d)
+		defer t.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+	if i.roll(func(c Config) float64 { return c.ErrorRate }) {
+		return storage.NewUnreachableError(key, 0)
+	}
+
+	return nil
+}
+
+// dropEvent returns true if the watch event should be dropped.
+func (i *Injector) dropEvent(gr schema.GroupResource, e watch.Event) bool {
+	switch e.Type {
+	case watch.Added, watch.Modified, watch.Deleted:
+	default:
+		// Bookmarks and errors are always delivered.
+		return false
+	}
+
+	return i.applies(gr) && i.roll(func(c Config) float64 { return c.WatchDropRate })
+}
+
+// RESTOptionsGetter wraps the given getter so that all storage created through it
+// is subject to fault injection.
+func (i *Injector) RESTOptionsGetter(delegate generic.RESTOptionsGetter) generic.RESTOptionsGetter {
+	return &restOptionsGetter{delegate: delegate, injector: i}
+}
+
+type restOptionsGetter struct {
+	delegate generic.RESTOptionsGetter
+	injector *Injector
+}
+
+// GetRESTOptions returns the delegate's options with a decorator wrapping the storage.
+func (g *restOptionsGetter) GetRESTOptions(gr schema.GroupResource, example runtime.Object) (generic.RESTOptions, error) {
+	opts, err := g.delegate.GetRESTOptions(gr, example)
+	if err != nil {
+		return opts, err
+	}
+	decorator := opts.Decorator
+	if decorator == nil {
+		decorator = generic.UndecoratedStorage
+	}
+	opts.Decorator = func(
+		config *storagebackend.ConfigForResource,
+		resourcePrefix string,
+		keyFunc func(obj runtime.Object) (string, error),
+		newFunc func() runtime.Object,
+		newListFunc func() runtime.Object,
+		getAttrsFunc storage.AttrFunc,
+		trigger storage.IndexerFuncs,
+		indexers *cache.Indexers) (storage.Interface, factory.DestroyFunc, error) {
+		s, destroy, err := decorator(config, resourcePrefix, keyFunc, newFunc, newListFunc, getAttrsFunc, trigger, indexers)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return &faultyStorage{Interface: s, injector: g.injector, gr: gr}, destroy, nil
+	}
+
+	return opts, nil
+}
+
+// faultyStorage injects faults into the operations of the wrapped storage.
+type faultyStorage struct {
+	storage.Interface
+	injector *Injector
+	gr       schema.GroupResource
+}
+
+func (s *faultyStorage) Create(ctx context.Context, key string, obj, out runtime.Object, ttl uint64) error {
+	if err := s.injector.fault(ctx, s.gr, key); err != nil {
+		return err
+	}
+
+	return s.Interface.Create(ctx, key, obj, out, ttl)
+}
+
+func (s *faultyStorage) Delete(
+	ctx context.Context, key string, out runtime.Object, preconditions *storage.Preconditions,
+	validateDeletion storage.ValidateObjectFunc, cachedExistingObject runtime.Object, opts storage.DeleteOptions) error {
+	if err := s.injector.fault(ctx, s.gr, key); err != nil {
+		return err
+	}
+
+	return s.Interface.Delete(ctx, key, out, preconditions, validateDeletion, cachedExistingObject, opts)
+}
+
+func (s *faultyStorage) Watch(ctx context.Context, key string, opts storage.ListOptions) (watch.Interface, error) {
+	if err := s.injector.fault(ctx, s.gr, key); err != nil {
+		return nil, err
+	}
+	w, err := s.Interface.Watch(ctx, key, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return watch.Filter(w, func(e watch.Event) (watch.Event, bool) {
+		return e, !s.injector.dropEvent(s.gr, e)
+	}), nil
+}
+
+func (s *faultyStorage) Get(ctx context.Context, key string, opts storage.GetOptions, objPtr runtime.Object) error {
+	if err := s.injector.fault(ctx, s.gr, key); err != nil {
+		return err
+	}
+
+	return s.Interface.Get(ctx, key, opts, objPtr)
+}
+
+func (s *faultyStorage) GetList(ctx context.Context, key string, opts storage.ListOptions, listObj runtime.Object) error {
+	if err := s.injector.fault(ctx, s.gr, key); err != nil {
+		return err
+	}
+
+	return s.Interface.GetList(ctx, key, opts, listObj)
+}
+
+func (s *faultyStorage) GuaranteedUpdate(
+	ctx context.Context, key string, destination runtime.Object, ignoreNotFound bool,
+	preconditions *storage.Preconditions, tryUpdate storage.UpdateFunc, cachedExistingObject runtime.Object) error {
+	if err := s.injector.fault(ctx, s.gr, key); err != nil {
+		return err
+	}
+
+	return s.Interface.GuaranteedUpdate(ctx, key, destination, ignoreNotFound, preconditions, tryUpdate, cachedExistingObject)
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package chaos
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/storage"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fakeStorage records calls and serves a fixed watch.
+type fakeStorage struct {
+	storage.Interface
+	gets    int
+	watcher *watch.FakeWatcher
+}
+
+func (f *fakeStorage) Get(context.Context, string, storage.GetOptions, runtime.Object) error {
+	f.gets++
+	return nil
+}
+
+func (f *fakeStorage) Watch(context.Context, string, storage.ListOptions) (watch.Interface, error) {
+	return f.watcher, nil
+}
+
+var _ = Describe("faultyStorage", func() {
+	var (
+		gr   = schema.GroupResource{Group: "foo.opendefense.cloud", Resource: "bars"}
+		ctx  = context.Background()
+		fake *fakeStorage
+	)
+
+	BeforeEach(func() {
+		fake = &fakeStorage{watcher: watch.NewFakeWithChanSize(10, false)}
+	})
+
+	It("should pass operations through without faults", func() {
+		s := &faultyStorage{Interface: fake, injector: NewInjector(Config{}), gr: gr}
+		Expect(s.Get(ctx, "/bars/a", storage.GetOptions{}, nil)).To(Succeed())
+		Expect(fake.gets).To(Equal(1))
+	})
+
+	It("should fail operations at an error rate of one", func() {
+		s := &faultyStorage{Interface: fake, injector: NewInjector(Config{ErrorRate: 1}), gr: gr}
+		err := s.Get(ctx, "/bars/a", storage.GetOptions{}, nil)
+		Expect(storage.IsUnreachable(err)).To(BeTrue())
+		Expect(fake.gets).To(BeZero())
+	})
+
+	It("should only affect the configured resources", func() {
+		injector := NewInjector(Config{ErrorRate: 1, Resources: []schema.GroupResource{{Resource: "others"}}})
+		s := &faultyStorage{Interface: fake, injector: injector, gr: gr}
+		Expect(s.Get(ctx, "/bars/a", storage.GetOptions{}, nil)).To(Succeed())
+	})
+
+	It("should inject latency", func() {
+		s := &faultyStorage{Interface: fake, injector: NewInjector(Config{Latency: 20 * time.Millisecond}), gr: gr}
+		start := time.Now()
+		Expect(s.Get(ctx, "/bars/a", storage.GetOptions{}, nil)).To(Succeed())
+		Expect(time.Since(start)).To(BeNumerically(">=", 20*time.Millisecond))
+	})
+
+	It("should abort delayed operations when the context is cancelled", func() {
+		s := &faultyStorage{Interface: fake, injector: NewInjector(Config{Latency: time.Hour}), gr: gr}
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+		Expect(s.Get(cancelled, "/bars/a", storage.GetOptions{}, nil)).To(MatchError(context.Canceled))
+	})
+
+	It("should drop watch events but keep bookmarks", func() {
+		s := &faultyStorage{Interface: fake, injector: NewInjector(Config{WatchDropRate: 1}), gr: gr}
+		w, err := s.Watch(ctx, "/bars", storage.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		defer w.Stop()
+
+		fake.watcher.Add(&metav1.PartialObjectMetadata{})
+		fake.watcher.Action(watch.Bookmark, &metav1.PartialObjectMetadata{})
+		Eventually(w.ResultChan()).Should(Receive(HaveField("Type", watch.Bookmark)))
+	})
+
+	It("should inject the same faults for the same seed", func() {
+		results := func() []bool {
+			i := NewInjector(Config{ErrorRate: 0.5, Seed: 42})
+			out := []bool{}
+			for range 20 {
+				out = append(out, i.fault(ctx, gr, "/bars/a") != nil)
+			}
+			return out
+		}
+		Expect(results()).To(Equal(results()))
+	})
+})
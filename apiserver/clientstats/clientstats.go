@@ -0,0 +1,267 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package clientstats counts the requests of clients to the resources of a server by user, user
+// agent, verb and resource, to identify misbehaving controllers hammering an aggregated API.
+package clientstats
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/endpoints/responsewriter"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+)
+
+// Path is the path serving the Summary of the top clients to authorized clients.
+const Path = "/debug/kit/clients"
+
+// DefaultMaxClients is the default number of tracked clients.
+const DefaultMaxClients = 1000
+
+// maxUserAgentLength truncates user agents, which are chosen by clients.
+const maxUserAgentLength = 256
+
+var (
+	untrackedRequests = metrics.NewCounter(
+		&metrics.CounterOpts{
+			Namespace:      "kit",
+			Subsystem:      "clientstats",
+			Name:           "untracked_requests_total",
+			Help:           "Number of requests not counted per client, as the maximum number of tracked clients has been reached.",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+
+	registerMetricsOnce sync.Once
+)
+
+// RegisterMetrics registers the metrics of this package with the legacy registry,
+// which is served on /metrics. It is safe to call multiple times.
+func RegisterMetrics() {
+	registerMetricsOnce.Do(func() {
+		legacyregistry.MustRegister(untrackedRequests)
+	})
+}
+
+// Config configures the tracking of clients.
+type Config struct {
+	// MaxClients is the maximum number of tracked combinations of user, user agent, verb and
+	// resource, DefaultMaxClients if 0. Requests of further clients are only counted in
+	// kit_clientstats_untracked_requests_total.
+	MaxClients int
+}
+
+// Client identifies the requests of a client to a resource.
+type Client struct {
+	User      string `json:"user"`
+	UserAgent string `json:"userAgent"`
+	Verb      string `json:"verb"`
+	// Resource is the resource of the requests, with its group and subresource, e.g.
+	// "bars.foo.opendefense.cloud/status".
+	Resource string `json:"resource"`
+}
+
+// ClientStats are the requests of a client since the server started.
+type ClientStats struct {
+	Client
+	// Requests is the number of requests.
+	Requests int64 `json:"requests"`
+	// Throttled is the number of requests rejected with 429, e.g. by priority and fairness.
+	Throttled int64 `json:"throttled"`
+	// Failed is the number of requests answered with a status code of 500 or above.
+	Failed int64 `json:"failed"`
+}
+
+// Summary lists the clients with the most requests since Since.
+type Summary struct {
+	Since   time.Time     `json:"since"`
+	Clients []ClientStats `json:"clients"`
+}
+
+// Tracker counts the requests of clients to the resources of the given API groups.
+type Tracker struct {
+	groups     sets.Set[string]
+	maxClients int
+	since      time.Time
+
+	mu      sync.Mutex
+	clients map[Client]*ClientStats
+}
+
+// NewTracker returns a Tracker of the requests to the resources of groups.
+func NewTracker(c Config, groups ...string) *Tracker {
+	maxClients := c.MaxClients
+	if maxClients <= 0 {
+		maxClients = DefaultMaxClients
+	}
+
+	return &Tracker{
+		groups:     sets.New(groups...),
+		maxClients: maxClients,
+		since:      time.Now(),
+		clients:    map[Client]*ClientStats{},
+	}
+}
+
+// Summary returns the limit clients with the most requests, all if limit is 0.
+func (t *Tracker) Summary(limit int) Summary {
+	t.mu.Lock()
+	clients := make([]ClientStats, 0, len(t.clients))
+	for _, stats := range t.clients {
+		clients = append(clients, *stats)
+	}
+	t.mu.Unlock()
+	slices.SortFunc(clients, func(a, b ClientStats) int {
+		return cmp.Or(cmp.Compare(b.Requests, a.Requests), cmp.Compare(a.User, b.User), cmp.Compare(a.UserAgent, b.UserAgent),
+			cmp.Compare(a.Resource, b.Resource), cmp.Compare(a.Verb, b.Verb))
+	})
+	if limit > 0 && len(clients) > limit {
+		clients = clients[:limit]
+	}
+
+	return Summary{Since: t.since, Clients: clients}
+}
+
+// ServeHTTP serves the Summary as JSON. The number of clients is limited by the limit parameter.
+func (t *Tracker) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	limit := 0
+	if l := req.URL.Query().Get("limit"); l != "" {
+		var err error
+		if limit, err = strconv.Atoi(l); err != nil || limit < 0 {
+			http.Error(w, "limit must be a non-negative integer", http.StatusBadRequest)
+			return
+		}
+	}
+	body, err := json.Marshal(t.Summary(limit))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(body)
+}
+
+// record counts a request of client answered with code.
+func (t *Tracker) record(client Client, code int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	stats, ok := t.clients[client]
+	if !ok {
+		if len(t.clients) >= t.maxClients {
+			untrackedRequests.Inc()
+			return
+		}
+		stats = &ClientStats{Client: client}
+		t.clients[client] = stats
+	}
+	stats.Requests++
+	switch {
+	case code == http.StatusTooManyRequests:
+		stats.Throttled++
+	case code >= http.StatusInternalServerError:
+		stats.Failed++
+	}
+}
+
+// BuildHandlerChainFunc returns a handler chain builder that wraps the chain built by delegate
+// with the tracking of clients.
+func (t *Tracker) BuildHandlerChainFunc(delegate func(http.Handler, *genericapiserver.Config) http.Handler) func(http.Handler, *genericapiserver.Config) http.Handler {
+	return func(apiHandler http.Handler, config *genericapiserver.Config) http.Handler {
+		return t.WithTracking(delegate(withUserCapture(apiHandler), config), config.RequestInfoResolver)
+	}
+}
+
+type userHolderKey struct{}
+
+// userHolder transports the authenticated user from the inner end of the handler chain to the
+// tracking filter, which runs before authentication, so throttled requests are counted as well.
+type userHolder struct {
+	mu   sync.Mutex
+	name string
+}
+
+func (h *userHolder) set(name string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.name = name
+}
+
+func (h *userHolder) get() string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.name
+}
+
+// withUserCapture records the authenticated user for WithTracking. It must run after authentication.
+func withUserCapture(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		holder, ok := req.Context().Value(userHolderKey{}).(*userHolder)
+		if u, found := request.UserFrom(req.Context()); ok && found {
+			holder.set(u.GetName())
+		}
+		handler.ServeHTTP(w, req)
+	})
+}
+
+// WithTracking counts the requests to the resources of the tracked groups handled by handler.
+// Users are only known if the inner handler chain is wrapped as done by BuildHandlerChainFunc.
+func (t *Tracker) WithTracking(handler http.Handler, resolver request.RequestInfoResolver) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		info, err := resolver.NewRequestInfo(req)
+		if err != nil || !info.IsResourceRequest || !t.groups.Has(info.APIGroup) {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		holder := &userHolder{}
+		req = req.WithContext(context.WithValue(req.Context(), userHolderKey{}, holder))
+		rw := &statusRecorder{ResponseWriter: w, code: http.StatusOK}
+
+		handler.ServeHTTP(responsewriter.WrapForHTTP1Or2(rw), req)
+
+		resource := info.Resource
+		if info.APIGroup != "" {
+			resource += "." + info.APIGroup
+		}
+		if info.Subresource != "" {
+			resource += "/" + info.Subresource
+		}
+		userAgent := req.UserAgent()
+		if len(userAgent) > maxUserAgentLength {
+			userAgent = userAgent[:maxUserAgentLength]
+		}
+		t.record(Client{User: holder.get(), UserAgent: userAgent, Verb: info.Verb, Resource: resource}, rw.code)
+	})
+}
+
+// statusRecorder records the status code written to the response.
+type statusRecorder struct {
+	http.ResponseWriter
+	code        int
+	wroteHeader bool
+}
+
+var _ responsewriter.UserProvidedDecorator = &statusRecorder{}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	if !r.wroteHeader {
+		r.code = code
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(code)
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package clientstats
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/component-base/metrics/testutil"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tracker", func() {
+	var (
+		tracker  *Tracker
+		resolver = &request.RequestInfoFactory{
+			APIPrefixes:          sets.NewString("apis"),
+			GrouplessAPIPrefixes: sets.NewString(),
+		}
+	)
+
+	// serve emulates the generic handler chain: authentication happens between the tracking
+	// filter and the user capture.
+	serve := func(name, userAgent, method, target string, code int) {
+		inner := withUserCapture(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(code)
+		}))
+		authn := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			ctx := request.WithUser(req.Context(), &user.DefaultInfo{Name: name})
+			inner.ServeHTTP(w, req.WithContext(ctx))
+		})
+		req := httptest.NewRequest(method, target, nil)
+		req.Header.Set("User-Agent", userAgent)
+		tracker.WithTracking(authn, resolver).ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	BeforeEach(func() {
+		RegisterMetrics()
+		tracker = NewTracker(Config{MaxClients: 3}, "foo.opendefense.cloud")
+	})
+
+	It("should report the clients with the most requests", func() {
+		for range 3 {
+			serve("controller", "bar-controller/v1", http.MethodGet, "/apis/foo.opendefense.cloud/v1alpha1/bars", http.StatusOK)
+		}
+		serve("controller", "bar-controller/v1", http.MethodGet, "/apis/foo.opendefense.cloud/v1alpha1/bars", http.StatusTooManyRequests)
+		serve("controller", "bar-controller/v1", http.MethodPut, "/apis/foo.opendefense.cloud/v1alpha1/namespaces/ns/bars/b1/status", http.StatusInternalServerError)
+		serve("alice", "kubectl", http.MethodGet, "/apis/foo.opendefense.cloud/v1alpha1/namespaces/ns/bars/b1", http.StatusOK)
+		// Requests to other groups and non-resource requests are not tracked.
+		serve("alice", "kubectl", http.MethodGet, "/apis/other.opendefense.cloud/v1/things", http.StatusOK)
+		serve("alice", "kubectl", http.MethodGet, "/healthz", http.StatusOK)
+
+		summary := tracker.Summary(0)
+		Expect(summary.Clients).To(Equal([]ClientStats{
+			{
+				Client:    Client{User: "controller", UserAgent: "bar-controller/v1", Verb: "list", Resource: "bars.foo.opendefense.cloud"},
+				Requests:  4,
+				Throttled: 1,
+			},
+			{
+				Client:   Client{User: "alice", UserAgent: "kubectl", Verb: "get", Resource: "bars.foo.opendefense.cloud"},
+				Requests: 1,
+			},
+			{
+				Client:   Client{User: "controller", UserAgent: "bar-controller/v1", Verb: "update", Resource: "bars.foo.opendefense.cloud/status"},
+				Requests: 1,
+				Failed:   1,
+			},
+		}))
+		Expect(tracker.Summary(1).Clients).To(HaveLen(1))
+	})
+
+	It("should limit the number of tracked clients", func() {
+		before, err := testutil.GetCounterMetricValue(untrackedRequests)
+		Expect(err).NotTo(HaveOccurred())
+		for _, name := range []string{"a", "b", "c", "d"} {
+			serve(name, "", http.MethodGet, "/apis/foo.opendefense.cloud/v1alpha1/bars", http.StatusOK)
+		}
+		serve("a", "", http.MethodGet, "/apis/foo.opendefense.cloud/v1alpha1/bars", http.StatusOK)
+
+		Expect(tracker.Summary(0).Clients).To(HaveLen(3))
+		Expect(tracker.Summary(1).Clients[0].User).To(Equal("a"))
+		after, err := testutil.GetCounterMetricValue(untrackedRequests)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(after - before).To(Equal(1.0))
+	})
+
+	It("should serve the summary", func() {
+		serve("alice", "kubectl", http.MethodGet, "/apis/foo.opendefense.cloud/v1alpha1/bars", http.StatusOK)
+		serve("bob", "kubectl", http.MethodGet, "/apis/foo.opendefense.cloud/v1alpha1/bars", http.StatusOK)
+
+		rec := httptest.NewRecorder()
+		tracker.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path+"?limit=1", nil))
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		var summary Summary
+		Expect(json.Unmarshal(rec.Body.Bytes(), &summary)).To(Succeed())
+		Expect(summary.Clients).To(HaveLen(1))
+		Expect(summary.Clients[0].User).To(Equal("alice"))
+
+		rec = httptest.NewRecorder()
+		tracker.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path+"?limit=-1", nil))
+		Expect(rec.Code).To(Equal(http.StatusBadRequest))
+	})
+})
@@ -0,0 +1,740 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"slices"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/admission/plugin/namespace/lifecycle"
+	"k8s.io/apiserver/pkg/endpoints/openapi"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	genericoptions "k8s.io/apiserver/pkg/server/options"
+	"k8s.io/apiserver/pkg/util/compatibility"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	basecompatibility "k8s.io/component-base/compatibility"
+	"k8s.io/component-base/featuregate"
+	baseversion "k8s.io/component-base/version"
+	"k8s.io/klog/v2"
+	netutils "k8s.io/utils/net"
+
+	"go.opendefense.cloud/kit/apiserver/accesslog"
+	"go.opendefense.cloud/kit/apiserver/authn"
+	"go.opendefense.cloud/kit/apiserver/clientstats"
+	"go.opendefense.cloud/kit/apiserver/injection"
+	"go.opendefense.cloud/kit/apiserver/kitapi"
+	"go.opendefense.cloud/kit/apiserver/memstorage"
+	"go.opendefense.cloud/kit/apiserver/mirror"
+	"go.opendefense.cloud/kit/apiserver/profile"
+	"go.opendefense.cloud/kit/apiserver/rest"
+)
+
+// completedConfig is an immutable snapshot of a Builder's configuration. The Builder can be
+// modified or completed again without affecting servers built from a completedConfig.
+type completedConfig struct {
+	builderConfig
+
+	// orderedGroupVersions are the prioritized versions of the served groups, used for storage encoding.
+	orderedGroupVersions []schema.GroupVersion
+
+	// apiEnablement holds the --runtime-config flag enabling and disabling group versions and resources.
+	apiEnablement *genericoptions.APIEnablementOptions
+
+	// apiGroups build the API groups of this completion.
+	apiGroups []APIGroupFn
+
+	// enableAlphaResources holds the --enable-alpha-resources flag, see Builder.WithAlphaResourcesOptIn.
+	enableAlphaResources bool
+
+	// informerFactoriesMu guards sharedInformerFactories, which admission initializers may extend.
+	informerFactoriesMu sync.Mutex
+}
+
+// CompletedServer is a completed API server configuration, which can be validated and run without the
+// command line interface of Execute. It is obtained through Builder.Complete.
+type CompletedServer struct {
+	config *completedConfig
+}
+
+// Complete takes a snapshot of the Builder's configuration and defaults it. The Builder can be
+// completed multiple times, e.g. to start several servers in tests.
+func (b *Builder) Complete() (CompletedServer, error) {
+	c, err := b.complete()
+	if err != nil {
+		return CompletedServer{}, err
+	}
+
+	return CompletedServer{config: c}, nil
+}
+
+// Validate checks the builder configuration and all options.
+func (s CompletedServer) Validate() error {
+	return s.config.validate()
+}
+
+// Run validates the configuration, creates the API server with all registered resources and runs it
+// until ctx is done.
+func (s CompletedServer) Run(ctx context.Context) error {
+	if err := s.config.setComponentGlobals(); err != nil {
+		return err
+	}
+	if err := s.config.validate(); err != nil {
+		return err
+	}
+
+	return s.config.run(ctx)
+}
+
+// complete takes a snapshot of the Builder's configuration and defaults it.
+func (b *Builder) complete() (*completedConfig, error) {
+	b.mu.Lock()
+	c := &completedConfig{builderConfig: b.builderConfig}
+	b.mu.Unlock()
+
+	// Clone all slices, so appending to the Builder does not modify the snapshot and vice versa.
+	c.alternateDNS = slices.Clone(c.alternateDNS)
+	c.groupVersions = slices.Clone(c.groupVersions)
+	c.groupResources = slices.Clone(c.groupResources)
+	c.sharedInformerFactories = slices.Clone(c.sharedInformerFactories)
+	c.configMutators = slices.Clone(c.configMutators)
+	c.subresourceVerbs = slices.Clone(c.subresourceVerbs)
+	c.apiGroupFns = slices.Clone(c.apiGroupFns)
+	c.openAPIDefinitions = slices.Clone(c.openAPIDefinitions)
+	c.groupVersionLifecycles = maps.Clone(c.groupVersionLifecycles)
+	c.resourceValidateFns = slices.Clone(c.resourceValidateFns)
+	c.resourceInfoFns = slices.Clone(c.resourceInfoFns)
+	c.etcdOptionsFns = slices.Clone(c.etcdOptionsFns)
+	c.featureGates = maps.Clone(c.featureGates)
+	c.addFlagsFns = slices.Clone(c.addFlagsFns)
+	c.postStartHooks = slices.Clone(c.postStartHooks)
+	c.authenticatorFns = slices.Clone(c.authenticatorFns)
+	c.admissionPlugins = slices.Clone(c.admissionPlugins)
+	c.unknownModules = slices.Clone(c.unknownModules)
+	c.livezChecks = slices.Clone(c.livezChecks)
+	c.readyzChecks = slices.Clone(c.readyzChecks)
+	c.livezExcludes = slices.Clone(c.livezExcludes)
+	c.reloadFns = maps.Clone(c.reloadFns)
+	// Startup checks pass once for each server, the same instances are added to /readyz and /startupz.
+	c.startupChecks = latched(c.startupChecks)
+
+	// Instantiate the API groups, so their storage and post-start hooks belong to this completion.
+	for _, newFn := range c.apiGroupFns {
+		fn, hooks := newFn()
+		c.apiGroups = append(c.apiGroups, fn)
+		c.postStartHooks = append(c.postStartHooks, hooks...)
+	}
+
+	// Collect the served API groups in the order they have been registered.
+	groupNames := []string{}
+	for _, gv := range c.groupVersions {
+		if !slices.Contains(groupNames, gv.Group) {
+			groupNames = append(groupNames, gv.Group)
+		}
+	}
+	// Validate that the registered resources match the scheme and that the lifecycles are valid.
+	errs := []error{}
+	registered := sets.New[schema.GroupResource]()
+	for _, gr := range c.groupResources {
+		if registered.Has(gr) {
+			errs = append(errs, fmt.Errorf("resource %s is registered more than once, pass it to With only once", gr))
+		}
+		registered.Insert(gr)
+	}
+	for _, name := range c.unknownModules {
+		errs = append(errs, fmt.Errorf("module %q is not registered, registered modules are %v", name, RegisteredModules()))
+	}
+	for _, fn := range c.resourceValidateFns {
+		errs = append(errs, fn(c.scheme))
+	}
+	for gv, l := range c.groupVersionLifecycles {
+		if err := l.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid lifecycle of %s: %w", gv, err))
+		}
+	}
+	errs = append(errs, c.validatePostStartHooks(), c.validateConfigMutators(), c.validateSubresourceVerbs())
+	if c.requestMirror != nil {
+		if err := c.requestMirror.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid request mirroring: %w", err))
+		}
+	}
+	if c.tlsPolicy != nil {
+		if err := c.tlsPolicy.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid TLS policy: %w", err))
+		}
+	}
+	if c.delegatedAuth != nil {
+		if err := c.delegatedAuth.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid delegated authentication: %w", err))
+		}
+	}
+	if err := utilerrors.NewAggregate(errs); err != nil {
+		return nil, err
+	}
+	// Get the ordered group versions to ensure storage encoding matches the registered types.
+	c.orderedGroupVersions = nil
+	for _, groupName := range groupNames {
+		c.orderedGroupVersions = append(c.orderedGroupVersions, c.scheme.PrioritizedVersionsForGroup(groupName)...)
+	}
+
+	// Set up default recommended options if not already configured. The etcd prefix is derived from
+	// the first group, the keys of all resources contain their group below it.
+	if c.recommendedOptions == nil {
+		groupName := ""
+		if len(groupNames) > 0 {
+			groupName = groupNames[0]
+		}
+		c.recommendedOptions = genericoptions.NewRecommendedOptions(
+			fmt.Sprintf("/registry/%s", groupName),
+			c.codecs.LegacyCodec(c.orderedGroupVersions...),
+		)
+	}
+	c.apiEnablement = genericoptions.NewAPIEnablementOptions()
+	// Drop options depending on a kube-apiserver when serving standalone.
+	if err := c.applyStandaloneOptions(); err != nil {
+		return nil, err
+	}
+	// Restrict the TLS configuration of the secure port. The flags take precedence.
+	c.applyTLSPolicy()
+	// Configure the reviews of delegated authentication and authorization. The flags take precedence.
+	c.applyDelegatedAuth()
+	// Probes of the startup are allowed like the other health endpoints.
+	if c.recommendedOptions.Authorization != nil {
+		c.recommendedOptions.Authorization.AlwaysAllowPaths = append(c.recommendedOptions.Authorization.AlwaysAllowPaths, StartupzPath)
+	}
+	// Apply the defaulting profiles in admission.
+	if err := c.applyDefaultingProfiles(); err != nil {
+		return nil, err
+	}
+	// Inject labels and annotations in admission. The plugins of the modules are enabled before it.
+	if err := c.applyMetadataInjection(); err != nil {
+		return nil, err
+	}
+	// Enable the admission plugins of the modules.
+	if err := c.applyAdmissionPlugins(); err != nil {
+		return nil, err
+	}
+	// Compact etcd no earlier than the lifetime of continue tokens. The flag takes precedence.
+	if c.continueTokenLifetime > 0 {
+		c.recommendedOptions.Etcd.StorageConfig.CompactionInterval = c.continueTokenLifetime
+	}
+	// Apply the etcd options of the Builder. The flags take precedence.
+	for _, fn := range c.etcdOptionsFns {
+		fn(c.recommendedOptions.Etcd)
+	}
+	// Configure storage to use the ordered group versions for encoding.
+	c.recommendedOptions.Etcd.StorageConfig.EncodeVersioner = schema.GroupVersions(c.orderedGroupVersions)
+	// Drop the etcd options when keeping the objects in memory.
+	if c.inMemoryStorage {
+		c.recommendedOptions.Etcd = nil
+	}
+	// Wire up admission initializers if provided.
+	if c.extraAdmissionInitializers != nil {
+		c.recommendedOptions.ExtraAdmissionInitializers = func(rc *genericapiserver.RecommendedConfig) ([]admission.PluginInitializer, error) {
+			informerFactory, pluginInitialisers, err := c.extraAdmissionInitializers(rc)
+			if err != nil {
+				return nil, err
+			}
+			// Collect informer factories from admission setup.
+			if informerFactory != nil {
+				c.informerFactoriesMu.Lock()
+				defer c.informerFactoriesMu.Unlock()
+				c.sharedInformerFactories = append(c.sharedInformerFactories, informerFactory)
+			}
+
+			return pluginInitialisers, nil
+		}
+	}
+	// Set up TLS certificates for secure serving if possible and not otherwise provided.
+	_ = c.recommendedOptions.SecureServing.MaybeDefaultWithSelfSignedCerts("localhost", c.alternateDNS, []net.IP{netutils.ParseIPSloppy("127.0.0.1")})
+
+	// Serve the OpenAPI definitions given to the builder and registered by API packages.
+	c.applyOpenAPIDefinitions()
+
+	// Use default component registry if not provided.
+	if c.componentGlobalsRegistry == nil {
+		c.componentGlobalsRegistry = compatibility.DefaultComponentGlobalsRegistry
+	}
+	if err := c.registerComponentGlobals(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// applyDefaultingProfiles registers the admission plugin applying the defaulting profiles and
+// enables it right after the namespace lifecycle plugin, so namespaces exist when it runs.
+func (c *completedConfig) applyDefaultingProfiles() error {
+	if c.defaultingProfiles == nil {
+		return nil
+	}
+	admissionOptions := c.recommendedOptions.Admission
+	profile.Register(admissionOptions.Plugins, c.defaultingProfiles)
+	i := slices.Index(admissionOptions.RecommendedPluginOrder, lifecycle.PluginName) + 1
+	admissionOptions.RecommendedPluginOrder = slices.Insert(slices.Clone(admissionOptions.RecommendedPluginOrder), i, profile.PluginName)
+
+	return nil
+}
+
+// applyMetadataInjection registers the admission plugin injecting labels and annotations and
+// enables it after the namespace lifecycle plugin and the defaulting profiles.
+func (c *completedConfig) applyMetadataInjection() error {
+	if c.metadataInjection == nil {
+		return nil
+	}
+	if err := c.metadataInjection.Validate(); err != nil {
+		return fmt.Errorf("invalid metadata injection: %w", err)
+	}
+	admissionOptions := c.recommendedOptions.Admission
+	injection.Register(admissionOptions.Plugins, *c.metadataInjection)
+	order := slices.Clone(admissionOptions.RecommendedPluginOrder)
+	i := max(slices.Index(order, lifecycle.PluginName), slices.Index(order, profile.PluginName)) + 1
+	admissionOptions.RecommendedPluginOrder = slices.Insert(order, i, injection.PluginName)
+
+	return nil
+}
+
+// applyOpenAPIDefinitions configures OpenAPI v2 and v3 documentation if any definitions are available.
+// It runs before all other config mutators, which may thus modify the OpenAPI configuration, unless
+// ConfigMutatorOpenAPI has been replaced or removed.
+func (c *completedConfig) applyOpenAPIDefinitions() {
+	defs := append(kitapi.RegisteredOpenAPIDefinitions(), c.openAPIDefinitions...)
+	if len(defs) == 0 || slices.ContainsFunc(c.configMutators, func(m configMutator) bool { return m.name == ConfigMutatorOpenAPI }) {
+		return
+	}
+	getDefinitions := kitapi.MergeOpenAPIDefinitions(defs...)
+	title := c.openAPITitle
+	if title == "" {
+		title = c.componentName
+	}
+	configureOpenAPI := func(config *genericapiserver.RecommendedConfig) {
+		config.OpenAPIConfig = genericapiserver.DefaultOpenAPIConfig(getDefinitions, openapi.NewDefinitionNamer(c.scheme))
+		config.OpenAPIConfig.Info.Title = title
+		config.OpenAPIV3Config = genericapiserver.DefaultOpenAPIV3Config(getDefinitions, openapi.NewDefinitionNamer(c.scheme))
+		config.OpenAPIV3Config.Info.Title = title
+		config.OpenAPIV3Config.PostProcessSpec = kitapi.AddTableResponses(config.OpenAPIV3Config)
+		if c.openAPIVersion != "" {
+			config.OpenAPIConfig.Info.Version = c.openAPIVersion
+			config.OpenAPIV3Config.Info.Version = c.openAPIVersion
+		}
+	}
+	c.configMutators = append([]configMutator{{name: ConfigMutatorOpenAPI, phase: ConfigPhasePreOptions, fn: configureOpenAPI}}, c.configMutators...)
+}
+
+var (
+	// versionMappingsMu guards versionMappings.
+	versionMappingsMu sync.Mutex
+	// versionMappings records the components whose emulation version mapping has been set per
+	// registry, as a mapping can only be set once. Unlike the rest of a completion, it is shared
+	// by all completions, since they share the registry.
+	versionMappings = map[basecompatibility.ComponentGlobalsRegistry]sets.Set[string]{}
+)
+
+// buildAPIGroups builds the API groups of the registered handlers, up to groupInstallParallelism
+// at once, and merges the resources of handlers of the same group. The groups are returned in the
+// order of their registration. A panicking handler panics after all handlers have returned.
+func (c *completedConfig) buildAPIGroups(completedConfig *genericapiserver.CompletedConfig) ([]*genericapiserver.APIGroupInfo, error) {
+	infos := make([]genericapiserver.APIGroupInfo, len(c.apiGroups))
+	panics := make([]any, len(c.apiGroups))
+	g := errgroup.Group{}
+	g.SetLimit(max(c.groupInstallParallelism, 1))
+	for i, fn := range c.apiGroups {
+		g.Go(func() error {
+			defer func() { panics[i] = recover() }()
+			infos[i] = fn(c.scheme, c.codecs, completedConfig)
+
+			return nil
+		})
+	}
+	_ = g.Wait()
+	for _, p := range panics {
+		if p != nil {
+			panic(p)
+		}
+	}
+
+	groups := []*genericapiserver.APIGroupInfo{}
+	byName := map[string]*genericapiserver.APIGroupInfo{}
+	errs := []error{}
+	for i := range infos {
+		groupName := ""
+		for _, gv := range infos[i].PrioritizedVersions {
+			groupName = gv.Group
+			break
+		}
+		if groupName == "" {
+			errs = append(errs, fmt.Errorf("empty group name is not allowed"))
+			continue
+		}
+
+		// Merge resources from multiple handlers for the same group.
+		if apiGroupInfoPrev, ok := byName[groupName]; ok {
+			apiGroupInfoPrev.VersionedResourcesStorageMap = mergeVersionedResourcesStorageMap(apiGroupInfoPrev.VersionedResourcesStorageMap, infos[i].VersionedResourcesStorageMap)
+		} else {
+			byName[groupName] = &infos[i]
+			groups = append(groups, &infos[i])
+		}
+	}
+
+	return groups, errors.Join(errs...)
+}
+
+// registerComponentGlobals registers component versions and feature gates with the global registry.
+func (c *completedConfig) registerComponentGlobals() error {
+	effectiveVersion, err := c.effectiveVersion()
+	if err != nil {
+		return err
+	}
+	// Register the component with the global component registry,
+	// associating it with its effective version and feature gate configuration.
+	// Will skip if the component has been registered, like in the integration test.
+	_, featureGate := c.componentGlobalsRegistry.ComponentGlobalsOrRegister(
+		c.componentName, effectiveVersion,
+		featuregate.NewVersionedFeatureGate(effectiveVersion.EmulationVersion()))
+
+	// Add the feature gates of the Builder, which are enabled depending on the emulation version
+	// of the component. Gates known from an earlier completion are not added again, as the gate
+	// is closed once its flags are added.
+	known := featureGate.GetAllVersioned()
+	added := map[featuregate.Feature]featuregate.VersionedSpecs{}
+	for name, specs := range c.featureGates {
+		if _, ok := known[name]; !ok {
+			added[name] = specs
+		}
+	}
+	if len(added) > 0 {
+		if err := featureGate.AddVersioned(added); err != nil {
+			return err
+		}
+	}
+
+	// Register the default kube component if not already present in the global registry.
+	_, _ = c.componentGlobalsRegistry.ComponentGlobalsOrRegister(basecompatibility.DefaultKubeComponent,
+		basecompatibility.NewEffectiveVersionFromString(baseversion.DefaultKubeBinaryVersion, "", ""), utilfeature.DefaultMutableFeatureGate)
+
+	// Set the emulation version mapping from the component to the kube component.
+	// This ensures that the emulation version of the latter is determined by the emulation version of the former.
+	versionMappingsMu.Lock()
+	defer versionMappingsMu.Unlock()
+	if versionMappings[c.componentGlobalsRegistry].Has(c.componentName) {
+		return nil
+	}
+	versionToKubeVersion := c.kubeVersionMapping
+	if versionToKubeVersion == nil {
+		versionToKubeVersion = defaultKubeVersionMapping(effectiveVersion.BinaryVersion())
+	}
+	if err := c.componentGlobalsRegistry.SetVersionMapping(c.componentName, basecompatibility.DefaultKubeComponent, versionToKubeVersion); err != nil {
+		return err
+	}
+	if versionMappings[c.componentGlobalsRegistry] == nil {
+		versionMappings[c.componentGlobalsRegistry] = sets.New[string]()
+	}
+	versionMappings[c.componentGlobalsRegistry].Insert(c.componentName)
+
+	return nil
+}
+
+// command returns the cobra command running the server with the flags of all options.
+func (c *completedConfig) command(ctx context.Context) *cobra.Command {
+	var buildInfo bool
+	cmd := &cobra.Command{
+		Short: "Launch API server",
+		Long:  "Launch API server",
+		PersistentPreRunE: func(*cobra.Command, []string) error {
+			return c.setComponentGlobals()
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if buildInfo {
+				return printBuildInfo(cmd.OutOrStdout())
+			}
+			if err := c.validate(); err != nil {
+				return err
+			}
+
+			return c.run(cmd.Context())
+		},
+	}
+	cmd.SetContext(ctx)
+
+	flags := cmd.Flags()
+	c.recommendedOptions.AddFlags(flags)
+	c.apiEnablement.AddFlags(flags)
+	c.componentGlobalsRegistry.AddFlags(flags)
+	flags.BoolVar(&buildInfo, "build-info", false, "Print the build information of the server, e.g. its module versions and VCS revision, as JSON and exit.")
+	if c.alphaResourcesOptIn {
+		flags.BoolVar(&c.enableAlphaResources, enableAlphaResourcesFlag, false, "Serve the resources whose API is alpha and may change incompatibly or be removed without notice.")
+	}
+	for _, addFlags := range c.addFlagsFns {
+		addFlags(flags)
+	}
+
+	// TODO: add kube version compatibility matrix and feature gates
+
+	return cmd
+}
+
+// setComponentGlobals applies the emulation versions and feature gates to the component globals registry.
+func (c *completedConfig) setComponentGlobals() error {
+	if c.skipDefaultComponentGlobalsRegistrySet {
+		return nil
+	}
+
+	return c.componentGlobalsRegistry.Set()
+}
+
+// validate checks the builder configuration and all options.
+func (c *completedConfig) validate() error {
+	// Validate essential builder configuration early to provide a helpful error
+	if len(c.orderedGroupVersions) == 0 {
+		return fmt.Errorf("orderedGroupVersions not set on Builder; call WithGroupVersions(...) before Execute")
+	}
+	// Collect and validate all configuration.
+	errors := []error{}
+	errors = append(errors, c.recommendedOptions.Validate()...)
+	errors = append(errors, c.apiEnablement.Validate(c.scheme)...)
+	errors = append(errors, c.componentGlobalsRegistry.Validate()...)
+
+	return utilerrors.NewAggregate(errors)
+}
+
+// run creates the API server, installs all API groups and runs it until ctx is done.
+func (c *completedConfig) run(ctx context.Context) error {
+	serverConfig := genericapiserver.NewRecommendedConfig(c.codecs)
+
+	// Apply the config mutators running before the options.
+	c.mutateConfig(ConfigPhasePreOptions, serverConfig)
+
+	// Set feature gates and versioning.
+	serverConfig.FeatureGate = c.componentGlobalsRegistry.FeatureGateFor(basecompatibility.DefaultKubeComponent)
+	serverConfig.EffectiveVersion = c.componentGlobalsRegistry.EffectiveVersionFor(c.componentName)
+
+	// Apply recommended options (TLS, etcd, admission, etc.).
+	if err := c.recommendedOptions.ApplyTo(serverConfig); err != nil {
+		return err
+	}
+
+	// Keep the objects in memory instead of etcd if requested.
+	if c.inMemoryStorage {
+		serverConfig.RESTOptionsGetter = memstorage.NewRESTOptionsGetter(c.codecs.LegacyCodec(c.orderedGroupVersions...), schema.GroupVersions(c.orderedGroupVersions))
+	}
+
+	// Reload the audit policy and the registered settings on SIGHUP.
+	if err := c.applyReload(ctx, serverConfig); err != nil {
+		return err
+	}
+
+	// Add the health checks of the Builder.
+	if err := c.applyHealthChecks(&serverConfig.Config); err != nil {
+		return err
+	}
+
+	// Enable and disable group versions and resources by --runtime-config.
+	if err := c.applyRuntimeConfig(serverConfig); err != nil {
+		return err
+	}
+
+	// Install additional authenticators and the standalone authorizer.
+	if err := c.applyAuthentication(ctx, serverConfig); err != nil {
+		return err
+	}
+
+	// Authorize requests to subresources with their custom verbs.
+	c.applySubresourceVerbs(serverConfig)
+
+	// Log sampled requests if requested.
+	if c.accessLog != nil {
+		serverConfig.BuildHandlerChainFunc = accesslog.BuildHandlerChainFunc(*c.accessLog, serverConfig.BuildHandlerChainFunc)
+	}
+
+	// Count the requests of clients to the resources of the server if requested.
+	var tracker *clientstats.Tracker
+	if c.clientStats != nil {
+		groups := make([]string, 0, len(c.orderedGroupVersions))
+		for _, gv := range c.orderedGroupVersions {
+			groups = append(groups, gv.Group)
+		}
+		tracker = clientstats.NewTracker(*c.clientStats, groups...)
+		serverConfig.BuildHandlerChainFunc = tracker.BuildHandlerChainFunc(serverConfig.BuildHandlerChainFunc)
+		clientstats.RegisterMetrics()
+	}
+
+	// Mirror sampled reads to a secondary server if requested.
+	if c.requestMirror != nil {
+		serverConfig.BuildHandlerChainFunc = mirror.BuildHandlerChainFunc(*c.requestMirror, serverConfig.BuildHandlerChainFunc)
+		mirror.RegisterMetrics()
+	}
+
+	// Warn clients about expiring client certificates if requested.
+	if c.clientCertExpiryThreshold > 0 {
+		serverConfig.BuildHandlerChainFunc = authn.BuildHandlerChainFunc(c.clientCertExpiryThreshold, serverConfig.BuildHandlerChainFunc)
+		authn.RegisterMetrics()
+	}
+
+	// Record the user agent of requests for the validation metrics and the canary opt-in.
+	serverConfig.BuildHandlerChainFunc = withUserAgent(serverConfig.BuildHandlerChainFunc)
+	rest.RegisterMetrics()
+
+	// Warn about deprecated group versions.
+	emulationVersion := serverConfig.EffectiveVersion.EmulationVersion()
+	serverConfig.BuildHandlerChainFunc = withDeprecationWarnings(serverConfig.BuildHandlerChainFunc, c.deprecationWarnings(emulationVersion))
+
+	// Warn about alpha and beta resources.
+	stabilities := c.resourceStabilities()
+	serverConfig.BuildHandlerChainFunc = withStabilityWarnings(serverConfig.BuildHandlerChainFunc, stabilityWarnings(stabilities))
+
+	// Apply the config mutators running after the options and the configuration of the Builder.
+	c.mutateConfig(ConfigPhasePostOptions, serverConfig)
+
+	// Keep the metadata of objects created by authorized import requests if requested. The imported
+	// metadata takes precedence over the identity set by the Builder.
+	if c.importMode {
+		serverConfig.BuildHandlerChainFunc = withImport(serverConfig.BuildHandlerChainFunc)
+		serverConfig.RESTOptionsGetter = rest.ImportRESTOptionsGetter(serverConfig.RESTOptionsGetter)
+	}
+
+	// Set the identity of created objects if requested.
+	if c.identityFn != nil {
+		serverConfig.RESTOptionsGetter = rest.IdentityRESTOptionsGetter(serverConfig.RESTOptionsGetter, c.identityFn)
+	}
+
+	// Inject storage faults for resilience testing if requested.
+	if c.storageFaultInjector != nil {
+		serverConfig.RESTOptionsGetter = c.storageFaultInjector.RESTOptionsGetter(serverConfig.RESTOptionsGetter)
+	}
+
+	// Create the fully configured API server.
+	completedConfig := serverConfig.Complete()
+	server, err := completedConfig.New(fmt.Sprintf("%s-apiserver", c.componentName), genericapiserver.NewEmptyDelegate())
+	if err != nil {
+		return err
+	}
+
+	// Build API groups from registered handlers and install them into the server.
+	apiGroupInfos, err := c.buildAPIGroups(&completedConfig)
+	if err != nil {
+		return err
+	}
+
+	// Install all API groups into the server, skipping group versions not served at the emulation version,
+	// resources disabled by --runtime-config and alpha resources without opt-in.
+	installed := []*genericapiserver.APIGroupInfo{}
+	for _, apiGroupInfo := range apiGroupInfos {
+		c.removeUnservedVersions(apiGroupInfo, emulationVersion)
+		removeDisabledResources(apiGroupInfo, serverConfig.MergedResourceConfig)
+		if !c.servesAlphaResources() {
+			removeAlphaResources(apiGroupInfo, stabilities)
+		}
+		if len(apiGroupInfo.PrioritizedVersions) == 0 {
+			continue
+		}
+		if err := server.InstallAPIGroup(apiGroupInfo); err != nil {
+			return err
+		}
+		installed = append(installed, apiGroupInfo)
+	}
+
+	// Describe the installed API groups if requested.
+	if err := c.installSelfDescription(server, installed, serverConfig.FeatureGate, serverConfig.EffectiveVersion); err != nil {
+		return err
+	}
+
+	// Collect the informer factories of the server.
+	informerFactories := func() []SharedInformerFactory {
+		factories := []SharedInformerFactory{}
+		// Defensive: the SharedInformerFactory may not be set by the recommended options
+		// in all call sites (callers may provide their own factories via WithSharedInformerFactory).
+		// Avoid a nil-pointer panic by checking for nil before starting.
+		if serverConfig.SharedInformerFactory != nil {
+			factories = append(factories, InformerFactory(serverConfig.SharedInformerFactory))
+		}
+		c.informerFactoriesMu.Lock()
+		defer c.informerFactoriesMu.Unlock()
+
+		return append(factories, c.sharedInformerFactories...)
+	}
+
+	// The server and its post-start hooks run in an errgroup: the first failing hook cancels the
+	// context of the server, which shuts down, and its error is returned. The informers are stopped
+	// once the server shut down, so requests in flight can still read from them, and waited for,
+	// so they don't outlive the server.
+	group, groupCtx := errgroup.WithContext(ctx)
+	informersCtx, stopInformers := context.WithCancel(context.WithoutCancel(ctx))
+	defer stopInformers()
+	hookErrs := make(chan error, 1)
+	fail := func(err error) {
+		select {
+		case hookErrs <- err:
+		default:
+		}
+	}
+
+	// Register post-start hook to start informers once server is ready, followed by the hooks
+	// added through the builder in the order of their dependencies.
+	hooks := append([]postStartHook{{
+		name: c.informersHookName(),
+		fn: func(genericapiserver.PostStartHookContext) error {
+			for _, sharedInformerFactory := range informerFactories() {
+				sharedInformerFactory.Start(informersCtx)
+			}
+
+			return nil
+		},
+	}}, c.postStartHooks...)
+	informersSynced := postStartHook{
+		name:  PostStartHookInformersSynced,
+		after: []string{c.informersHookName()},
+		fn: func(context genericapiserver.PostStartHookContext) error {
+			return waitForCacheSync(context, informerFactories()...)
+		},
+	}
+	done, err := addPostStartHooks(server, hooks, informersSynced, fail)
+	if err != nil {
+		return err
+	}
+	// Report the startup once the startup checks passed and the hooks completed.
+	c.installStartupz(server, done)
+
+	// Serve the provenance of the binary to authorized clients.
+	if err := installBuildInfo(server); err != nil {
+		return err
+	}
+	// Serve the clients with the most requests to authorized clients.
+	if tracker != nil {
+		server.Handler.NonGoRestfulMux.Handle(clientstats.Path, tracker)
+	}
+
+	prepared := server.PrepareRun()
+	stopped := make(chan struct{})
+	group.Go(func() error {
+		defer close(stopped)
+		defer func() {
+			stopInformers()
+			if err := shutdownInformers(informerShutdownTimeout, informerFactories()...); err != nil {
+				klog.FromContext(ctx).Error(err, "Failed to stop informers")
+			}
+		}()
+
+		return prepared.RunWithContext(groupCtx)
+	})
+	group.Go(func() error {
+		select {
+		case err := <-hookErrs:
+			return err
+		case <-stopped:
+			return nil
+		}
+	})
+
+	return group.Wait()
+}
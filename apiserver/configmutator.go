@@ -0,0 +1,94 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"fmt"
+	"slices"
+
+	genericapiserver "k8s.io/apiserver/pkg/server"
+)
+
+// ConfigPhase is the phase of the server configuration in which a config mutator runs.
+type ConfigPhase string
+
+const (
+	// ConfigPhasePreOptions runs before the options of the flags are applied, e.g. to set defaults
+	// which the flags override.
+	ConfigPhasePreOptions ConfigPhase = "PreOptions"
+	// ConfigPhasePostOptions runs after the options and the configuration of the Builder have been
+	// applied, e.g. to replace the admission chain.
+	ConfigPhasePostOptions ConfigPhase = "PostOptions"
+)
+
+// ConfigMutatorOpenAPI is the name of the config mutator configuring the OpenAPI documentation of
+// the definitions registered with WithOpenAPIDefinitions. It runs first in ConfigPhasePreOptions.
+const ConfigMutatorOpenAPI = "openapi"
+
+// configMutator is a named RecommendedConfigFn. A nil fn removes the mutator of the name.
+type configMutator struct {
+	name  string
+	phase ConfigPhase
+	fn    RecommendedConfigFn
+}
+
+// WithConfigMutator modifies the RecommendedConfig of the server in phase. Mutators of a phase
+// run in the order they were registered. Registering a name again replaces the mutator in its
+// position, which also replaces the defaults of the kit, e.g. ConfigMutatorOpenAPI.
+func (b *Builder) WithConfigMutator(name string, phase ConfigPhase, fn RecommendedConfigFn) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.configMutators = setConfigMutator(b.configMutators, configMutator{name: name, phase: phase, fn: fn})
+
+	return b
+}
+
+// WithoutConfigMutator removes the named config mutator, e.g. ConfigMutatorOpenAPI.
+func (b *Builder) WithoutConfigMutator(name string) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.configMutators = setConfigMutator(b.configMutators, configMutator{name: name})
+
+	return b
+}
+
+// setConfigMutator replaces the mutator of the same name or appends m.
+func setConfigMutator(mutators []configMutator, m configMutator) []configMutator {
+	if i := slices.IndexFunc(mutators, func(o configMutator) bool { return o.name == m.name }); i >= 0 {
+		mutators[i] = m
+
+		return mutators
+	}
+
+	return append(mutators, m)
+}
+
+// validateConfigMutators returns an error if a mutator has no name or an invalid phase.
+func (c *completedConfig) validateConfigMutators() error {
+	for _, m := range c.configMutators {
+		if m.name == "" {
+			return fmt.Errorf("config mutators require a name")
+		}
+		switch m.phase {
+		case ConfigPhasePreOptions, ConfigPhasePostOptions:
+		case "":
+			if m.fn != nil {
+				return fmt.Errorf("config mutator %q has no phase", m.name)
+			}
+		default:
+			return fmt.Errorf("config mutator %q has an invalid phase %q", m.name, m.phase)
+		}
+	}
+
+	return nil
+}
+
+// mutateConfig runs the config mutators of phase.
+func (c *completedConfig) mutateConfig(phase ConfigPhase, config *genericapiserver.RecommendedConfig) {
+	for _, m := range c.configMutators {
+		if m.phase == phase && m.fn != nil {
+			m.fn(config)
+		}
+	}
+}
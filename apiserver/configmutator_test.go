@@ -0,0 +1,79 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	basecompatibility "k8s.io/component-base/compatibility"
+	openapicommon "k8s.io/kube-openapi/pkg/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config mutators", func() {
+	var (
+		b   *Builder
+		ran []string
+	)
+
+	mutator := func(name string) RecommendedConfigFn {
+		return func(*genericapiserver.RecommendedConfig) { ran = append(ran, name) }
+	}
+
+	BeforeEach(func() {
+		ran = nil
+		b = NewBuilder(runtime.NewScheme()).WithComponentName("test")
+		b.componentGlobalsRegistry = basecompatibility.NewComponentGlobalsRegistry()
+	})
+
+	It("should run the mutators of a phase in the order they were registered", func() {
+		c, err := b.
+			WithConfigMutator("a", ConfigPhasePostOptions, mutator("a")).
+			WithConfigMutator("b", ConfigPhasePreOptions, mutator("b")).
+			WithConfigMutator("c", ConfigPhasePostOptions, mutator("c")).
+			WithConfigMutator("a", ConfigPhasePostOptions, mutator("a2")).
+			complete()
+		Expect(err).NotTo(HaveOccurred())
+
+		config := genericapiserver.NewRecommendedConfig(c.codecs)
+		c.mutateConfig(ConfigPhasePreOptions, config)
+		Expect(ran).To(Equal([]string{"b"}))
+		ran = nil
+		c.mutateConfig(ConfigPhasePostOptions, config)
+		Expect(ran).To(Equal([]string{"a2", "c"}))
+	})
+
+	It("should replace and remove the defaults", func() {
+		defs := func(openapicommon.ReferenceCallback) map[string]openapicommon.OpenAPIDefinition {
+			return map[string]openapicommon.OpenAPIDefinition{"test.Test": {}}
+		}
+		b.WithOpenAPIDefinitions("Test API", "v0.1.0", defs)
+
+		c, err := b.WithConfigMutator(ConfigMutatorOpenAPI, ConfigPhasePostOptions, mutator("openapi")).complete()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.configMutators).To(HaveLen(1))
+		config := genericapiserver.NewRecommendedConfig(c.codecs)
+		c.mutateConfig(ConfigPhasePostOptions, config)
+		Expect(ran).To(Equal([]string{"openapi"}))
+		Expect(config.OpenAPIConfig).To(BeNil())
+
+		c, err = b.WithoutConfigMutator(ConfigMutatorOpenAPI).complete()
+		Expect(err).NotTo(HaveOccurred())
+		config = genericapiserver.NewRecommendedConfig(c.codecs)
+		c.mutateConfig(ConfigPhasePreOptions, config)
+		Expect(config.OpenAPIConfig).To(BeNil())
+	})
+
+	It("should reject invalid mutators", func() {
+		_, err := b.WithConfigMutator("", ConfigPhasePreOptions, mutator("a")).complete()
+		Expect(err).To(MatchError(ContainSubstring("require a name")))
+
+		b = NewBuilder(runtime.NewScheme()).WithConfigMutator("a", "Later", mutator("a"))
+		b.componentGlobalsRegistry = basecompatibility.NewComponentGlobalsRegistry()
+		_, err = b.complete()
+		Expect(err).To(MatchError(ContainSubstring(`invalid phase "Later"`)))
+	})
+})
@@ -0,0 +1,298 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package conventions checks the source of API types for violations of the Kubernetes API
+// conventions before code is generated from them, e.g. in unit tests of API packages:
+//
+//	It("should follow the API conventions", func() {
+//	    Expect(conventions.Check(".")).To(BeEmpty())
+//	})
+package conventions
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// Rules checked by Check.
+const (
+	// RuleMarkers requires fields to be marked either +optional or +required.
+	RuleMarkers = "markers"
+	// RuleOmitEmpty requires optional fields to be omitted from JSON if they are empty.
+	RuleOmitEmpty = "omitempty"
+	// RuleListType requires list fields to declare their +listType, and +listMapKey for maps.
+	RuleListType = "listtype"
+	// RuleConditions requires conditions to be a map of metav1.Condition keyed by type.
+	RuleConditions = "conditions"
+	// RulePlural requires list fields and the items of list kinds to be named in plural.
+	RulePlural = "plural"
+)
+
+// Violation is a violation of the API conventions by a type or field.
+type Violation struct {
+	Pos token.Position
+	// Field is the violating type or field, e.g. "BarSpec.Message".
+	Field   string
+	Rule    string
+	Message string
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s: %s: %s (%s)", v.Pos, v.Field, v.Message, v.Rule)
+}
+
+// Check parses the Go files in dir, skipping tests and generated files, and returns the
+// violations of all exported struct types ordered by position.
+func Check(dir string) ([]Violation, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	var violations []Violation
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || filepath.Ext(name) != ".go" || strings.HasSuffix(name, "_test.go") || strings.HasPrefix(name, "zz_generated") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		violations = append(violations, checkFile(fset, file)...)
+	}
+	slices.SortStableFunc(violations, func(a, b Violation) int {
+		if c := strings.Compare(a.Pos.Filename, b.Pos.Filename); c != 0 {
+			return c
+		}
+
+		return a.Pos.Offset - b.Pos.Offset
+	})
+
+	return violations, nil
+}
+
+// checkFile returns the violations of the exported struct types declared in file.
+func checkFile(fset *token.FileSet, file *ast.File) []Violation {
+	var violations []Violation
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok || !ts.Name.IsExported() {
+				continue
+			}
+			c := &checker{fset: fset, typeName: ts.Name.Name}
+			c.checkStruct(st)
+			violations = append(violations, c.violations...)
+		}
+	}
+
+	return violations
+}
+
+// checker collects the violations of a struct type.
+type checker struct {
+	fset       *token.FileSet
+	typeName   string
+	violations []Violation
+}
+
+func (c *checker) report(pos token.Pos, field, rule, format string, args ...any) {
+	c.violations = append(c.violations, Violation{
+		Pos:     c.fset.Position(pos),
+		Field:   field,
+		Rule:    rule,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (c *checker) checkStruct(st *ast.StructType) {
+	isList := strings.HasSuffix(c.typeName, "List")
+	hasItems := false
+	for _, f := range st.Fields.List {
+		// Embedded fields, e.g. metav1.TypeMeta, are checked with their own type.
+		if len(f.Names) == 0 {
+			continue
+		}
+		for _, ident := range f.Names {
+			if !ident.IsExported() {
+				continue
+			}
+			jsonName, jsonOpts, ok := jsonTag(f, ident.Name)
+			if !ok {
+				continue
+			}
+			field := c.typeName + "." + ident.Name
+			if isList && ident.Name == "Items" {
+				hasItems = true
+				c.checkItems(f, field, jsonName)
+
+				continue
+			}
+			c.checkField(f, field, jsonName, jsonOpts)
+		}
+	}
+	if isList && !hasItems {
+		c.report(st.Pos(), c.typeName, RulePlural, "list kind has no Items field")
+	}
+}
+
+// checkItems checks the items of a list kind, which are the objects of its kind in plural.
+func (c *checker) checkItems(f *ast.Field, field, jsonName string) {
+	elem, ok := sliceElem(f.Type)
+	if !ok {
+		c.report(f.Pos(), field, RulePlural, "items of a list kind must be a slice")
+
+		return
+	}
+	if kind := strings.TrimSuffix(c.typeName, "List"); typeName(elem) != kind {
+		c.report(f.Pos(), field, RulePlural, "items of %s must be %s objects", c.typeName, kind)
+	}
+	if jsonName != "items" {
+		c.report(f.Pos(), field, RulePlural, "items must be serialized as %q, not %q", "items", jsonName)
+	}
+}
+
+func (c *checker) checkField(f *ast.Field, field, jsonName string, jsonOpts []string) {
+	m := parseMarkers(f.Doc)
+	optional := m.has("optional") || m.has("k8s:optional") || m.has("kubebuilder:validation:Optional")
+	required := m.has("required") || m.has("k8s:required") || m.has("kubebuilder:validation:Required")
+	switch {
+	case !optional && !required:
+		c.report(f.Pos(), field, RuleMarkers, "field must be marked +optional or +required")
+	case optional && required:
+		c.report(f.Pos(), field, RuleMarkers, "field must not be marked both +optional and +required")
+	case optional && !slices.Contains(jsonOpts, "omitempty") && !slices.Contains(jsonOpts, "omitzero"):
+		c.report(f.Pos(), field, RuleOmitEmpty, "optional field must be tagged omitempty or omitzero")
+	}
+
+	elem, isSlice := sliceElem(f.Type)
+	if !isSlice || typeName(elem) == "byte" {
+		return
+	}
+	listType, _ := m.value("listType")
+	listMapKey, hasMapKey := m.value("listMapKey")
+	switch listType {
+	case "":
+		c.report(f.Pos(), field, RuleListType, "list field must be marked +listType=atomic, set or map")
+	case "atomic", "set":
+		if hasMapKey {
+			c.report(f.Pos(), field, RuleListType, "+listMapKey is only allowed with +listType=map")
+		}
+	case "map":
+		if !hasMapKey {
+			c.report(f.Pos(), field, RuleListType, "map list field must be marked +listMapKey")
+		}
+	default:
+		c.report(f.Pos(), field, RuleListType, "invalid +listType %q", listType)
+	}
+	if singular := lowerFirst(typeName(elem)); jsonName == singular {
+		c.report(f.Pos(), field, RulePlural, "list field %q must be named in plural", jsonName)
+	}
+	if jsonName == "conditions" {
+		sel, ok := elem.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Condition" {
+			c.report(f.Pos(), field, RuleConditions, "conditions must be metav1.Condition")
+		}
+		if listType != "map" || listMapKey != "type" {
+			c.report(f.Pos(), field, RuleConditions, "conditions must be marked +listType=map and +listMapKey=type")
+		}
+	}
+}
+
+// markers are the +key[=value] comment lines of a field.
+type markers map[string]string
+
+func parseMarkers(doc *ast.CommentGroup) markers {
+	m := markers{}
+	if doc == nil {
+		return m
+	}
+	for _, comment := range doc.List {
+		text := strings.TrimSpace(strings.TrimPrefix(comment.Text, "//"))
+		if !strings.HasPrefix(text, "+") {
+			continue
+		}
+		key, value, _ := strings.Cut(text[1:], "=")
+		m[key] = value
+	}
+
+	return m
+}
+
+func (m markers) has(key string) bool {
+	_, ok := m[key]
+
+	return ok
+}
+
+func (m markers) value(key string) (string, bool) {
+	v, ok := m[key]
+
+	return v, ok
+}
+
+// jsonTag returns the JSON name and options of a field. It returns false for fields which are
+// not serialized.
+func jsonTag(f *ast.Field, goName string) (string, []string, bool) {
+	tag := ""
+	if f.Tag != nil {
+		tag = reflect.StructTag(strings.Trim(f.Tag.Value, "`")).Get("json")
+	}
+	if tag == "-" {
+		return "", nil, false
+	}
+	name, opts, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = goName
+	}
+
+	return name, strings.Split(opts, ","), true
+}
+
+// sliceElem returns the element type of slice types.
+func sliceElem(expr ast.Expr) (ast.Expr, bool) {
+	arr, ok := expr.(*ast.ArrayType)
+	if !ok || arr.Len != nil {
+		return nil, false
+	}
+
+	return arr.Elt, true
+}
+
+// typeName returns the unqualified name of a, possibly pointer, named type.
+func typeName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return typeName(t.X)
+	case *ast.Ident:
+		return t.Name
+	case *ast.SelectorExpr:
+		return t.Sel.Name
+	}
+
+	return ""
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToLower(r[0])
+
+	return string(r)
+}
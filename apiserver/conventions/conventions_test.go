@@ -0,0 +1,51 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package conventions
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Check", func() {
+	It("should report violations of the conventions", func() {
+		violations, err := Check("testdata/violations")
+		Expect(err).NotTo(HaveOccurred())
+
+		found := []string{}
+		for _, v := range violations {
+			Expect(v.Pos.Filename).To(HaveSuffix("types.go"))
+			found = append(found, v.Field+" "+v.Rule+": "+v.Message)
+		}
+		Expect(found).To(Equal([]string{
+			"FooSpec.Unmarked markers: field must be marked +optional or +required",
+			"FooSpec.Both markers: field must not be marked both +optional and +required",
+			"FooSpec.NotOmitted omitempty: optional field must be tagged omitempty or omitzero",
+			`FooSpec.Port plural: list field "port" must be named in plural`,
+			"FooSpec.Tags listtype: list field must be marked +listType=atomic, set or map",
+			"FooSpec.Ports listtype: map list field must be marked +listMapKey",
+			"FooSpec.Names listtype: +listMapKey is only allowed with +listType=map",
+			"FooStatus.Conditions conditions: conditions must be metav1.Condition",
+			"FooStatus.Conditions conditions: conditions must be marked +listType=map and +listMapKey=type",
+			"FooList.Items plural: items of FooList must be Foo objects",
+			`FooList.Items plural: items must be serialized as "items", not "item"`,
+			"BarList plural: list kind has no Items field",
+		}))
+	})
+
+	It("should format violations like compilers", func() {
+		violations, err := Check("testdata/violations")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(violations[0].String()).To(MatchRegexp(`types\.go:\d+:2: FooSpec\.Unmarked: field must be marked \+optional or \+required \(markers\)$`))
+	})
+
+	It("should accept the example API", func() {
+		Expect(Check("../../example/api/foo/v1alpha1")).To(BeEmpty())
+	})
+
+	It("should fail on invalid sources", func() {
+		_, err := Check("testdata/missing")
+		Expect(err).To(MatchError(ContainSubstring("missing")))
+	})
+})
@@ -0,0 +1,71 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package violations
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type Foo struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec FooSpec `json:"spec,omitempty"`
+	// +optional
+	Status FooStatus `json:"status,omitempty"`
+}
+
+type FooSpec struct {
+	Unmarked string `json:"unmarked"`
+	// +optional
+	// +required
+	Both string `json:"both"`
+	// +optional
+	NotOmitted string `json:"notOmitted"`
+	// +optional
+	// +listType=atomic
+	Port []Port `json:"port,omitempty"`
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+	// +optional
+	// +listType=map
+	Ports []Port `json:"ports,omitempty"`
+	// +optional
+	// +listType=set
+	// +listMapKey=name
+	Names []string `json:"names,omitempty"`
+	// +required
+	Data []byte `json:"data"`
+	// +required
+	Ignored  string `json:"-"`
+	internal string
+}
+
+type Port struct {
+	// +required
+	Name string `json:"name"`
+}
+
+type FooStatus struct {
+	// +optional
+	// +listType=atomic
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+type Condition struct {
+	// +required
+	Type string `json:"type"`
+}
+
+type FooList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Port `json:"item"`
+}
+
+type BarList struct {
+	metav1.TypeMeta `json:",inline"`
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package crdconversion serves the conversion webhooks of CRDs with the conversions of a scheme,
+// so types served by an API server built with the kit and by CRDs share their conversion code.
+package crdconversion
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+)
+
+// NewHandler returns a handler serving apiextensions.k8s.io/v1 ConversionReviews with the
+// conversions registered in scheme, e.g. the scheme passed to the Builder. Objects are converted
+// through the internal version of their group if it is registered, like in the API server. The
+// handler is mounted into a webhook server referenced by the conversion strategy of the CRDs:
+//
+//	mgr.GetWebhookServer().Register("/convert", crdconversion.NewHandler(scheme))
+func NewHandler(scheme *runtime.Scheme) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+			return
+		}
+		review := &apiextensionsv1.ConversionReview{}
+		if err := json.NewDecoder(r.Body).Decode(review); err != nil {
+			http.Error(w, fmt.Sprintf("decoding ConversionReview: %v", err), http.StatusBadRequest)
+			return
+		}
+		if review.APIVersion != apiextensionsv1.SchemeGroupVersion.String() || review.Request == nil {
+			http.Error(w, fmt.Sprintf("expected a ConversionReview request of %s", apiextensionsv1.SchemeGroupVersion), http.StatusBadRequest)
+			return
+		}
+
+		response := &apiextensionsv1.ConversionResponse{UID: review.Request.UID, Result: metav1.Status{Status: metav1.StatusSuccess}}
+		converted, err := Convert(scheme, review.Request.Objects, review.Request.DesiredAPIVersion)
+		if err != nil {
+			response.Result = metav1.Status{Status: metav1.StatusFailure, Message: err.Error()}
+		} else {
+			response.ConvertedObjects = converted
+		}
+		review.Request, review.Response = nil, response
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(review); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
+
+// Convert converts the objects to the desired API version with the conversions registered in
+// scheme. It fails if any object cannot be converted.
+func Convert(scheme *runtime.Scheme, objects []runtime.RawExtension, desiredAPIVersion string) ([]runtime.RawExtension, error) {
+	gv, err := schema.ParseGroupVersion(desiredAPIVersion)
+	if err != nil {
+		return nil, err
+	}
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+	converted := make([]runtime.RawExtension, 0, len(objects))
+	for i, raw := range objects {
+		obj, gvk, err := decoder.Decode(raw.Raw, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("decoding object %d: %w", i, err)
+		}
+		out, err := convert(scheme, obj, gv)
+		if err != nil {
+			return nil, fmt.Errorf("converting %s to %s: %w", gvk, gv, err)
+		}
+		data, err := json.Marshal(out)
+		if err != nil {
+			return nil, err
+		}
+		converted = append(converted, runtime.RawExtension{Raw: data})
+	}
+
+	return converted, nil
+}
+
+// convert converts obj to gv through the internal version of its group, if it is registered.
+func convert(scheme *runtime.Scheme, obj runtime.Object, gv schema.GroupVersion) (runtime.Object, error) {
+	internal := schema.GroupVersion{Group: gv.Group, Version: runtime.APIVersionInternal}
+	if len(scheme.KnownTypes(internal)) > 0 {
+		var err error
+		if obj, err = scheme.ConvertToVersion(obj, internal); err != nil {
+			return nil, err
+		}
+	}
+
+	return scheme.ConvertToVersion(obj, gv)
+}
@@ -0,0 +1,149 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package crdconversion
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var (
+	v1 = schema.GroupVersion{Group: "test.opendefense.cloud", Version: "v1"}
+	v2 = schema.GroupVersion{Group: "test.opendefense.cloud", Version: "v2"}
+)
+
+// widgetV1 stores the size of a widget as a quantity with unit, e.g. "3Gi".
+type widgetV1 struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Size              string `json:"size"`
+}
+
+func (w *widgetV1) DeepCopyObject() runtime.Object {
+	out := *w
+	w.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+
+	return &out
+}
+
+// widgetV2 stores the size of a widget in Gi.
+type widgetV2 struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	SizeGi            int `json:"sizeGi"`
+}
+
+func (w *widgetV2) DeepCopyObject() runtime.Object {
+	out := *w
+	w.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+
+	return &out
+}
+
+func newScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypeWithName(v1.WithKind("Widget"), &widgetV1{})
+	scheme.AddKnownTypeWithName(v2.WithKind("Widget"), &widgetV2{})
+	Expect(scheme.AddConversionFunc(&widgetV1{}, &widgetV2{}, func(a, b any, _ conversion.Scope) error {
+		in, out := a.(*widgetV1), b.(*widgetV2)
+		size, err := strconv.Atoi(strings.TrimSuffix(in.Size, "Gi"))
+		if err != nil {
+			return fmt.Errorf("invalid size %q", in.Size)
+		}
+		out.ObjectMeta, out.SizeGi = in.ObjectMeta, size
+
+		return nil
+	})).To(Succeed())
+	Expect(scheme.AddConversionFunc(&widgetV2{}, &widgetV1{}, func(a, b any, _ conversion.Scope) error {
+		in, out := a.(*widgetV2), b.(*widgetV1)
+		out.ObjectMeta, out.Size = in.ObjectMeta, strconv.Itoa(in.SizeGi)+"Gi"
+
+		return nil
+	})).To(Succeed())
+
+	return scheme
+}
+
+var _ = Describe("NewHandler", func() {
+	var handler http.Handler
+
+	BeforeEach(func() {
+		handler = NewHandler(newScheme())
+	})
+
+	review := func(desiredAPIVersion string, objects ...string) (*http.Response, *apiextensionsv1.ConversionReview) {
+		GinkgoHelper()
+		in := &apiextensionsv1.ConversionReview{
+			TypeMeta: metav1.TypeMeta{APIVersion: "apiextensions.k8s.io/v1", Kind: "ConversionReview"},
+			Request:  &apiextensionsv1.ConversionRequest{UID: "uid", DesiredAPIVersion: desiredAPIVersion},
+		}
+		for _, obj := range objects {
+			in.Request.Objects = append(in.Request.Objects, runtime.RawExtension{Raw: []byte(obj)})
+		}
+		body, err := json.Marshal(in)
+		Expect(err).NotTo(HaveOccurred())
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/convert", bytes.NewReader(body)))
+		out := &apiextensionsv1.ConversionReview{}
+		if rec.Code == http.StatusOK {
+			Expect(json.Unmarshal(rec.Body.Bytes(), out)).To(Succeed())
+		}
+
+		return rec.Result(), out
+	}
+
+	It("should convert objects with the conversions of the scheme", func() {
+		resp, out := review("test.opendefense.cloud/v2",
+			`{"apiVersion":"test.opendefense.cloud/v1","kind":"Widget","metadata":{"name":"a"},"size":"3Gi"}`,
+			`{"apiVersion":"test.opendefense.cloud/v2","kind":"Widget","metadata":{"name":"b"},"sizeGi":5}`)
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(out.Request).To(BeNil())
+		Expect(out.Response.UID).To(BeEquivalentTo("uid"))
+		Expect(out.Response.Result.Status).To(Equal(metav1.StatusSuccess))
+		Expect(out.Response.ConvertedObjects).To(HaveLen(2))
+		Expect(out.Response.ConvertedObjects[0].Raw).To(MatchJSON(
+			`{"apiVersion":"test.opendefense.cloud/v2","kind":"Widget","metadata":{"name":"a"},"sizeGi":3}`))
+		Expect(out.Response.ConvertedObjects[1].Raw).To(MatchJSON(
+			`{"apiVersion":"test.opendefense.cloud/v2","kind":"Widget","metadata":{"name":"b"},"sizeGi":5}`))
+	})
+
+	It("should report failed conversions in the result", func() {
+		resp, out := review("test.opendefense.cloud/v2",
+			`{"apiVersion":"test.opendefense.cloud/v1","kind":"Widget","metadata":{"name":"a"},"size":"large"}`)
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(out.Response.Result.Status).To(Equal(metav1.StatusFailure))
+		Expect(out.Response.Result.Message).To(ContainSubstring(`invalid size "large"`))
+		Expect(out.Response.ConvertedObjects).To(BeEmpty())
+	})
+
+	It("should report unknown kinds in the result", func() {
+		_, out := review("test.opendefense.cloud/v2", `{"apiVersion":"test.opendefense.cloud/v1","kind":"Gadget"}`)
+		Expect(out.Response.Result.Status).To(Equal(metav1.StatusFailure))
+		Expect(out.Response.Result.Message).To(ContainSubstring("decoding object 0"))
+	})
+
+	It("should reject invalid reviews", func() {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/convert", strings.NewReader(`{"apiVersion":"apiextensions.k8s.io/v1beta1"}`)))
+		Expect(rec.Code).To(Equal(http.StatusBadRequest))
+
+		rec = httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/convert", nil))
+		Expect(rec.Code).To(Equal(http.StatusMethodNotAllowed))
+	})
+})
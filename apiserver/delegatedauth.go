@@ -0,0 +1,189 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+// errCircuitOpen is returned for reviews which are not sent, as the circuit breaker is open.
+var errCircuitOpen = errors.New("delegated authentication and authorization are unavailable, the circuit breaker is open")
+
+// DelegatedAuth configures the TokenReviews and SubjectAccessReviews sent to the kube-apiserver
+// for delegated authentication and authorization. Zero values keep the defaults of the server.
+type DelegatedAuth struct {
+	// Timeout is the timeout of a single review.
+	Timeout time.Duration
+	// Retry is the backoff of retried reviews which failed, e.g. because the kube-apiserver
+	// is unavailable.
+	Retry *wait.Backoff
+	// TokenCacheTTL is the duration authenticated tokens are cached.
+	TokenCacheTTL time.Duration
+	// AllowCacheTTL and DenyCacheTTL are the durations allowed and denied authorization
+	// decisions are cached.
+	AllowCacheTTL time.Duration
+	DenyCacheTTL  time.Duration
+	// CircuitBreaker stops sending reviews to a failing kube-apiserver for a while, so requests
+	// fail fast instead of piling up until their reviews time out.
+	CircuitBreaker *CircuitBreaker
+}
+
+// CircuitBreaker opens after consecutive failed reviews. While it is open reviews fail without
+// being sent. Once the cooldown elapsed reviews are sent again, and the next failure opens it again.
+type CircuitBreaker struct {
+	// Failures is the number of consecutive failed reviews opening the circuit breaker.
+	// Requests failing with 429 or 5xx are failed reviews.
+	Failures int
+	// Cooldown is the duration the circuit breaker stays open.
+	Cooldown time.Duration
+}
+
+// WithDelegatedAuth configures the timeouts, retries and caches of delegated authentication and
+// authorization, and optionally a circuit breaker, so an unavailable kube-apiserver does not make
+// requests to the server pile up. The durations are the defaults of the corresponding flags, e.g.
+// --authorization-webhook-cache-authorized-ttl, which take precedence. It has no effect in
+// standalone mode.
+func (b *Builder) WithDelegatedAuth(d DelegatedAuth) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if d.Retry != nil {
+		retry := *d.Retry
+		d.Retry = &retry
+	}
+	if d.CircuitBreaker != nil {
+		cb := *d.CircuitBreaker
+		d.CircuitBreaker = &cb
+	}
+	b.delegatedAuth = &d
+
+	return b
+}
+
+// validate returns an error if durations are negative or the circuit breaker is invalid.
+func (d *DelegatedAuth) validate() error {
+	errs := []error{}
+	for name, duration := range map[string]time.Duration{
+		"timeout": d.Timeout, "token cache TTL": d.TokenCacheTTL, "allow cache TTL": d.AllowCacheTTL, "deny cache TTL": d.DenyCacheTTL,
+	} {
+		if duration < 0 {
+			errs = append(errs, fmt.Errorf("%s must not be negative", name))
+		}
+	}
+	if d.Retry != nil && d.Retry.Steps < 1 {
+		errs = append(errs, errors.New("retry steps must be at least 1"))
+	}
+	if cb := d.CircuitBreaker; cb != nil && (cb.Failures < 1 || cb.Cooldown <= 0) {
+		errs = append(errs, errors.New("circuit breaker requires at least 1 failure and a positive cooldown"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// applyDelegatedAuth sets the delegated authentication and authorization options.
+func (c *completedConfig) applyDelegatedAuth() {
+	d := c.delegatedAuth
+	if d == nil {
+		return
+	}
+	var wrap func(http.RoundTripper) http.RoundTripper
+	if d.CircuitBreaker != nil {
+		wrap = newCircuitBreaker(*d.CircuitBreaker).wrap
+	}
+	if authn := c.recommendedOptions.Authentication; authn != nil {
+		if d.Timeout > 0 {
+			authn.TokenRequestTimeout = d.Timeout
+		}
+		if d.Retry != nil {
+			retry := *d.Retry
+			authn.WebhookRetryBackoff = &retry
+		}
+		if d.TokenCacheTTL > 0 {
+			authn.CacheTTL = d.TokenCacheTTL
+		}
+		if wrap != nil {
+			authn.CustomRoundTripperFn = wrap
+		}
+	}
+	if authz := c.recommendedOptions.Authorization; authz != nil {
+		if d.Timeout > 0 {
+			authz.ClientTimeout = d.Timeout
+		}
+		if d.Retry != nil {
+			retry := *d.Retry
+			authz.WebhookRetryBackoff = &retry
+		}
+		if d.AllowCacheTTL > 0 {
+			authz.AllowCacheTTL = d.AllowCacheTTL
+		}
+		if d.DenyCacheTTL > 0 {
+			authz.DenyCacheTTL = d.DenyCacheTTL
+		}
+		if wrap != nil {
+			authz.CustomRoundTripperFn = wrap
+		}
+	}
+}
+
+// circuitBreaker counts the consecutive failed reviews sent by the wrapped round trippers.
+// Authentication and authorization share it, as both are served by the kube-apiserver.
+type circuitBreaker struct {
+	CircuitBreaker
+	now func() time.Time
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+}
+
+func newCircuitBreaker(cb CircuitBreaker) *circuitBreaker {
+	return &circuitBreaker{CircuitBreaker: cb, now: time.Now}
+}
+
+func (b *circuitBreaker) wrap(rt http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if !b.allow() {
+			return nil, errCircuitOpen
+		}
+		resp, err := rt.RoundTrip(req)
+		b.record(err != nil || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError)
+
+		return resp, err
+	})
+}
+
+// allow returns false while the circuit breaker is open.
+func (b *circuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return !b.now().Before(b.openUntil)
+}
+
+// record counts a failed review or resets the count after a successful one, and opens the
+// circuit breaker once the failures reach the threshold.
+func (b *circuitBreaker) record(failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !failed {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.Failures {
+		b.openUntil = b.now().Add(b.Cooldown)
+		klog.Background().Info("Opened circuit breaker of delegated authentication and authorization",
+			"failures", b.failures, "cooldown", b.Cooldown)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
@@ -0,0 +1,104 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	basecompatibility "k8s.io/component-base/compatibility"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Delegated authentication", func() {
+	It("should set the defaults of the delegated authentication and authorization options", func() {
+		retry := wait.Backoff{Duration: 100 * time.Millisecond, Factor: 2, Steps: 3}
+		b := NewBuilder(runtime.NewScheme()).
+			WithComponentName("test").
+			WithGroupVersions(schema.GroupVersion{Group: "test.opendefense.cloud", Version: "v1"}).
+			WithDelegatedAuth(DelegatedAuth{
+				Timeout:        2 * time.Second,
+				Retry:          &retry,
+				TokenCacheTTL:  time.Minute,
+				AllowCacheTTL:  30 * time.Second,
+				DenyCacheTTL:   5 * time.Second,
+				CircuitBreaker: &CircuitBreaker{Failures: 3, Cooldown: 10 * time.Second},
+			})
+		b.componentGlobalsRegistry = basecompatibility.NewComponentGlobalsRegistry()
+		retry.Steps = 10
+		c, err := b.complete()
+		Expect(err).NotTo(HaveOccurred())
+
+		authn := c.recommendedOptions.Authentication
+		Expect(authn.TokenRequestTimeout).To(Equal(2 * time.Second))
+		Expect(authn.CacheTTL).To(Equal(time.Minute))
+		Expect(authn.WebhookRetryBackoff.Steps).To(Equal(3))
+		Expect(authn.CustomRoundTripperFn).NotTo(BeNil())
+		authz := c.recommendedOptions.Authorization
+		Expect(authz.ClientTimeout).To(Equal(2 * time.Second))
+		Expect(authz.AllowCacheTTL).To(Equal(30 * time.Second))
+		Expect(authz.DenyCacheTTL).To(Equal(5 * time.Second))
+		Expect(authz.WebhookRetryBackoff.Steps).To(Equal(3))
+		Expect(authz.CustomRoundTripperFn).NotTo(BeNil())
+	})
+
+	It("should reject invalid configurations", func() {
+		b := NewBuilder(runtime.NewScheme()).WithDelegatedAuth(DelegatedAuth{
+			Timeout:        -time.Second,
+			Retry:          &wait.Backoff{},
+			CircuitBreaker: &CircuitBreaker{},
+		})
+		b.componentGlobalsRegistry = basecompatibility.NewComponentGlobalsRegistry()
+		_, err := b.complete()
+		Expect(err).To(MatchError(ContainSubstring("invalid delegated authentication")))
+		Expect(err).To(MatchError(ContainSubstring("timeout must not be negative")))
+		Expect(err).To(MatchError(ContainSubstring("retry steps must be at least 1")))
+		Expect(err).To(MatchError(ContainSubstring("circuit breaker requires")))
+	})
+
+	It("should fail reviews fast while the circuit breaker is open", func() {
+		now := time.Now()
+		cb := newCircuitBreaker(CircuitBreaker{Failures: 2, Cooldown: time.Minute})
+		cb.now = func() time.Time { return now }
+		sent := 0
+		status := http.StatusServiceUnavailable
+		rt := cb.wrap(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+			sent++
+
+			return &http.Response{StatusCode: status}, nil
+		}))
+		review := func() error {
+			_, err := rt.RoundTrip(&http.Request{})
+
+			return err
+		}
+
+		Expect(review()).To(Succeed())
+		Expect(review()).To(Succeed())
+		Expect(sent).To(Equal(2))
+		Expect(errors.Is(review(), errCircuitOpen)).To(BeTrue())
+		Expect(sent).To(Equal(2))
+
+		By("sending reviews again after the cooldown, and opening again on the next failure")
+		now = now.Add(time.Minute)
+		Expect(review()).To(Succeed())
+		Expect(sent).To(Equal(3))
+		Expect(errors.Is(review(), errCircuitOpen)).To(BeTrue())
+
+		By("closing after a successful review")
+		now = now.Add(time.Minute)
+		status = http.StatusCreated
+		Expect(review()).To(Succeed())
+		status = http.StatusServiceUnavailable
+		Expect(review()).To(Succeed())
+		Expect(review()).To(Succeed())
+		Expect(sent).To(Equal(6))
+	})
+})
@@ -0,0 +1,304 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package diff computes structural diffs between API objects, e.g. to explain
+// why an update was rejected or to record what changed in audit annotations.
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Op is the kind of a change.
+type Op string
+
+const (
+	OpAdd     Op = "add"
+	OpRemove  Op = "remove"
+	OpReplace Op = "replace"
+)
+
+// DefaultIgnoredFields are fields maintained by the server, which are never reported.
+var DefaultIgnoredFields = []string{
+	"metadata.resourceVersion",
+	"metadata.generation",
+	"metadata.managedFields",
+}
+
+// Change is a single difference between two objects.
+type Change struct {
+	// Op is the kind of the change.
+	Op Op `json:"op"`
+	// Path is the field path of the change, e.g. spec.items[0].name.
+	Path string `json:"path"`
+	// Old is the previous value; it is unset for additions.
+	Old any `json:"old,omitempty"`
+	// New is the updated value; it is unset for removals.
+	New any `json:"new,omitempty"`
+
+	field *field.Path
+}
+
+// String returns a human-readable representation of the change.
+func (c Change) String() string {
+	switch c.Op {
+	case OpAdd:
+		return fmt.Sprintf("%s: added %s", c.Path, formatValue(c.New))
+	case OpRemove:
+		return fmt.Sprintf("%s: removed %s", c.Path, formatValue(c.Old))
+	default:
+		return fmt.Sprintf("%s: %s -> %s", c.Path, formatValue(c.Old), formatValue(c.New))
+	}
+}
+
+// Diff is the list of changes between two objects, ordered by path.
+type Diff []Change
+
+// Empty returns true if there are no changes.
+func (d Diff) Empty() bool {
+	return len(d) == 0
+}
+
+// Paths returns the paths of all changes.
+func (d Diff) Paths() []string {
+	paths := make([]string, 0, len(d))
+	for _, c := range d {
+		paths = append(paths, c.Path)
+	}
+
+	return paths
+}
+
+// String returns a human-readable representation with one change per line.
+func (d Diff) String() string {
+	lines := make([]string, 0, len(d))
+	for _, c := range d {
+		lines = append(lines, c.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Touches returns true if a change is at path, at one of its children or at one of its parents,
+// i.e. if the value at path changed. Keys in path, e.g. spec.items[name] as built by
+// field.Path.Key, are not compared: any change of the map or list touches them.
+func (d Diff) Touches(path string) bool {
+	path = trimKeys(path)
+	if path == "" {
+		return !d.Empty()
+	}
+
+	return slices.ContainsFunc(d, func(c Change) bool {
+		return under(c.Path, path) || under(path, c.Path)
+	})
+}
+
+// JSON returns the machine-readable representation of the diff, suitable for audit annotations.
+func (d Diff) JSON() string {
+	data, err := json.Marshal(d)
+	if err != nil {
+		// Values originate from unstructured content and are always serializable.
+		return ""
+	}
+
+	return string(data)
+}
+
+// Forbidden returns a Forbidden error for every change, e.g. to reject updates of immutable fields:
+//
+//	d, err := diff.Objects(old, obj, diff.WithAllowedFields("spec.storageClass"))
+//	...
+//	return d.Forbidden("field is immutable")
+func (d Diff) Forbidden(msg string) field.ErrorList {
+	errs := field.ErrorList{}
+	for _, c := range d {
+		detail := fmt.Sprintf("%s, %s", msg, strings.TrimPrefix(c.String(), c.Path+": "))
+		errs = append(errs, field.Forbidden(c.field, detail))
+	}
+
+	return errs
+}
+
+// Option configures the computation of a diff.
+type Option func(*options)
+
+type options struct {
+	allowed []string
+	ignored []string
+}
+
+// WithAllowedFields limits the diff to the given field paths and their children.
+func WithAllowedFields(paths ...string) Option {
+	return func(o *options) {
+		o.allowed = append(o.allowed, paths...)
+	}
+}
+
+// WithIgnoredFields excludes the given field paths and their children in
+// addition to DefaultIgnoredFields.
+func WithIgnoredFields(paths ...string) Option {
+	return func(o *options) {
+		o.ignored = append(o.ignored, paths...)
+	}
+}
+
+// Objects computes the diff between oldObj and newObj. Both must be pointers to
+// structs or unstructured content, typically of the same type.
+func Objects(oldObj, newObj any, opts ...Option) (Diff, error) {
+	oldContent, err := toUnstructured(oldObj)
+	if err != nil {
+		return nil, fmt.Errorf("converting old object: %w", err)
+	}
+	newContent, err := toUnstructured(newObj)
+	if err != nil {
+		return nil, fmt.Errorf("converting new object: %w", err)
+	}
+	o := &options{ignored: slices.Clone(DefaultIgnoredFields)}
+	for _, opt := range opts {
+		opt(o)
+	}
+	d := &differ{options: o, diff: Diff{}}
+	d.walk(nil, oldContent, newContent, true, true)
+
+	return d.diff, nil
+}
+
+func toUnstructured(obj any) (map[string]any, error) {
+	if u, ok := obj.(runtime.Unstructured); ok {
+		return u.UnstructuredContent(), nil
+	}
+
+	return runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+}
+
+type differ struct {
+	*options
+	diff Diff
+}
+
+// walk compares two values at path. A missing value on either side is
+// indicated by oldOK or newOK, so maps are reported field by field.
+func (d *differ) walk(path *field.Path, oldV, newV any, oldOK, newOK bool) {
+	if path != nil && !d.relevant(path.String()) {
+		return
+	}
+
+	oldMap, oldIsMap := oldV.(map[string]any)
+	newMap, newIsMap := newV.(map[string]any)
+	if (oldIsMap || !oldOK) && (newIsMap || !newOK) && (oldIsMap || newIsMap) {
+		keys := sets.KeySet(oldMap).Union(sets.KeySet(newMap))
+		for _, k := range sets.List(keys) {
+			o, ok1 := oldMap[k]
+			n, ok2 := newMap[k]
+			d.walk(child(path, k), o, n, ok1, ok2)
+		}
+
+		return
+	}
+
+	oldList, oldIsList := oldV.([]any)
+	newList, newIsList := newV.([]any)
+	if oldIsList && newIsList {
+		for i := range max(len(oldList), len(newList)) {
+			var o, n any
+			if i < len(oldList) {
+				o = oldList[i]
+			}
+			if i < len(newList) {
+				n = newList[i]
+			}
+			d.walk(path.Index(i), o, n, i < len(oldList), i < len(newList))
+		}
+
+		return
+	}
+
+	switch {
+	case !oldOK:
+		d.add(Change{Op: OpAdd, New: newV}, path)
+	case !newOK:
+		d.add(Change{Op: OpRemove, Old: oldV}, path)
+	case !reflect.DeepEqual(oldV, newV):
+		d.add(Change{Op: OpReplace, Old: oldV, New: newV}, path)
+	}
+}
+
+func (d *differ) add(c Change, path *field.Path) {
+	c.field = path
+	c.Path = path.String()
+	if !d.included(c.Path) {
+		return
+	}
+	d.diff = append(d.diff, c)
+}
+
+// relevant returns true if path or any of its children may be reported.
+func (d *differ) relevant(path string) bool {
+	if slices.ContainsFunc(d.ignored, func(p string) bool { return under(path, p) }) {
+		return false
+	}
+
+	return len(d.allowed) == 0 || slices.ContainsFunc(d.allowed, func(p string) bool {
+		return under(path, p) || under(p, path)
+	})
+}
+
+// included returns true if a change at path is reported.
+func (d *differ) included(path string) bool {
+	return len(d.allowed) == 0 || slices.ContainsFunc(d.allowed, func(p string) bool { return under(path, p) })
+}
+
+// under returns true if path equals prefix or is one of its children.
+func under(path, prefix string) bool {
+	if !strings.HasPrefix(path, prefix) {
+		return false
+	}
+	rest := path[len(prefix):]
+
+	return rest == "" || rest[0] == '.' || rest[0] == '['
+}
+
+// trimKeys cuts path before its first key, i.e. its first subscript which is not an index.
+func trimKeys(path string) string {
+	for i := 0; i < len(path); i++ {
+		if path[i] != '[' {
+			continue
+		}
+		end := strings.IndexByte(path[i:], ']')
+		if end < 0 {
+			return path[:i]
+		}
+		if _, err := strconv.Atoi(path[i+1 : i+end]); err != nil {
+			return path[:i]
+		}
+		i += end
+	}
+
+	return path
+}
+
+func child(path *field.Path, name string) *field.Path {
+	if path == nil {
+		return field.NewPath(name)
+	}
+
+	return path.Child(name)
+}
+
+func formatValue(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type testSpec struct {
+	StorageClass string   `json:"storageClass,omitempty"`
+	Size         int64    `json:"size,omitempty"`
+	Tags         []string `json:"tags,omitempty"`
+}
+
+type testObject struct {
+	Metadata map[string]any `json:"metadata,omitempty"`
+	Spec     testSpec       `json:"spec"`
+}
+
+var _ = Describe("Objects", func() {
+	var oldObj, newObj *testObject
+
+	BeforeEach(func() {
+		oldObj = &testObject{
+			Metadata: map[string]any{"name": "foo", "resourceVersion": "1"},
+			Spec:     testSpec{StorageClass: "fast", Size: 1, Tags: []string{"a", "b"}},
+		}
+		newObj = &testObject{
+			Metadata: map[string]any{"name": "foo", "resourceVersion": "2"},
+			Spec:     testSpec{StorageClass: "slow", Size: 1, Tags: []string{"a"}},
+		}
+	})
+
+	It("should report changes ordered by path", func() {
+		d, err := Objects(oldObj, newObj)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(d.Paths()).To(Equal([]string{"spec.storageClass", "spec.tags[1]"}))
+		Expect(d[0].Op).To(Equal(OpReplace))
+		Expect(d[1].Op).To(Equal(OpRemove))
+		Expect(d.String()).To(Equal("spec.storageClass: \"fast\" -> \"slow\"\nspec.tags[1]: removed \"b\""))
+	})
+
+	It("should report added fields", func() {
+		oldObj.Spec = testSpec{}
+		d, err := Objects(oldObj, newObj, WithAllowedFields("spec"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(d.Paths()).To(Equal([]string{"spec.size", "spec.storageClass", "spec.tags"}))
+		Expect(d[1].Op).To(Equal(OpAdd))
+	})
+
+	It("should return an empty diff for equal objects", func() {
+		d, err := Objects(oldObj, oldObj)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(d.Empty()).To(BeTrue())
+	})
+
+	It("should respect allowed and ignored fields", func() {
+		d, err := Objects(oldObj, newObj, WithAllowedFields("spec.tags"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(d.Paths()).To(Equal([]string{"spec.tags[1]"}))
+
+		d, err = Objects(oldObj, newObj, WithIgnoredFields("spec.tags"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(d.Paths()).To(Equal([]string{"spec.storageClass"}))
+
+		d, err = Objects(oldObj, newObj, WithAllowedFields("spec.tag"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(d.Empty()).To(BeTrue())
+	})
+
+	It("should support unstructured objects", func() {
+		oldU := &unstructured.Unstructured{Object: map[string]any{"spec": map[string]any{"a": "1"}}}
+		newU := &unstructured.Unstructured{Object: map[string]any{"spec": map[string]any{"a": "2"}}}
+		d, err := Objects(oldU, newU)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(d.Paths()).To(Equal([]string{"spec.a"}))
+	})
+
+	It("should render machine-readable json", func() {
+		d, err := Objects(oldObj, newObj, WithAllowedFields("spec.storageClass"))
+		Expect(err).NotTo(HaveOccurred())
+		var changes []map[string]any
+		Expect(json.Unmarshal([]byte(d.JSON()), &changes)).To(Succeed())
+		Expect(changes).To(ConsistOf(map[string]any{"op": "replace", "path": "spec.storageClass", "old": "fast", "new": "slow"}))
+	})
+
+	It("should report whether a path has been touched", func() {
+		d, err := Objects(oldObj, newObj)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(d.Touches("spec.storageClass")).To(BeTrue())
+		Expect(d.Touches("spec")).To(BeTrue())
+		Expect(d.Touches("spec.storageClass.name")).To(BeTrue())
+		Expect(d.Touches("spec.tags[1]")).To(BeTrue())
+		Expect(d.Touches("spec.tags[0]")).To(BeFalse())
+		Expect(d.Touches("spec.tags[b]")).To(BeTrue())
+		Expect(d.Touches("spec.size")).To(BeFalse())
+		Expect(d.Touches("spec.storage")).To(BeFalse())
+		Expect(Diff{}.Touches("[key]")).To(BeFalse())
+	})
+
+	It("should produce forbidden errors for immutable fields", func() {
+		d, err := Objects(oldObj, newObj, WithAllowedFields("spec.storageClass"))
+		Expect(err).NotTo(HaveOccurred())
+		errs := d.Forbidden("field is immutable")
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Type).To(Equal(field.ErrorTypeForbidden))
+		Expect(errs[0].Field).To(Equal("spec.storageClass"))
+		Expect(errs[0].Detail).To(Equal(`field is immutable, "fast" -> "slow"`))
+	})
+})
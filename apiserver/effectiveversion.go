@@ -0,0 +1,97 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/version"
+	basecompatibility "k8s.io/component-base/compatibility"
+	baseversion "k8s.io/component-base/version"
+)
+
+// defaultBinaryVersion is the binary version of components without WithEffectiveVersion.
+const defaultBinaryVersion = "1.2"
+
+// WithEffectiveVersion sets the binary version of the component, e.g. "1.4", and the emulation
+// and minimum compatibility versions it defaults to, which operators change with
+// --emulated-version=<component>=<version>. An empty emulation version defaults to the binary
+// version, an empty minimum compatibility version to the minor version before the emulation
+// version. Without it, the binary version is 1.2. The versions gate feature gates and group
+// version lifecycles and are reported by the self description.
+func (b *Builder) WithEffectiveVersion(binary, emulation, minCompatibility string) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.binaryVersion = binary
+	b.emulationVersion = emulation
+	b.minCompatibilityVersion = minCompatibility
+
+	return b
+}
+
+// WithKubeVersionMapping sets the Kubernetes version emulated by the generic API server for an
+// emulation version of the component, which determines the Kubernetes features the server
+// behaves like. fn returns nil for versions which have no Kubernetes version. Without it, the
+// binary version of the component maps to the Kubernetes version the kit is built with and
+// every earlier minor version to the Kubernetes minor version before.
+func (b *Builder) WithKubeVersionMapping(fn func(*version.Version) *version.Version) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.kubeVersionMapping = fn
+
+	return b
+}
+
+// effectiveVersion returns the effective version of the component set by WithEffectiveVersion.
+func (c *completedConfig) effectiveVersion() (basecompatibility.MutableEffectiveVersion, error) {
+	binary := c.binaryVersion
+	if binary == "" {
+		binary = defaultBinaryVersion
+	}
+	binaryVersion, err := version.Parse(binary)
+	if err != nil {
+		return nil, fmt.Errorf("invalid binary version: %w", err)
+	}
+	v := basecompatibility.NewEffectiveVersion(binaryVersion, false, version.MajorMinor(0, 0), version.MajorMinor(0, 0))
+	if c.emulationVersion != "" {
+		emulationVersion, err := version.Parse(c.emulationVersion)
+		if err != nil {
+			return nil, fmt.Errorf("invalid emulation version: %w", err)
+		}
+		v.SetEmulationVersion(emulationVersion)
+		v.SetMinCompatibilityVersion(emulationVersion.SubtractMinor(1))
+	}
+	if c.minCompatibilityVersion != "" {
+		minCompatibilityVersion, err := version.Parse(c.minCompatibilityVersion)
+		if err != nil {
+			return nil, fmt.Errorf("invalid minimum compatibility version: %w", err)
+		}
+		v.SetMinCompatibilityVersion(minCompatibilityVersion)
+	}
+	if errs := v.Validate(); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid effective version: %v", errs)
+	}
+
+	return v, nil
+}
+
+// defaultKubeVersionMapping maps binary, the binary version of the component, to the Kubernetes
+// version the kit is built with and earlier minor versions to the Kubernetes minor versions
+// before.
+func defaultKubeVersionMapping(binary *version.Version) func(*version.Version) *version.Version {
+	return func(ver *version.Version) *version.Version {
+		if ver.Major() != binary.Major() {
+			return nil
+		}
+		kubeVer := version.MustParse(baseversion.DefaultKubeBinaryVersion)
+		// nolint:gosec
+		offset := int(ver.Minor()) - int(binary.Minor())
+		mappedVer := kubeVer.OffsetMinor(offset)
+		if mappedVer.GreaterThan(kubeVer) {
+			return kubeVer
+		}
+
+		return mappedVer
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/apiserver/pkg/server/healthz"
+)
+
+// StartupzPath is the path of the startup probe. It reports healthy once all startup checks
+// passed and all post-start hooks completed, and stays healthy afterwards.
+const StartupzPath = "/startupz"
+
+// WithLivezChecks adds checks to /livez only. Failing liveness checks make the kubelet restart
+// the server, so they should only fail if restarting helps, e.g. on deadlocks.
+func (b *Builder) WithLivezChecks(checks ...healthz.HealthChecker) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.livezChecks = append(b.livezChecks, checks...)
+
+	return b
+}
+
+// WithReadyzChecks adds checks to /readyz only, which take the server out of load balancing
+// while they fail.
+func (b *Builder) WithReadyzChecks(checks ...healthz.HealthChecker) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.readyzChecks = append(b.readyzChecks, checks...)
+
+	return b
+}
+
+// WithStartupChecks adds one-time gates, e.g. a completed storage migration, to /startupz and
+// /readyz. Once a check passed, it is not run again. The gates are not part of /livez, so a
+// kubelet probing /startupz does not restart servers doing long migrations:
+//
+//	startupProbe:
+//	  httpGet: {path: /startupz, port: 443, scheme: HTTPS}
+//	  failureThreshold: 60
+func (b *Builder) WithStartupChecks(checks ...healthz.HealthChecker) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.startupChecks = append(b.startupChecks, checks...)
+
+	return b
+}
+
+// WithoutLivezChecks removes the named checks of the server configuration from /livez, e.g.
+// "etcd", so an unavailable etcd makes the server unready but does not restart it. The checks
+// are still part of /readyz and /healthz.
+func (b *Builder) WithoutLivezChecks(names ...string) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.livezExcludes = append(b.livezExcludes, names...)
+
+	return b
+}
+
+// WithLivezGracePeriod sets the time after the start of the server in which /livez reports
+// unfinished post-start hooks as healthy.
+func (b *Builder) WithLivezGracePeriod(d time.Duration) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.livezGracePeriod = d
+
+	return b
+}
+
+// applyHealthChecks adds the checks of the Builder to rc and removes the excluded ones from /livez.
+func (c *completedConfig) applyHealthChecks(rc *genericapiserver.Config) error {
+	if c.livezGracePeriod > 0 {
+		rc.LivezGracePeriod = c.livezGracePeriod
+	}
+	for _, name := range c.livezExcludes {
+		if !slices.ContainsFunc(rc.LivezChecks, func(check healthz.HealthChecker) bool { return check.Name() == name }) {
+			return fmt.Errorf("livez check %q does not exist", name)
+		}
+	}
+	rc.LivezChecks = slices.DeleteFunc(rc.LivezChecks, func(check healthz.HealthChecker) bool {
+		return slices.Contains(c.livezExcludes, check.Name())
+	})
+	rc.AddLivezChecks(c.livezChecks...)
+	rc.AddReadyzChecks(c.readyzChecks...)
+	rc.AddReadyzChecks(c.startupChecks...)
+
+	return nil
+}
+
+// installStartupz installs StartupzPath into the server, checking the startup checks and that the
+// post-start hooks with the given done channels completed.
+func (c *completedConfig) installStartupz(server *genericapiserver.GenericAPIServer, done map[string]chan struct{}) {
+	checks := append(slices.Clone(c.startupChecks), postStartHooksCompleted(done))
+	healthz.InstallPathHandler(server.Handler.NonGoRestfulMux, StartupzPath, checks...)
+}
+
+// latchedCheck passes once its check passed.
+type latchedCheck struct {
+	healthz.HealthChecker
+	passed atomic.Bool
+}
+
+// latched wraps checks, so they are not run anymore once they passed. The same instances are
+// added to /readyz and /startupz.
+func latched(checks []healthz.HealthChecker) []healthz.HealthChecker {
+	out := make([]healthz.HealthChecker, 0, len(checks))
+	for _, check := range checks {
+		out = append(out, &latchedCheck{HealthChecker: check})
+	}
+
+	return out
+}
+
+func (l *latchedCheck) Check(req *http.Request) error {
+	if l.passed.Load() {
+		return nil
+	}
+	if err := l.HealthChecker.Check(req); err != nil {
+		return err
+	}
+	l.passed.Store(true)
+
+	return nil
+}
+
+// postStartHooksCompleted returns a check passing once all done channels are closed.
+func postStartHooksCompleted(done map[string]chan struct{}) healthz.HealthChecker {
+	return healthz.NamedCheck("poststarthooks", func(*http.Request) error {
+		for _, name := range slices.Sorted(maps.Keys(done)) {
+			select {
+			case <-done[name]:
+			default:
+				return fmt.Errorf("post-start hook %q has not completed", name)
+			}
+		}
+
+		return nil
+	})
+}
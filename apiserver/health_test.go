@@ -0,0 +1,104 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/apiserver/pkg/server/healthz"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// checkNames returns the names of checks.
+func checkNames(checks []healthz.HealthChecker) []string {
+	names := []string{}
+	for _, check := range checks {
+		names = append(names, check.Name())
+	}
+
+	return names
+}
+
+var _ = Describe("health checks", func() {
+	var (
+		rc     *genericapiserver.RecommendedConfig
+		passes = func(*http.Request) error { return nil }
+	)
+
+	BeforeEach(func() {
+		rc = genericapiserver.NewRecommendedConfig(serializer.NewCodecFactory(runtime.NewScheme()))
+		rc.AddHealthChecks(healthz.NamedCheck("etcd", passes))
+	})
+
+	It("should add checks to their probes", func() {
+		c := &completedConfig{builderConfig: builderConfig{
+			livezChecks:   []healthz.HealthChecker{healthz.NamedCheck("deadlock", passes)},
+			readyzChecks:  []healthz.HealthChecker{healthz.NamedCheck("cache", passes)},
+			startupChecks: latched([]healthz.HealthChecker{healthz.NamedCheck("migration", passes)}),
+		}}
+		Expect(c.applyHealthChecks(&rc.Config)).To(Succeed())
+
+		Expect(checkNames(rc.LivezChecks)).To(ContainElement("deadlock"))
+		Expect(checkNames(rc.LivezChecks)).NotTo(ContainElements("cache", "migration"))
+		Expect(checkNames(rc.ReadyzChecks)).To(ContainElements("cache", "migration"))
+		Expect(checkNames(rc.ReadyzChecks)).NotTo(ContainElement("deadlock"))
+		Expect(checkNames(rc.HealthzChecks)).NotTo(ContainElements("deadlock", "cache", "migration"))
+	})
+
+	It("should remove excluded checks from livez only", func() {
+		c := &completedConfig{builderConfig: builderConfig{livezExcludes: []string{"etcd"}, livezGracePeriod: time.Minute}}
+		Expect(c.applyHealthChecks(&rc.Config)).To(Succeed())
+
+		Expect(checkNames(rc.LivezChecks)).NotTo(ContainElement("etcd"))
+		Expect(checkNames(rc.ReadyzChecks)).To(ContainElement("etcd"))
+		Expect(checkNames(rc.HealthzChecks)).To(ContainElement("etcd"))
+		Expect(rc.LivezGracePeriod).To(Equal(time.Minute))
+	})
+
+	It("should reject unknown excluded checks", func() {
+		c := &completedConfig{builderConfig: builderConfig{livezExcludes: []string{"unknown"}}}
+		Expect(c.applyHealthChecks(&rc.Config)).To(MatchError(`livez check "unknown" does not exist`))
+	})
+
+	It("should not run startup checks again once they passed", func() {
+		calls, err := 0, errors.New("migrating")
+		checks := latched([]healthz.HealthChecker{healthz.NamedCheck("migration", func(*http.Request) error {
+			calls++
+			return err
+		})})
+		Expect(checks[0].Name()).To(Equal("migration"))
+		Expect(checks[0].Check(nil)).To(MatchError("migrating"))
+
+		err = nil
+		Expect(checks[0].Check(nil)).To(Succeed())
+		err = errors.New("migrating")
+		Expect(checks[0].Check(nil)).To(Succeed())
+		Expect(calls).To(Equal(2))
+	})
+
+	It("should report the startup once the post-start hooks completed", func() {
+		done := map[string]chan struct{}{"a": make(chan struct{}), "b": make(chan struct{})}
+		mux := http.NewServeMux()
+		healthz.InstallPathHandler(mux, StartupzPath, postStartHooksCompleted(done))
+		probe := func() int {
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, StartupzPath, nil))
+
+			return rec.Code
+		}
+
+		close(done["a"])
+		Expect(probe()).To(Equal(http.StatusInternalServerError))
+		close(done["b"])
+		Expect(probe()).To(Equal(http.StatusOK))
+	})
+})
@@ -0,0 +1,160 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package history resolves points in time to resourceVersions, so objects can be read as
+// they were at that time, e.g. for audits or debugging.
+//
+// The generic API server serves lists at an exact resourceVersion if it is requested with
+// resourceVersionMatch=Exact, as long as the revision has not been compacted by etcd. etcd
+// does not index its revisions by time, so a Recorder samples the current resourceVersion
+// of a resource periodically and resolves a time to the newest sample taken before it.
+package history
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	k8srest "k8s.io/apiserver/pkg/registry/rest"
+
+	"go.opendefense.cloud/kit/apiserver/rest"
+)
+
+const (
+	// DefaultRetention is how long samples are kept by default. It covers the revisions
+	// kept by etcd with the default compaction interval of the API server of 5 minutes.
+	DefaultRetention = 10 * time.Minute
+	// DefaultSampleInterval is the default interval in which the resourceVersion is sampled.
+	DefaultSampleInterval = 5 * time.Second
+)
+
+var (
+	// ErrNotRecorded is returned for times before the oldest sample.
+	ErrNotRecorded = errors.New("no resourceVersion has been recorded at the given time")
+	// errNotReady is returned while the storage has not been set.
+	errNotReady = errors.New("storage of the recorder has not been set")
+)
+
+// sample is the resourceVersion read at a time.
+type sample struct {
+	time            time.Time
+	resourceVersion uint64
+}
+
+// Recorder records the resourceVersions of a storage over time. Since all resources of a
+// server share the revision of etcd, a single Recorder resolves times for all of them.
+type Recorder struct {
+	retention time.Duration
+
+	mu      sync.Mutex
+	lister  k8srest.Lister
+	samples []sample
+}
+
+// NewRecorder returns a Recorder keeping samples for the given retention. Samples older
+// than the compaction of etcd are useless, as their revision cannot be read anymore.
+func NewRecorder(retention time.Duration) *Recorder {
+	return &Recorder{retention: retention}
+}
+
+// SetStorage sets the storage the resourceVersion is read from. It is passed to
+// ResourceHandler.WithStorageHook of any resource of the server.
+func (r *Recorder) SetStorage(storage rest.Storage) error {
+	lister, ok := storage.(k8srest.Lister)
+	if !ok {
+		return fmt.Errorf("storage %T does not support list", storage)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lister = lister
+
+	return nil
+}
+
+// Run samples the resourceVersion in the given interval until ctx is done.
+func (r *Recorder) Run(ctx context.Context, interval time.Duration) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := r.Sample(ctx); err != nil {
+			utilruntime.HandleErrorWithContext(ctx, err, "Failed to sample the resourceVersion")
+		}
+	}, interval)
+}
+
+// Sample reads the current resourceVersion from storage and records it.
+func (r *Recorder) Sample(ctx context.Context) error {
+	r.mu.Lock()
+	lister := r.lister
+	r.mu.Unlock()
+	if lister == nil {
+		return errNotReady
+	}
+	// A list without resourceVersion is read consistently, its resourceVersion is the
+	// current revision of etcd.
+	list, err := lister.List(genericapirequest.WithNamespace(ctx, ""), &metainternalversion.ListOptions{Limit: 1})
+	if err != nil {
+		return fmt.Errorf("listing the current resourceVersion: %w", err)
+	}
+	m, err := meta.ListAccessor(list)
+	if err != nil {
+		return err
+	}
+	// The time after the list ensures that the sample does not contain later writes.
+	return r.Record(time.Now(), m.GetResourceVersion())
+}
+
+// Record records that resourceVersion has been current at t. Samples must be recorded in order,
+// samples older than the newest one are ignored.
+func (r *Recorder) Record(t time.Time, resourceVersion string) error {
+	rv, err := strconv.ParseUint(resourceVersion, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid resourceVersion %q: %w", resourceVersion, err)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if n := len(r.samples); n > 0 {
+		last := r.samples[n-1]
+		if t.Before(last.time) || rv < last.resourceVersion {
+			return nil
+		}
+		// Nothing has been written since the last sample, which thus still resolves t.
+		if rv == last.resourceVersion {
+			return nil
+		}
+	}
+	r.samples = append(r.samples, sample{time: t, resourceVersion: rv})
+	r.prune(t)
+
+	return nil
+}
+
+// prune drops the samples older than the retention, keeping the newest one.
+func (r *Recorder) prune(now time.Time) {
+	cutoff := now.Add(-r.retention)
+	i := sort.Search(len(r.samples), func(i int) bool { return !r.samples[i].time.Before(cutoff) })
+	i = min(i, len(r.samples)-1)
+	r.samples = r.samples[i:]
+}
+
+// ResourceVersionAt returns the resourceVersion of the newest sample recorded at or before t.
+// Objects read at this resourceVersion do not contain changes made after t, but may lack
+// changes made within the sample interval before t. ErrNotRecorded is returned if t is
+// before the oldest sample.
+func (r *Recorder) ResourceVersionAt(t time.Time) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	i := sort.Search(len(r.samples), func(i int) bool { return r.samples[i].time.After(t) })
+	if i == 0 {
+		return "", ErrNotRecorded
+	}
+
+	return strconv.FormatUint(r.samples[i-1].resourceVersion, 10), nil
+}
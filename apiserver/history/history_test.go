@@ -0,0 +1,122 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package history
+
+import (
+	"context"
+	"time"
+
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fakeStorage returns empty lists at a fixed resourceVersion.
+type fakeStorage struct {
+	resourceVersion string
+	options         *metainternalversion.ListOptions
+}
+
+func (f *fakeStorage) New() runtime.Object     { return &metav1.Status{} }
+func (f *fakeStorage) Destroy()                {}
+func (f *fakeStorage) NewList() runtime.Object { return &metav1.List{} }
+
+func (f *fakeStorage) List(ctx context.Context, options *metainternalversion.ListOptions) (runtime.Object, error) {
+	f.options = options
+
+	return &metav1.List{ListMeta: metav1.ListMeta{ResourceVersion: f.resourceVersion}}, nil
+}
+
+func (f *fakeStorage) ConvertToTable(ctx context.Context, obj runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
+	return &metav1.Table{}, nil
+}
+
+// getOnlyStorage cannot list.
+type getOnlyStorage struct{}
+
+func (*getOnlyStorage) New() runtime.Object { return &metav1.Status{} }
+func (*getOnlyStorage) Destroy()            {}
+
+var _ = Describe("Recorder", func() {
+	var (
+		r  *Recorder
+		t0 time.Time
+	)
+
+	BeforeEach(func() {
+		r = NewRecorder(DefaultRetention)
+		t0 = time.Now()
+	})
+
+	It("should resolve times to the newest resourceVersion recorded before", func() {
+		Expect(r.Record(t0, "10")).To(Succeed())
+		Expect(r.Record(t0.Add(time.Second), "15")).To(Succeed())
+		Expect(r.Record(t0.Add(2*time.Second), "20")).To(Succeed())
+
+		Expect(r.ResourceVersionAt(t0)).To(Equal("10"))
+		Expect(r.ResourceVersionAt(t0.Add(1500 * time.Millisecond))).To(Equal("15"))
+		Expect(r.ResourceVersionAt(t0.Add(time.Hour))).To(Equal("20"))
+	})
+
+	It("should reject times before the oldest sample", func() {
+		Expect(r.Record(t0, "10")).To(Succeed())
+
+		_, err := r.ResourceVersionAt(t0.Add(-time.Second))
+		Expect(err).To(MatchError(ErrNotRecorded))
+	})
+
+	It("should keep the first sample of an unchanged resourceVersion", func() {
+		Expect(r.Record(t0, "10")).To(Succeed())
+		Expect(r.Record(t0.Add(time.Second), "10")).To(Succeed())
+
+		Expect(r.ResourceVersionAt(t0)).To(Equal("10"))
+	})
+
+	It("should ignore samples out of order", func() {
+		Expect(r.Record(t0, "10")).To(Succeed())
+		Expect(r.Record(t0.Add(-time.Second), "5")).To(Succeed())
+		Expect(r.Record(t0.Add(time.Second), "7")).To(Succeed())
+
+		_, err := r.ResourceVersionAt(t0.Add(-time.Second))
+		Expect(err).To(MatchError(ErrNotRecorded))
+		Expect(r.ResourceVersionAt(t0.Add(time.Second))).To(Equal("10"))
+	})
+
+	It("should drop samples older than the retention but the newest one", func() {
+		r = NewRecorder(time.Minute)
+		Expect(r.Record(t0, "10")).To(Succeed())
+		Expect(r.Record(t0.Add(30*time.Second), "20")).To(Succeed())
+		Expect(r.Record(t0.Add(2*time.Minute), "30")).To(Succeed())
+
+		_, err := r.ResourceVersionAt(t0.Add(time.Minute))
+		Expect(err).To(MatchError(ErrNotRecorded))
+		Expect(r.ResourceVersionAt(t0.Add(2 * time.Minute))).To(Equal("30"))
+
+		r = NewRecorder(time.Minute)
+		Expect(r.Record(t0, "10")).To(Succeed())
+		Expect(r.Record(t0.Add(time.Hour), "10")).To(Succeed())
+		Expect(r.ResourceVersionAt(t0.Add(time.Hour))).To(Equal("10"))
+	})
+
+	It("should reject invalid resourceVersions", func() {
+		Expect(r.Record(t0, "abc")).To(MatchError(ContainSubstring("invalid resourceVersion")))
+	})
+
+	It("should sample the resourceVersion of the storage", func() {
+		storage := &fakeStorage{resourceVersion: "42"}
+		Expect(r.Sample(context.Background())).To(MatchError(errNotReady))
+
+		Expect(r.SetStorage(storage)).To(Succeed())
+		Expect(r.Sample(context.Background())).To(Succeed())
+		Expect(storage.options.ResourceVersion).To(BeEmpty())
+		Expect(r.ResourceVersionAt(time.Now())).To(Equal("42"))
+	})
+
+	It("should reject storage which cannot list", func() {
+		Expect(r.SetStorage(&getOnlyStorage{})).To(MatchError(ContainSubstring("does not support list")))
+	})
+})
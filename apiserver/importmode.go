@@ -0,0 +1,146 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/endpoints/filters"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+
+	"go.opendefense.cloud/kit/apiserver/audit"
+	"go.opendefense.cloud/kit/apiserver/rest"
+)
+
+const (
+	// ImportHeader marks create requests as imports, which keep the UID and the creation timestamp
+	// of the submitted object, if set to true. See Builder.WithImportMode.
+	ImportHeader = "X-Kit-Import"
+	// ImportVerb authorizes import requests to a resource in addition to the verb of the request.
+	ImportVerb = "import"
+)
+
+// WithImportMode lets clients restore or migrate objects with their UID and creation timestamp,
+// which are set by the server otherwise, by creating them with the X-Kit-Import: true header.
+// Import requests are authorized with the import verb on the resource in addition to create or
+// update, which RBAC grants separately:
+//
+//	rules:
+//	- apiGroups: ["foo.opendefense.cloud"]
+//	  resources: ["bars"]
+//	  verbs: ["create", "import"]
+//
+// The kept metadata is recorded in the audit annotation kit.opendefense.cloud/import. Objects
+// must be submitted as JSON or YAML. Existing objects are not changed by import requests.
+func (b *Builder) WithImportMode() *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.importMode = true
+
+	return b
+}
+
+// withImport wraps the API handler passed to delegate to authorize import requests and to pass
+// the metadata of their objects to storage.
+func withImport(delegate func(http.Handler, *genericapiserver.Config) http.Handler) func(http.Handler, *genericapiserver.Config) http.Handler {
+	return func(apiHandler http.Handler, c *genericapiserver.Config) http.Handler {
+		handler := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if req.Header.Get(ImportHeader) != "true" {
+				apiHandler.ServeHTTP(w, req)
+				return
+			}
+			req, err := importRequest(w, req, c.Authorization.Authorizer, c.MaxRequestBodyBytes)
+			if err != nil {
+				gv := schema.GroupVersion{}
+				if info, ok := request.RequestInfoFrom(req.Context()); ok {
+					gv = schema.GroupVersion{Group: info.APIGroup, Version: info.APIVersion}
+				}
+				responsewriters.ErrorNegotiated(err, c.Serializer, gv, w, req)
+
+				return
+			}
+			apiHandler.ServeHTTP(w, req)
+		})
+
+		return delegate(handler, c)
+	}
+}
+
+// importRequest authorizes the import request req and returns it with the metadata of its object
+// in the context.
+func importRequest(w http.ResponseWriter, req *http.Request, authz authorizer.Authorizer, maxBodyBytes int64) (*http.Request, error) {
+	ctx := req.Context()
+	info, ok := request.RequestInfoFrom(ctx)
+	if !ok || !info.IsResourceRequest || info.Subresource != "" || (info.Verb != "create" && info.Verb != "update") {
+		return req, apierrors.NewBadRequest(fmt.Sprintf("%s is only supported by requests creating objects", ImportHeader))
+	}
+	if authz != nil {
+		attrs, err := filters.GetAuthorizerAttributes(ctx)
+		if err != nil {
+			return req, apierrors.NewInternalError(err)
+		}
+		attrs = verbAttributes{Attributes: attrs, verb: ImportVerb}
+		decision, reason, err := authz.Authorize(ctx, attrs)
+		if decision != authorizer.DecisionAllow {
+			if err != nil {
+				reason = err.Error()
+			}
+
+			return req, responsewriters.ForbiddenStatusError(attrs, reason)
+		}
+	}
+	if strings.Contains(req.Header.Get("Content-Type"), "protobuf") {
+		return req, apierrors.NewBadRequest("import requests must submit objects as JSON or YAML")
+	}
+
+	// Read the metadata of the object and pass the body on.
+	body := io.Reader(req.Body)
+	if maxBodyBytes > 0 {
+		body = http.MaxBytesReader(w, req.Body, maxBodyBytes)
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		if tooLarge := (*http.MaxBytesError)(nil); errors.As(err, &tooLarge) {
+			return req, apierrors.NewRequestEntityTooLargeError(err.Error())
+		}
+
+		return req, apierrors.NewBadRequest(err.Error())
+	}
+	obj := &metav1.PartialObjectMetadata{}
+	if err := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), len(data)).Decode(obj); err != nil {
+		return req, apierrors.NewBadRequest(err.Error())
+	}
+	m := rest.ImportedMetadata{UID: obj.UID, CreationTimestamp: obj.CreationTimestamp}
+	audit.AddAnnotation(ctx, rest.AuditAnnotationImport, importAnnotation(m))
+	req = req.WithContext(rest.WithImportedMetadata(ctx, m))
+	req.Body = io.NopCloser(bytes.NewReader(data))
+
+	return req, nil
+}
+
+// importAnnotation returns the value of the audit annotation recording the kept metadata m.
+func importAnnotation(m rest.ImportedMetadata) string {
+	fields := []string{}
+	if m.UID != "" {
+		fields = append(fields, "uid="+string(m.UID))
+	}
+	if !m.CreationTimestamp.IsZero() {
+		fields = append(fields, "creationTimestamp="+m.CreationTimestamp.UTC().Format(time.RFC3339))
+	}
+
+	return strings.Join(fields, ",")
+}
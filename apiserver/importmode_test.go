@@ -0,0 +1,83 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	"go.opendefense.cloud/kit/apiserver/rest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Import mode", func() {
+	const body = `{"apiVersion":"test.opendefense.cloud/v1","kind":"Bar","metadata":{"name":"b1","uid":"imported","creationTimestamp":"2020-01-01T00:00:00Z"}}`
+
+	var recorder *recordingAuthorizer
+
+	// newRequest returns a request resolved and authenticated as by the handler chain of the server.
+	newRequest := func(method, path, body string) *http.Request {
+		resolver := &request.RequestInfoFactory{APIPrefixes: sets.NewString("apis"), GrouplessAPIPrefixes: sets.NewString()}
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(ImportHeader, "true")
+		info, err := resolver.NewRequestInfo(req)
+		Expect(err).NotTo(HaveOccurred())
+		ctx := request.WithUser(request.WithRequestInfo(req.Context(), info), &user.DefaultInfo{Name: "migration"})
+
+		return req.WithContext(ctx)
+	}
+
+	BeforeEach(func() {
+		recorder = &recordingAuthorizer{}
+	})
+
+	It("should pass the metadata of authorized imports on", func() {
+		req, err := importRequest(httptest.NewRecorder(), newRequest(http.MethodPost, "/apis/test.opendefense.cloud/v1/namespaces/ns/bars", body), recorder, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorder.attrs.GetVerb()).To(Equal(ImportVerb))
+		Expect(recorder.attrs.GetResource()).To(Equal("bars"))
+
+		m, ok := rest.ImportedMetadataFrom(req.Context())
+		Expect(ok).To(BeTrue())
+		Expect(m.UID).To(Equal(types.UID("imported")))
+		Expect(m.CreationTimestamp.UTC().Year()).To(Equal(2020))
+		data, err := io.ReadAll(req.Body)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(Equal(body))
+		Expect(importAnnotation(m)).To(Equal("uid=imported,creationTimestamp=2020-01-01T00:00:00Z"))
+	})
+
+	It("should forbid imports not authorized with the import verb", func() {
+		denyAll := authorizer.AuthorizerFunc(func(context.Context, authorizer.Attributes) (authorizer.Decision, string, error) {
+			return authorizer.DecisionNoOpinion, "", nil
+		})
+		_, err := importRequest(httptest.NewRecorder(), newRequest(http.MethodPost, "/apis/test.opendefense.cloud/v1/namespaces/ns/bars", body), denyAll, 0)
+		Expect(apierrors.IsForbidden(err)).To(BeTrue())
+	})
+
+	It("should reject imports by requests not creating objects", func() {
+		_, err := importRequest(httptest.NewRecorder(), newRequest(http.MethodDelete, "/apis/test.opendefense.cloud/v1/namespaces/ns/bars/b1", ""), recorder, 0)
+		Expect(apierrors.IsBadRequest(err)).To(BeTrue())
+		_, err = importRequest(httptest.NewRecorder(), newRequest(http.MethodPut, "/apis/test.opendefense.cloud/v1/namespaces/ns/bars/b1/status", body), recorder, 0)
+		Expect(apierrors.IsBadRequest(err)).To(BeTrue())
+	})
+
+	It("should limit the size of imported objects", func() {
+		_, err := importRequest(httptest.NewRecorder(), newRequest(http.MethodPost, "/apis/test.opendefense.cloud/v1/namespaces/ns/bars", body), recorder, 10)
+		Expect(apierrors.IsRequestEntityTooLargeError(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,60 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"context"
+	"reflect"
+)
+
+// StopChannelInformerFactory is an informer factory started with a stop channel, like the
+// factories generated by informer-gen for client-go.
+type StopChannelInformerFactory interface {
+	Start(stopCh <-chan struct{})
+}
+
+// InformerFactory adapts an informer factory started with a stop channel, e.g. of client-go, to
+// a SharedInformerFactory, which is stopped by cancelling the context it has been started with:
+//
+//	builder.WithSharedInformerFactory(apiserver.InformerFactory(informers.NewSharedInformerFactory(client, 0)))
+//
+// The informers of the factory are waited for until they synced and when the server stops if
+// the factory supports it, like those of client-go.
+func InformerFactory(f StopChannelInformerFactory) SharedInformerFactory {
+	if f == nil {
+		return nil
+	}
+
+	return &stopChannelInformerFactory{factory: f}
+}
+
+// stopChannelInformerFactory starts the informers of a factory until the context is cancelled.
+type stopChannelInformerFactory struct {
+	factory StopChannelInformerFactory
+}
+
+var (
+	_ cacheSyncWaiter    = &stopChannelInformerFactory{}
+	_ informerShutdowner = &stopChannelInformerFactory{}
+)
+
+func (f *stopChannelInformerFactory) Start(ctx context.Context) {
+	f.factory.Start(ctx.Done())
+}
+
+// WaitForCacheSync waits for the informers of the factory if it supports it.
+func (f *stopChannelInformerFactory) WaitForCacheSync(stopCh <-chan struct{}) map[reflect.Type]bool {
+	if w, ok := f.factory.(cacheSyncWaiter); ok {
+		return w.WaitForCacheSync(stopCh)
+	}
+
+	return nil
+}
+
+// Shutdown waits for the informers of the factory to terminate if it supports it.
+func (f *stopChannelInformerFactory) Shutdown() {
+	if s, ok := f.factory.(informerShutdowner); ok {
+		s.Shutdown()
+	}
+}
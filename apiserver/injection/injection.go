@@ -0,0 +1,124 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package injection adds standard labels and annotations to created objects in admission, so
+// the objects of all resources of a server can be selected and attributed uniformly, e.g. by
+// app.kubernetes.io/managed-by.
+package injection
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/admission"
+)
+
+const (
+	// PluginName is the name of the admission plugin injecting labels and annotations.
+	PluginName = "MetadataInjection"
+	// LabelManagedBy is the recommended label naming the tool managing an object.
+	LabelManagedBy = "app.kubernetes.io/managed-by"
+	// LabelComponent is the recommended label naming the component an object belongs to.
+	LabelComponent = "app.kubernetes.io/component"
+)
+
+// Config configures the labels and annotations injected into created objects.
+type Config struct {
+	// Labels are added to created objects. Labels set by the client are kept.
+	Labels map[string]string
+	// Annotations are added to created objects. Annotations set by the client are kept.
+	Annotations map[string]string
+	// CreatorAnnotation is the key of an annotation recording the name of the user creating an
+	// object, if it is set. It is always overwritten, so clients cannot forge it.
+	CreatorAnnotation string
+	// ExcludeResources are the resources whose objects are left unchanged.
+	ExcludeResources []schema.GroupResource
+	// ExcludeNamespaces are the namespaces whose objects are left unchanged.
+	ExcludeNamespaces []string
+}
+
+// Validate returns an error if the labels or annotations of c are invalid.
+func (c Config) Validate() error {
+	errs := metav1validation.ValidateLabels(c.Labels, field.NewPath("labels"))
+	annotations := maps.Clone(c.Annotations)
+	if c.CreatorAnnotation != "" {
+		annotations = add(annotations, map[string]string{c.CreatorAnnotation: ""})
+	}
+	errs = append(errs, validation.ValidateAnnotations(annotations, field.NewPath("annotations"))...)
+
+	return errs.ToAggregate()
+}
+
+// Register registers the admission plugin injecting the labels and annotations of c.
+func Register(plugins *admission.Plugins, c Config) {
+	plugins.Register(PluginName, func(io.Reader) (admission.Interface, error) {
+		return NewPlugin(c), nil
+	})
+}
+
+// Plugin is an admission plugin adding labels and annotations to created objects.
+type Plugin struct {
+	*admission.Handler
+	config Config
+}
+
+var _ admission.MutationInterface = &Plugin{}
+
+// NewPlugin returns the admission plugin injecting the labels and annotations of c.
+func NewPlugin(c Config) *Plugin {
+	return &Plugin{
+		Handler: admission.NewHandler(admission.Create),
+		config:  c,
+	}
+}
+
+// Admit adds the labels and annotations to created objects which are not excluded.
+func (p *Plugin) Admit(ctx context.Context, a admission.Attributes, o admission.ObjectInterfaces) error {
+	if a.GetSubresource() != "" || a.GetObject() == nil || p.excluded(a) {
+		return nil
+	}
+	m, err := meta.Accessor(a.GetObject())
+	if err != nil {
+		return fmt.Errorf("injecting labels and annotations: %w", err)
+	}
+	m.SetLabels(add(m.GetLabels(), p.config.Labels))
+	annotations := add(m.GetAnnotations(), p.config.Annotations)
+	if key := p.config.CreatorAnnotation; key != "" && a.GetUserInfo() != nil {
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[key] = a.GetUserInfo().GetName()
+	}
+	m.SetAnnotations(annotations)
+
+	return nil
+}
+
+// excluded returns true if the object of a is excluded by its resource or namespace.
+func (p *Plugin) excluded(a admission.Attributes) bool {
+	return slices.Contains(p.config.ExcludeResources, a.GetResource().GroupResource()) ||
+		(a.GetNamespace() != "" && slices.Contains(p.config.ExcludeNamespaces, a.GetNamespace()))
+}
+
+// add adds the entries of from missing in to and returns to.
+func add(to, from map[string]string) map[string]string {
+	for k, v := range from {
+		if _, ok := to[k]; ok {
+			continue
+		}
+		if to == nil {
+			to = map[string]string{}
+		}
+		to[k] = v
+	}
+
+	return to
+}
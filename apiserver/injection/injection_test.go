@@ -0,0 +1,88 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package injection
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config", func() {
+	It("should accept valid labels and annotations", func() {
+		Expect(Config{
+			Labels:            map[string]string{LabelManagedBy: "foo-apiserver"},
+			Annotations:       map[string]string{"example.com/source": "api"},
+			CreatorAnnotation: "example.com/created-by",
+		}.Validate()).To(Succeed())
+	})
+
+	It("should reject invalid labels and annotations", func() {
+		Expect(Config{Labels: map[string]string{LabelManagedBy: "not valid"}}.Validate()).To(MatchError(ContainSubstring("labels")))
+		Expect(Config{CreatorAnnotation: "not valid"}.Validate()).To(MatchError(ContainSubstring("annotations")))
+	})
+})
+
+var _ = Describe("Plugin", func() {
+	var plugin *Plugin
+
+	BeforeEach(func() {
+		plugin = NewPlugin(Config{
+			Labels:            map[string]string{LabelManagedBy: "foo-apiserver", LabelComponent: "foo"},
+			Annotations:       map[string]string{"example.com/source": "api"},
+			CreatorAnnotation: "example.com/created-by",
+			ExcludeResources:  []schema.GroupResource{{Resource: "secrets"}},
+			ExcludeNamespaces: []string{"kube-system"},
+		})
+	})
+
+	admit := func(cm *corev1.ConfigMap, resource, subresource string) error {
+		a := admission.NewAttributesRecord(cm, nil, corev1.SchemeGroupVersion.WithKind("ConfigMap"), cm.Namespace, cm.Name,
+			corev1.SchemeGroupVersion.WithResource(resource), subresource, admission.Create, &metav1.CreateOptions{}, false,
+			&user.DefaultInfo{Name: "alice"})
+
+		return plugin.Admit(context.Background(), a, nil)
+	}
+
+	It("should only handle creates", func() {
+		Expect(plugin.Handles(admission.Create)).To(BeTrue())
+		Expect(plugin.Handles(admission.Update)).To(BeFalse())
+	})
+
+	It("should inject the labels, annotations and creator", func() {
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+		Expect(admit(cm, "configmaps", "")).To(Succeed())
+		Expect(cm.Labels).To(Equal(map[string]string{LabelManagedBy: "foo-apiserver", LabelComponent: "foo"}))
+		Expect(cm.Annotations).To(Equal(map[string]string{"example.com/source": "api", "example.com/created-by": "alice"}))
+	})
+
+	It("should keep labels and annotations set by the client, except the creator", func() {
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Name:        "test",
+			Namespace:   "default",
+			Labels:      map[string]string{LabelManagedBy: "helm"},
+			Annotations: map[string]string{"example.com/source": "cli", "example.com/created-by": "mallory"},
+		}}
+		Expect(admit(cm, "configmaps", "")).To(Succeed())
+		Expect(cm.Labels).To(Equal(map[string]string{LabelManagedBy: "helm", LabelComponent: "foo"}))
+		Expect(cm.Annotations).To(Equal(map[string]string{"example.com/source": "cli", "example.com/created-by": "alice"}))
+	})
+
+	It("should leave excluded objects and subresources unchanged", func() {
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+		Expect(admit(cm, "secrets", "")).To(Succeed())
+		Expect(admit(cm, "configmaps", "status")).To(Succeed())
+		cm.Namespace = "kube-system"
+		Expect(admit(cm, "configmaps", "")).To(Succeed())
+		Expect(cm.Labels).To(BeNil())
+		Expect(cm.Annotations).To(BeNil())
+	})
+})
@@ -0,0 +1,29 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package kitapi
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// AddExternalTypes registers types in gv and as the internal version of its group, so an API
+// with a single version is served without internal types and generated conversions. The generic
+// API server converts requests to the internal version, which is a no-op for types registered
+// in both versions, so strategies, validation and admission operate on the versioned types.
+//
+// It replaces AddKnownTypes in the scheme builder of the version package:
+//
+//	func addKnownTypes(scheme *runtime.Scheme) error {
+//	    kitapi.AddExternalTypes(scheme, SchemeGroupVersion, &Bar{}, &BarList{})
+//	    metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
+//	    return nil
+//	}
+//
+// Resources are then registered with their versioned type, e.g. apiserver.Resource(&v1.Bar{},
+// v1.SchemeGroupVersion). Once a second version is added, the group needs internal types again.
+func AddExternalTypes(scheme *runtime.Scheme, gv schema.GroupVersion, types ...runtime.Object) {
+	scheme.AddKnownTypes(gv, types...)
+	scheme.AddKnownTypes(schema.GroupVersion{Group: gv.Group, Version: runtime.APIVersionInternal}, types...)
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package kitapi
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"k8s.io/kube-openapi/pkg/builder3"
+	openapicommon "k8s.io/kube-openapi/pkg/common"
+	"k8s.io/kube-openapi/pkg/spec3"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+)
+
+const (
+	// TableMIMEType is the media type clients accept to receive objects as metav1.Table, e.g.
+	// kubectl get.
+	TableMIMEType = "application/json;as=Table;v=v1;g=meta.k8s.io"
+	// tableDefinition is the name of the OpenAPI definition of metav1.Table.
+	tableDefinition = "k8s.io/apimachinery/pkg/apis/meta/v1.Table"
+)
+
+var (
+	// openAPIDefinitionsMu guards openAPIDefinitions.
+	openAPIDefinitionsMu sync.Mutex
+	// openAPIDefinitions are the definitions registered by API packages.
+	openAPIDefinitions []openapicommon.GetOpenAPIDefinitions
+)
+
+// RegisterOpenAPIDefinitions registers generated OpenAPI definitions, which are served by every
+// API server built afterwards. It is typically called by the package containing the definitions:
+//
+//	func init() {
+//	    kitapi.RegisterOpenAPIDefinitions(GetOpenAPIDefinitions)
+//	}
+func RegisterOpenAPIDefinitions(defs ...openapicommon.GetOpenAPIDefinitions) {
+	openAPIDefinitionsMu.Lock()
+	defer openAPIDefinitionsMu.Unlock()
+	for _, d := range defs {
+		if d != nil {
+			openAPIDefinitions = append(openAPIDefinitions, d)
+		}
+	}
+}
+
+// RegisteredOpenAPIDefinitions returns all definitions registered by RegisterOpenAPIDefinitions.
+func RegisteredOpenAPIDefinitions() []openapicommon.GetOpenAPIDefinitions {
+	openAPIDefinitionsMu.Lock()
+	defer openAPIDefinitionsMu.Unlock()
+
+	return slices.Clone(openAPIDefinitions)
+}
+
+// MergeOpenAPIDefinitions returns the definitions of all defs. Definitions of the same name,
+// e.g. of common types generated into several packages, are taken from the last one.
+func MergeOpenAPIDefinitions(defs ...openapicommon.GetOpenAPIDefinitions) openapicommon.GetOpenAPIDefinitions {
+	return func(ref openapicommon.ReferenceCallback) map[string]openapicommon.OpenAPIDefinition {
+		merged := map[string]openapicommon.OpenAPIDefinition{}
+		for _, d := range defs {
+			if d == nil {
+				continue
+			}
+			for name, def := range d(ref) {
+				merged[name] = def
+			}
+		}
+
+		return merged
+	}
+}
+
+// AddTableResponses returns a PostProcessSpec for config, which documents that get and list
+// operations respond with a metav1.Table if it is requested by the Accept header. The spec is
+// left unchanged if the definitions of config lack metav1.Table, which is generated with the
+// definitions of k8s.io/apimachinery/pkg/apis/meta/v1.
+func AddTableResponses(config *openapicommon.OpenAPIV3Config) func(*spec3.OpenAPI) (*spec3.OpenAPI, error) {
+	return func(s *spec3.OpenAPI) (*spec3.OpenAPI, error) {
+		if config.GetDefinitions == nil {
+			return s, nil
+		}
+		if _, ok := config.GetDefinitions(func(string) spec.Ref { return spec.Ref{} })[tableDefinition]; !ok {
+			return s, nil
+		}
+		schemas, err := builder3.BuildOpenAPIDefinitionsForResources(config, tableDefinition)
+		if err != nil {
+			return nil, err
+		}
+		if s.Components == nil {
+			s.Components = &spec3.Components{}
+		}
+		if s.Components.Schemas == nil {
+			s.Components.Schemas = map[string]*spec.Schema{}
+		}
+		for name, schema := range schemas {
+			s.Components.Schemas[name] = schema
+		}
+		name, _ := config.GetDefinitionName(tableDefinition)
+		ref := spec.MustCreateRef("#/components/schemas/" + openapicommon.EscapeJsonPointer(name))
+		if s.Paths == nil {
+			return s, nil
+		}
+		for _, path := range s.Paths.Paths {
+			op := path.Get
+			if op == nil || op.Responses == nil || !isTableOperation(op.OperationId) {
+				continue
+			}
+			resp := op.Responses.StatusCodeResponses[http.StatusOK]
+			if resp == nil || resp.Content == nil {
+				continue
+			}
+			resp.Content[TableMIMEType] = &spec3.MediaType{MediaTypeProps: spec3.MediaTypeProps{
+				Schema: &spec.Schema{SchemaProps: spec.SchemaProps{Ref: ref}},
+			}}
+		}
+
+		return s, nil
+	}
+}
+
+// isTableOperation returns true for the operations served by the TableConvertor of a resource,
+// which are the get and list operations of the resource and its subresources.
+func isTableOperation(operationID string) bool {
+	return strings.HasPrefix(operationID, "read") || strings.HasPrefix(operationID, "list")
+}
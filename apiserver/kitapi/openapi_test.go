@@ -0,0 +1,96 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package kitapi
+
+import (
+	"net/http"
+
+	openapicommon "k8s.io/kube-openapi/pkg/common"
+	"k8s.io/kube-openapi/pkg/spec3"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// definitions returns OpenAPI definitions of the given names, described by description.
+func definitions(description string, names ...string) openapicommon.GetOpenAPIDefinitions {
+	return func(openapicommon.ReferenceCallback) map[string]openapicommon.OpenAPIDefinition {
+		defs := map[string]openapicommon.OpenAPIDefinition{}
+		for _, name := range names {
+			defs[name] = openapicommon.OpenAPIDefinition{Schema: spec.Schema{SchemaProps: spec.SchemaProps{Description: description}}}
+		}
+
+		return defs
+	}
+}
+
+var _ = Describe("OpenAPI definitions", func() {
+	BeforeEach(func() {
+		registered := RegisteredOpenAPIDefinitions()
+		DeferCleanup(func() {
+			openAPIDefinitionsMu.Lock()
+			defer openAPIDefinitionsMu.Unlock()
+			openAPIDefinitions = registered
+		})
+	})
+
+	It("should register definitions", func() {
+		RegisterOpenAPIDefinitions(definitions("a", "a.A"), nil)
+		Expect(RegisteredOpenAPIDefinitions()).To(HaveLen(1))
+	})
+
+	It("should merge definitions", func() {
+		merged := MergeOpenAPIDefinitions(definitions("first", "a.A", "common.C"), nil, definitions("second", "b.B", "common.C"))(nil)
+		Expect(merged).To(HaveKey("a.A"))
+		Expect(merged).To(HaveKey("b.B"))
+		Expect(merged["common.C"].Schema.Description).To(Equal("second"))
+	})
+})
+
+// getOperation returns a GET path responding with JSON.
+func getOperation(operationID string) *spec3.Path {
+	return &spec3.Path{PathProps: spec3.PathProps{Get: &spec3.Operation{OperationProps: spec3.OperationProps{
+		OperationId: operationID,
+		Responses: &spec3.Responses{ResponsesProps: spec3.ResponsesProps{StatusCodeResponses: map[int]*spec3.Response{
+			http.StatusOK: {ResponseProps: spec3.ResponseProps{Content: map[string]*spec3.MediaType{"application/json": {}}}},
+		}}},
+	}}}}
+}
+
+var _ = Describe("AddTableResponses", func() {
+	var s *spec3.OpenAPI
+
+	BeforeEach(func() {
+		s = &spec3.OpenAPI{Paths: &spec3.Paths{Paths: map[string]*spec3.Path{
+			"/apis/arc/v1/foos":               getOperation("listArcV1Foo"),
+			"/apis/arc/v1/foos/{name}/status": getOperation("readArcV1FooStatus"),
+			"/apis/arc/v1/watch/foos":         getOperation("watchArcV1FooList"),
+		}}}
+	})
+
+	contentOf := func(path string) map[string]*spec3.MediaType {
+		return s.Paths.Paths[path].Get.Responses.StatusCodeResponses[http.StatusOK].Content
+	}
+
+	It("should add Table responses to get and list operations", func() {
+		config := &openapicommon.OpenAPIV3Config{GetDefinitions: definitions("table", tableDefinition)}
+		_, err := AddTableResponses(config)(s)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s.Components.Schemas).To(HaveKey("v1.Table"))
+
+		Expect(contentOf("/apis/arc/v1/foos")).To(HaveKey(TableMIMEType))
+		Expect(contentOf("/apis/arc/v1/foos")[TableMIMEType].Schema.Ref.String()).To(Equal("#/components/schemas/v1.Table"))
+		Expect(contentOf("/apis/arc/v1/foos/{name}/status")).To(HaveKey(TableMIMEType))
+		Expect(contentOf("/apis/arc/v1/watch/foos")).ToNot(HaveKey(TableMIMEType))
+	})
+
+	It("should leave the spec unchanged without the Table definition", func() {
+		config := &openapicommon.OpenAPIV3Config{GetDefinitions: definitions("a", "a.A")}
+		_, err := AddTableResponses(config)(s)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s.Components).To(BeNil())
+		Expect(contentOf("/apis/arc/v1/foos")).ToNot(HaveKey(TableMIMEType))
+	})
+})
@@ -0,0 +1,47 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package kitapi provides helpers to set up the scheme of an API server.
+package kitapi
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+)
+
+// InstallFunc registers the types of an API group and their version priority with a scheme,
+// like the Install function of an install package.
+type InstallFunc func(*runtime.Scheme)
+
+// NewScheme returns a new scheme with the types of all API groups and the types required by
+// the generic API server, together with its codecs:
+//
+//	scheme, codecs := kitapi.NewScheme(install.Install)
+func NewScheme(installFns ...InstallFunc) (*runtime.Scheme, serializer.CodecFactory) {
+	scheme := runtime.NewScheme()
+	for _, install := range installFns {
+		install(scheme)
+	}
+	AddServerTypes(scheme)
+
+	return scheme, serializer.NewCodecFactory(scheme)
+}
+
+// AddServerTypes adds the types required by the generic API server to scheme, which are the
+// options of the empty v1 group version and the unversioned discovery and status types.
+// It is called by apiserver.NewBuilder and may be called multiple times.
+func AddServerTypes(scheme *runtime.Scheme) {
+	// The options of all requests are decoded from the empty v1 group version.
+	metav1.AddToGroupVersion(scheme, schema.GroupVersion{Version: "v1"})
+
+	unversioned := schema.GroupVersion{Group: "", Version: "v1"}
+	scheme.AddUnversionedTypes(unversioned,
+		&metav1.Status{},
+		&metav1.APIVersions{},
+		&metav1.APIGroupList{},
+		&metav1.APIGroup{},
+		&metav1.APIResourceList{},
+	)
+}
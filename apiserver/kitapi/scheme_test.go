@@ -0,0 +1,74 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package kitapi
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewScheme", func() {
+	gv := schema.GroupVersion{Group: "test.opendefense.cloud", Version: "v1"}
+
+	It("should install all API groups", func() {
+		scheme, _ := NewScheme(func(s *runtime.Scheme) {
+			s.AddKnownTypes(gv, &metav1.PartialObjectMetadata{})
+		})
+		Expect(scheme.Recognizes(gv.WithKind("PartialObjectMetadata"))).To(BeTrue())
+	})
+
+	It("should add the types required by the generic API server", func() {
+		scheme, _ := NewScheme()
+		v1 := schema.GroupVersion{Version: "v1"}
+		Expect(scheme.Recognizes(v1.WithKind("ListOptions"))).To(BeTrue())
+		Expect(scheme.Recognizes(v1.WithKind("DeleteOptions"))).To(BeTrue())
+		for _, obj := range []runtime.Object{&metav1.Status{}, &metav1.APIGroupList{}} {
+			unversioned, ok := scheme.IsUnversioned(obj)
+			Expect(ok).To(BeTrue())
+			Expect(unversioned).To(BeTrue())
+		}
+	})
+
+	It("should add the types required by the generic API server repeatedly", func() {
+		scheme, _ := NewScheme()
+		Expect(func() { AddServerTypes(scheme) }).NotTo(Panic())
+	})
+
+	It("should return codecs for the scheme", func() {
+		scheme, codecs := NewScheme()
+		data, err := runtime.Encode(codecs.LegacyCodec(schema.GroupVersion{Version: "v1"}), &metav1.Status{Status: metav1.StatusSuccess})
+		Expect(err).NotTo(HaveOccurred())
+		obj, err := runtime.Decode(codecs.UniversalDecoder(), data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(obj).To(BeAssignableToTypeOf(&metav1.Status{}))
+		Expect(scheme).NotTo(BeNil())
+	})
+})
+
+var _ = Describe("AddExternalTypes", func() {
+	gv := schema.GroupVersion{Group: "test.opendefense.cloud", Version: "v1"}
+
+	It("should serve external types as internal version", func() {
+		scheme, codecs := NewScheme(func(s *runtime.Scheme) {
+			AddExternalTypes(s, gv, &metav1.PartialObjectMetadata{})
+		})
+		internal := schema.GroupVersion{Group: gv.Group, Version: runtime.APIVersionInternal}
+		Expect(scheme.Recognizes(gv.WithKind("PartialObjectMetadata"))).To(BeTrue())
+		Expect(scheme.Recognizes(internal.WithKind("PartialObjectMetadata"))).To(BeTrue())
+
+		obj := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: "test"}}
+		data, err := runtime.Encode(codecs.LegacyCodec(gv), obj)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(ContainSubstring(`"apiVersion":"test.opendefense.cloud/v1"`))
+
+		decoded, err := runtime.Decode(codecs.UniversalDecoder(internal), data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(decoded).To(BeAssignableToTypeOf(&metav1.PartialObjectMetadata{}))
+		Expect(decoded.(*metav1.PartialObjectMetadata).Name).To(Equal("test"))
+	})
+})
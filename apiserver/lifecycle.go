@@ -0,0 +1,130 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/apiserver/pkg/warning"
+)
+
+// GroupVersionLifecycle defines the emulation versions of the component in which a group version
+// is served. Empty versions are not checked.
+type GroupVersionLifecycle struct {
+	// Introduced is the first emulation version serving the group version.
+	Introduced string
+	// Deprecated is the first emulation version returning a deprecation warning for all requests
+	// to the group version.
+	Deprecated string
+	// Removed is the first emulation version not serving the group version anymore.
+	Removed string
+}
+
+// WithGroupVersionLifecycle ties serving gv to the emulation version of the component, which
+// operators can set with the --emulated-version flag to preview deprecations and removals:
+//
+//	builder.WithGroupVersionLifecycle(v1alpha1.SchemeGroupVersion, apiserver.GroupVersionLifecycle{
+//	    Deprecated: "1.3",
+//	    Removed:    "1.4",
+//	})
+func (b *Builder) WithGroupVersionLifecycle(gv schema.GroupVersion, l GroupVersionLifecycle) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.groupVersionLifecycles == nil {
+		b.groupVersionLifecycles = map[schema.GroupVersion]GroupVersionLifecycle{}
+	}
+	b.groupVersionLifecycles[gv] = l
+
+	return b
+}
+
+// validate returns an error if any of the versions cannot be parsed.
+func (l GroupVersionLifecycle) validate() error {
+	for _, v := range []string{l.Introduced, l.Deprecated, l.Removed} {
+		if v == "" {
+			continue
+		}
+		if _, err := version.ParseGeneric(v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// served returns true if the group version is served at the emulation version.
+func (l GroupVersionLifecycle) served(emulationVersion *version.Version) bool {
+	return !atLeast(emulationVersion, l.Removed) && (l.Introduced == "" || atLeast(emulationVersion, l.Introduced))
+}
+
+// deprecated returns true if the group version is deprecated at the emulation version.
+func (l GroupVersionLifecycle) deprecated(emulationVersion *version.Version) bool {
+	return atLeast(emulationVersion, l.Deprecated)
+}
+
+// atLeast returns true if v is set and emulationVersion is at least v.
+func atLeast(emulationVersion *version.Version, v string) bool {
+	if v == "" || emulationVersion == nil {
+		return false
+	}
+
+	return emulationVersion.AtLeast(version.MustParseGeneric(v))
+}
+
+// removeUnservedVersions removes all group versions not served at the emulation version from apiGroupInfo.
+func (c *completedConfig) removeUnservedVersions(apiGroupInfo *genericapiserver.APIGroupInfo, emulationVersion *version.Version) {
+	apiGroupInfo.PrioritizedVersions = slices.DeleteFunc(slices.Clone(apiGroupInfo.PrioritizedVersions), func(gv schema.GroupVersion) bool {
+		l, ok := c.groupVersionLifecycles[gv]
+		if !ok || l.served(emulationVersion) {
+			return false
+		}
+		delete(apiGroupInfo.VersionedResourcesStorageMap, gv.Version)
+
+		return true
+	})
+}
+
+// deprecationWarnings returns the warnings of all group versions deprecated at the emulation version.
+func (c *completedConfig) deprecationWarnings(emulationVersion *version.Version) map[schema.GroupVersion]string {
+	warnings := map[schema.GroupVersion]string{}
+	for gv, l := range c.groupVersionLifecycles {
+		if !l.deprecated(emulationVersion) {
+			continue
+		}
+		msg := fmt.Sprintf("%s is deprecated in %s %s+", gv, c.componentName, l.Deprecated)
+		if l.Removed != "" {
+			msg += fmt.Sprintf(", unavailable in %s+", l.Removed)
+		}
+		warnings[gv] = msg
+	}
+
+	return warnings
+}
+
+// withDeprecationWarnings wraps the API handler passed to delegate to add a warning to all
+// requests of deprecated group versions.
+func withDeprecationWarnings(delegate func(http.Handler, *genericapiserver.Config) http.Handler, warnings map[schema.GroupVersion]string) func(http.Handler, *genericapiserver.Config) http.Handler {
+	if len(warnings) == 0 {
+		return delegate
+	}
+
+	return func(apiHandler http.Handler, c *genericapiserver.Config) http.Handler {
+		handler := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if info, ok := request.RequestInfoFrom(req.Context()); ok && info.IsResourceRequest {
+				if msg, ok := warnings[schema.GroupVersion{Group: info.APIGroup, Version: info.APIVersion}]; ok {
+					warning.AddWarning(req.Context(), "", msg)
+				}
+			}
+			apiHandler.ServeHTTP(w, req)
+		})
+
+		return delegate(handler, c)
+	}
+}
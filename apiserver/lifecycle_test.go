@@ -0,0 +1,94 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/apiserver/pkg/warning"
+
+	"go.opendefense.cloud/kit/apiserver/rest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// recordingWarnings collects the warnings added to a request.
+type recordingWarnings []string
+
+func (r *recordingWarnings) AddWarning(_, text string) {
+	*r = append(*r, text)
+}
+
+var _ = Describe("GroupVersionLifecycle", func() {
+	var (
+		v1alpha1 = schema.GroupVersion{Group: "test.opendefense.cloud", Version: "v1alpha1"}
+		v1       = schema.GroupVersion{Group: "test.opendefense.cloud", Version: "v1"}
+		c        *completedConfig
+	)
+
+	BeforeEach(func() {
+		c = &completedConfig{builderConfig: builderConfig{
+			componentName: "test",
+			groupVersionLifecycles: map[schema.GroupVersion]GroupVersionLifecycle{
+				v1alpha1: {Deprecated: "1.2", Removed: "1.3"},
+				v1:       {Introduced: "1.1"},
+			},
+		}}
+	})
+
+	DescribeTable("served group versions",
+		func(emulationVersion string, expected ...schema.GroupVersion) {
+			apiGroupInfo := &genericapiserver.APIGroupInfo{
+				PrioritizedVersions: []schema.GroupVersion{v1, v1alpha1},
+				VersionedResourcesStorageMap: map[string]map[string]rest.Storage{
+					v1.Version:       {},
+					v1alpha1.Version: {},
+				},
+			}
+			c.removeUnservedVersions(apiGroupInfo, version.MustParseGeneric(emulationVersion))
+			Expect(apiGroupInfo.PrioritizedVersions).To(ConsistOf(expected))
+			Expect(apiGroupInfo.VersionedResourcesStorageMap).To(HaveLen(len(expected)))
+		},
+		Entry("before introduction", "1.0", v1alpha1),
+		Entry("after introduction", "1.1", v1, v1alpha1),
+		Entry("deprecated", "1.2", v1, v1alpha1),
+		Entry("removed", "1.3", v1),
+	)
+
+	It("should only warn about deprecated group versions", func() {
+		Expect(c.deprecationWarnings(version.MustParseGeneric("1.1"))).To(BeEmpty())
+		Expect(c.deprecationWarnings(version.MustParseGeneric("1.2"))).To(Equal(map[schema.GroupVersion]string{
+			v1alpha1: "test.opendefense.cloud/v1alpha1 is deprecated in test 1.2+, unavailable in 1.3+",
+		}))
+	})
+
+	It("should add deprecation warnings to requests", func() {
+		chain := func(apiHandler http.Handler, _ *genericapiserver.Config) http.Handler { return apiHandler }
+		handler := withDeprecationWarnings(chain, map[schema.GroupVersion]string{v1alpha1: "deprecated"})(
+			http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}), nil)
+
+		serve := func(gv schema.GroupVersion) recordingWarnings {
+			warnings := recordingWarnings{}
+			ctx := warning.WithWarningRecorder(request.WithRequestInfo(GinkgoT().Context(), &request.RequestInfo{
+				IsResourceRequest: true, APIGroup: gv.Group, APIVersion: gv.Version,
+			}), &warnings)
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx))
+
+			return warnings
+		}
+		Expect(serve(v1alpha1)).To(ConsistOf("deprecated"))
+		Expect(serve(v1)).To(BeEmpty())
+	})
+
+	It("should reject invalid versions", func() {
+		Expect(GroupVersionLifecycle{Removed: "next"}.validate()).NotTo(Succeed())
+		Expect(GroupVersionLifecycle{Introduced: "1.0", Removed: "1.3"}.validate()).To(Succeed())
+	})
+})
@@ -0,0 +1,499 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package memstorage keeps the objects of kit API servers in memory instead of etcd. It is
+// intended to run servers locally, e.g. for demos and development, without etcd. All objects
+// are lost when the server stops.
+package memstorage
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/storagebackend"
+	"k8s.io/apiserver/pkg/storage/storagebackend/factory"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// historyLength is the number of events kept to resume watches from older resource
+	// versions. Watches from even older resource versions fail as expired, like after a
+	// compaction of etcd.
+	historyLength = 10000
+	// watchBufferLength is the number of events buffered per watch. Watches which fall behind
+	// further are closed and have to be resumed by the client.
+	watchBufferLength = 100
+)
+
+var versioner = storage.APIObjectVersioner{}
+
+// NewRESTOptionsGetter returns a RESTOptionsGetter keeping the objects of all resources in
+// memory. Like etcd, all resources share one resource version. Objects are encoded with codec
+// for dry-run requests and in encodeVersioner in discovery.
+func NewRESTOptionsGetter(codec runtime.Codec, encodeVersioner runtime.GroupVersioner) generic.RESTOptionsGetter {
+	return &restOptionsGetter{
+		store:           newStore(),
+		codec:           codec,
+		encodeVersioner: encodeVersioner,
+	}
+}
+
+type restOptionsGetter struct {
+	store           *store
+	codec           runtime.Codec
+	encodeVersioner runtime.GroupVersioner
+}
+
+func (g *restOptionsGetter) GetRESTOptions(gr schema.GroupResource, _ runtime.Object) (generic.RESTOptions, error) {
+	return generic.RESTOptions{
+		StorageConfig: &storagebackend.ConfigForResource{
+			Config:        storagebackend.Config{Codec: g.codec, EncodeVersioner: g.encodeVersioner},
+			GroupResource: gr,
+		},
+		Decorator:               g.decorate,
+		EnableGarbageCollection: true,
+		DeleteCollectionWorkers: 1,
+		ResourcePrefix:          gr.Group + "/" + gr.Resource,
+	}, nil
+}
+
+// decorate returns the storage of a resource, ignoring the etcd configuration. Field selectors
+// are matched by the predicates of the requests, so the indexers of the watch cache are not
+// needed.
+func (g *restOptionsGetter) decorate(
+	_ *storagebackend.ConfigForResource,
+	resourcePrefix string,
+	_ func(obj runtime.Object) (string, error),
+	newFunc func() runtime.Object,
+	_ func() runtime.Object,
+	_ storage.AttrFunc,
+	_ storage.IndexerFuncs,
+	_ *cache.Indexers) (storage.Interface, factory.DestroyFunc, error) {
+	return &resourceStorage{store: g.store, prefix: "/" + strings.Trim(resourcePrefix, "/") + "/", newFunc: newFunc}, func() {}, nil
+}
+
+// event is a change of an object.
+type event struct {
+	typ watch.EventType
+	key string
+	// obj is the object after the change. It is the deleted object with the resource version of
+	// the deletion for deletions.
+	obj runtime.Object
+	// prev is the object before the change, nil for additions.
+	prev runtime.Object
+	rev  uint64
+}
+
+// store keeps the objects of all resources.
+type store struct {
+	mu      sync.RWMutex
+	rev     uint64
+	objects map[string]runtime.Object
+	// history holds the last events, oldest first.
+	history []event
+	// compacted is the resource version of the last event dropped from history.
+	compacted uint64
+	watchers  map[*watcher]struct{}
+}
+
+func newStore() *store {
+	return &store{objects: map[string]runtime.Object{}, watchers: map[*watcher]struct{}{}}
+}
+
+// commit records e with the next resource version and notifies the watchers. The caller must
+// hold the write lock.
+func (s *store) commit(e event) {
+	if e.typ == watch.Deleted {
+		delete(s.objects, e.key)
+	} else {
+		s.objects[e.key] = e.obj
+	}
+	s.history = append(s.history, e)
+	if len(s.history) > historyLength {
+		s.compacted = s.history[0].rev
+		s.history = s.history[1:]
+	}
+	for w := range s.watchers {
+		select {
+		case w.incoming <- e:
+		default:
+			// Close watches falling behind instead of blocking all writes.
+			delete(s.watchers, w)
+			close(w.incoming)
+		}
+	}
+}
+
+// nextRevision returns a copy of obj with the next resource version. The caller must hold the
+// write lock and commit the change.
+func (s *store) nextRevision(obj runtime.Object) (runtime.Object, uint64, error) {
+	obj = obj.DeepCopyObject()
+	rev := s.rev + 1
+	if err := versioner.UpdateObject(obj, rev); err != nil {
+		return nil, 0, err
+	}
+	s.rev = rev
+
+	return obj, rev, nil
+}
+
+// checkResourceVersion returns an error if rv is invalid or newer than the current resource
+// version. The caller must hold the lock.
+func (s *store) checkResourceVersion(rv string) (uint64, error) {
+	if rv == "" {
+		return 0, nil
+	}
+	v, err := versioner.ParseResourceVersion(rv)
+	if err != nil {
+		return 0, apierrors.NewBadRequest(fmt.Sprintf("invalid resource version: %v", err))
+	}
+	if v > s.rev {
+		return 0, storage.NewTooLargeResourceVersionError(v, s.rev, 1)
+	}
+
+	return v, nil
+}
+
+// sortedKeys returns the sorted keys of all objects matching key. The caller must hold the lock.
+func (s *store) sortedKeys(key string, recursive bool) []string {
+	var keys []string
+	for k := range s.objects {
+		if matchesKey(k, key, recursive) {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+func matchesKey(k, key string, recursive bool) bool {
+	if recursive {
+		return strings.HasPrefix(k, key)
+	}
+
+	return k == key
+}
+
+// resourceStorage is the storage.Interface of a resource.
+type resourceStorage struct {
+	store   *store
+	prefix  string
+	newFunc func() runtime.Object
+}
+
+var _ storage.Interface = &resourceStorage{}
+
+func (r *resourceStorage) Versioner() storage.Versioner { return versioner }
+
+func (r *resourceStorage) Create(_ context.Context, key string, obj, out runtime.Object, _ uint64) error {
+	if rv, err := versioner.ObjectResourceVersion(obj); err != nil || rv != 0 {
+		return storage.ErrResourceVersionSetOnCreate
+	}
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.objects[key]; ok {
+		return storage.NewKeyExistsError(key, 0)
+	}
+	stored, rev, err := s.nextRevision(obj)
+	if err != nil {
+		return err
+	}
+	s.commit(event{typ: watch.Added, key: key, obj: stored, rev: rev})
+
+	return copyInto(stored, out)
+}
+
+func (r *resourceStorage) Delete(ctx context.Context, key string, out runtime.Object, preconditions *storage.Preconditions,
+	validateDeletion storage.ValidateObjectFunc, _ runtime.Object, _ storage.DeleteOptions) error {
+	s := r.store
+	for {
+		s.mu.RLock()
+		current, ok := s.objects[key]
+		s.mu.RUnlock()
+		if !ok {
+			return storage.NewKeyNotFoundError(key, 0)
+		}
+		existing := current.DeepCopyObject()
+		if preconditions != nil {
+			if err := preconditions.Check(key, existing); err != nil {
+				return err
+			}
+		}
+		if err := validateDeletion(ctx, existing); err != nil {
+			return err
+		}
+
+		s.mu.Lock()
+		if s.objects[key] != current {
+			// The object changed meanwhile, check it again.
+			s.mu.Unlock()
+			continue
+		}
+		deleted, rev, err := s.nextRevision(current)
+		if err != nil {
+			s.mu.Unlock()
+			return err
+		}
+		s.commit(event{typ: watch.Deleted, key: key, obj: deleted, prev: current, rev: rev})
+		s.mu.Unlock()
+
+		return copyInto(deleted, out)
+	}
+}
+
+func (r *resourceStorage) Watch(ctx context.Context, key string, opts storage.ListOptions) (watch.Interface, error) {
+	if opts.Recursive && !strings.HasSuffix(key, "/") {
+		key += "/"
+	}
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	from, err := s.checkResourceVersion(opts.ResourceVersion)
+	if err != nil {
+		return nil, err
+	}
+	w := newWatcher(ctx, key, opts.Recursive, opts.Predicate)
+	// Watches without a resource version start with the current objects, unless disabled.
+	sendInitialEvents := from == 0
+	if opts.SendInitialEvents != nil {
+		sendInitialEvents = *opts.SendInitialEvents
+	}
+	var initial []event
+	switch {
+	case sendInitialEvents:
+		for _, k := range s.sortedKeys(key, opts.Recursive) {
+			obj := s.objects[k]
+			rev, _ := versioner.ObjectResourceVersion(obj)
+			initial = append(initial, event{typ: watch.Added, key: k, obj: obj, rev: rev})
+		}
+		if opts.SendInitialEvents != nil && opts.Predicate.AllowWatchBookmarks {
+			w.initialEventsEnd = r.initialEventsEnd(s.rev)
+		}
+	case from > 0:
+		if from < s.compacted {
+			return nil, apierrors.NewResourceExpired(fmt.Sprintf("too old resource version: %d (%d)", from, s.compacted))
+		}
+		for _, e := range s.history {
+			if e.rev > from {
+				initial = append(initial, e)
+			}
+		}
+	}
+	s.watchers[w] = struct{}{}
+	go w.run(s, initial)
+
+	return w, nil
+}
+
+// initialEventsEnd returns the bookmark sent after the initial events of watches requesting
+// them.
+func (r *resourceStorage) initialEventsEnd(rev uint64) runtime.Object {
+	obj := r.newFunc()
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return nil
+	}
+	m.SetResourceVersion(fmt.Sprint(rev))
+	m.SetAnnotations(map[string]string{metav1.InitialEventsAnnotationKey: "true"})
+
+	return obj
+}
+
+func (r *resourceStorage) Get(_ context.Context, key string, opts storage.GetOptions, objPtr runtime.Object) error {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if _, err := s.checkResourceVersion(opts.ResourceVersion); err != nil {
+		return err
+	}
+	obj, ok := s.objects[key]
+	if !ok {
+		if opts.IgnoreNotFound {
+			return runtime.SetZeroValue(objPtr)
+		}
+
+		return storage.NewKeyNotFoundError(key, 0)
+	}
+
+	return copyInto(obj, objPtr)
+}
+
+func (r *resourceStorage) GetList(_ context.Context, key string, opts storage.ListOptions, listObj runtime.Object) error {
+	if opts.Recursive && !strings.HasSuffix(key, "/") {
+		key += "/"
+	}
+	pred := opts.Predicate
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if _, err := s.checkResourceVersion(opts.ResourceVersion); err != nil {
+		return err
+	}
+	// Continued lists are served from the current objects, but with the resource version of the
+	// first page, so watches from it replay the changes in between.
+	from, rev := key, s.rev
+	if pred.Continue != "" {
+		if !opts.Recursive {
+			return apierrors.NewBadRequest("continue is not supported for non-recursive lists")
+		}
+		continueKey, continueRV, err := storage.DecodeContinue(pred.Continue, key)
+		if err != nil {
+			return apierrors.NewBadRequest(fmt.Sprintf("invalid continue token: %v", err))
+		}
+		from, rev = continueKey, uint64(continueRV) //nolint:gosec // resource versions are positive
+	}
+	items := []runtime.Object{}
+	var last string
+	more := false
+	for _, k := range s.sortedKeys(key, opts.Recursive) {
+		if k < from {
+			continue
+		}
+		obj := s.objects[k]
+		if ok, err := pred.Matches(obj); err != nil {
+			return err
+		} else if !ok {
+			continue
+		}
+		if pred.Limit > 0 && int64(len(items)) == pred.Limit {
+			more = true
+			break
+		}
+		items = append(items, obj.DeepCopyObject())
+		last = k
+	}
+	var continueValue string
+	if more {
+		var err error
+		continueValue, err = storage.EncodeContinue(last+"\x00", key, int64(rev)) //nolint:gosec // resource versions are small
+		if err != nil {
+			return err
+		}
+	}
+	if err := meta.SetList(listObj, items); err != nil {
+		return err
+	}
+
+	return versioner.UpdateList(listObj, rev, continueValue, nil)
+}
+
+func (r *resourceStorage) GuaranteedUpdate(ctx context.Context, key string, destination runtime.Object, ignoreNotFound bool,
+	preconditions *storage.Preconditions, tryUpdate storage.UpdateFunc, _ runtime.Object) error {
+	s := r.store
+	for {
+		s.mu.RLock()
+		current, exists := s.objects[key]
+		s.mu.RUnlock()
+		var existing runtime.Object
+		var rv uint64
+		if exists {
+			existing = current.DeepCopyObject()
+			rv, _ = versioner.ObjectResourceVersion(current)
+		} else {
+			if !ignoreNotFound {
+				return storage.NewKeyNotFoundError(key, 0)
+			}
+			existing = r.newFunc()
+		}
+		if preconditions != nil {
+			if err := preconditions.Check(key, existing); err != nil {
+				return err
+			}
+		}
+		updated, _, err := tryUpdate(existing, storage.ResponseMeta{ResourceVersion: rv})
+		if err != nil {
+			return err
+		}
+		if err := versioner.PrepareObjectForStorage(updated); err != nil {
+			return err
+		}
+
+		s.mu.Lock()
+		if s.objects[key] != current {
+			// The object changed meanwhile, update it again.
+			s.mu.Unlock()
+			continue
+		}
+		if exists && unchanged(current, updated) {
+			s.mu.Unlock()
+			return copyInto(current, destination)
+		}
+		stored, rev, err := s.nextRevision(updated)
+		if err != nil {
+			s.mu.Unlock()
+			return err
+		}
+		e := event{typ: watch.Modified, key: key, obj: stored, prev: current, rev: rev}
+		if !exists {
+			e.typ, e.prev = watch.Added, nil
+		}
+		s.commit(e)
+		s.mu.Unlock()
+
+		return copyInto(stored, destination)
+	}
+}
+
+// unchanged returns true if updated equals current but for the resource version.
+func unchanged(current, updated runtime.Object) bool {
+	current = current.DeepCopyObject()
+	if err := versioner.PrepareObjectForStorage(current); err != nil {
+		return false
+	}
+
+	return apiequality.Semantic.DeepEqual(current, updated)
+}
+
+func (r *resourceStorage) Stats(context.Context) (storage.Stats, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return storage.Stats{ObjectCount: int64(len(s.sortedKeys(r.prefix, true)))}, nil
+}
+
+func (r *resourceStorage) ReadinessCheck() error { return nil }
+
+func (r *resourceStorage) RequestWatchProgress(context.Context) error { return nil }
+
+func (r *resourceStorage) GetCurrentResourceVersion(context.Context) (uint64, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return r.store.rev, nil
+}
+
+func (r *resourceStorage) EnableResourceSizeEstimation(storage.KeysFunc) error { return nil }
+
+func (r *resourceStorage) CompactRevision() int64 {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return int64(r.store.compacted) //nolint:gosec // resource versions are small
+}
+
+// copyInto sets out, a pointer to an object of the same type as in, to a copy of in.
+func copyInto(in, out runtime.Object) error {
+	src, dst := reflect.ValueOf(in.DeepCopyObject()), reflect.ValueOf(out)
+	if dst.Kind() != reflect.Pointer || src.Type() != dst.Type() {
+		return fmt.Errorf("unexpected object of type %T, expected %T", out, in)
+	}
+	dst.Elem().Set(src.Elem())
+
+	return nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package memstorage
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/storage"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("resourceStorage", func() {
+	var (
+		ctx = context.Background()
+		s   storage.Interface
+	)
+
+	configMap := func(name string, labels map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns", Labels: labels}}
+	}
+	update := func(key string, fn func(*corev1.ConfigMap)) *corev1.ConfigMap {
+		out := &corev1.ConfigMap{}
+		Expect(s.GuaranteedUpdate(ctx, key, out, false, nil, func(in runtime.Object, _ storage.ResponseMeta) (runtime.Object, *uint64, error) {
+			cm := in.(*corev1.ConfigMap)
+			fn(cm)
+
+			return cm, nil, nil
+		}, nil)).To(Succeed())
+
+		return out
+	}
+	labelPredicate := func(selector string) storage.SelectionPredicate {
+		return storage.SelectionPredicate{
+			Label: labels.SelectorFromSet(labels.Set{"app": selector}),
+			Field: fields.Everything(),
+			GetAttrs: func(obj runtime.Object) (labels.Set, fields.Set, error) {
+				return obj.(*corev1.ConfigMap).Labels, nil, nil
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		getter := NewRESTOptionsGetter(nil, nil)
+		opts, err := getter.GetRESTOptions(schema.GroupResource{Resource: "configmaps"}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		s, _, err = opts.Decorator(opts.StorageConfig, opts.ResourcePrefix, nil,
+			func() runtime.Object { return &corev1.ConfigMap{} }, func() runtime.Object { return &corev1.ConfigMapList{} }, nil, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should create, update, get and delete objects", func() {
+		created := &corev1.ConfigMap{}
+		Expect(s.Create(ctx, "/configmaps/ns/a", configMap("a", nil), created, 0)).To(Succeed())
+		Expect(created.ResourceVersion).To(Equal("1"))
+		err := s.Create(ctx, "/configmaps/ns/a", configMap("a", nil), &corev1.ConfigMap{}, 0)
+		Expect(storage.IsExist(err)).To(BeTrue())
+
+		updated := update("/configmaps/ns/a", func(cm *corev1.ConfigMap) { cm.Data = map[string]string{"k": "v"} })
+		Expect(updated.ResourceVersion).To(Equal("2"))
+		// Updates without changes don't increment the resource version.
+		Expect(update("/configmaps/ns/a", func(*corev1.ConfigMap) {}).ResourceVersion).To(Equal("2"))
+
+		got := &corev1.ConfigMap{}
+		Expect(s.Get(ctx, "/configmaps/ns/a", storage.GetOptions{}, got)).To(Succeed())
+		Expect(got.Data).To(HaveKeyWithValue("k", "v"))
+		err = s.Get(ctx, "/configmaps/ns/a", storage.GetOptions{ResourceVersion: "5"}, got)
+		Expect(storage.IsTooLargeResourceVersion(err)).To(BeTrue())
+
+		deleted := &corev1.ConfigMap{}
+		Expect(s.Delete(ctx, "/configmaps/ns/a", deleted, nil, storage.ValidateAllObjectFunc, nil, storage.DeleteOptions{})).To(Succeed())
+		Expect(deleted.ResourceVersion).To(Equal("3"))
+		err = s.Get(ctx, "/configmaps/ns/a", storage.GetOptions{}, got)
+		Expect(storage.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should check preconditions", func() {
+		Expect(s.Create(ctx, "/configmaps/ns/a", configMap("a", nil), &corev1.ConfigMap{}, 0)).To(Succeed())
+		err := s.Delete(ctx, "/configmaps/ns/a", &corev1.ConfigMap{}, storage.NewUIDPreconditions("other"), storage.ValidateAllObjectFunc, nil, storage.DeleteOptions{})
+		Expect(storage.IsInvalidObj(err)).To(BeTrue())
+	})
+
+	It("should list objects in pages", func() {
+		for _, name := range []string{"c", "a", "b"} {
+			Expect(s.Create(ctx, "/configmaps/ns/"+name, configMap(name, map[string]string{"app": "foo"}), &corev1.ConfigMap{}, 0)).To(Succeed())
+		}
+		Expect(s.Create(ctx, "/configmaps/other/d", configMap("d", nil), &corev1.ConfigMap{}, 0)).To(Succeed())
+
+		pred := labelPredicate("foo")
+		pred.Limit = 2
+		list := &corev1.ConfigMapList{}
+		Expect(s.GetList(ctx, "/configmaps/ns", storage.ListOptions{Recursive: true, Predicate: pred}, list)).To(Succeed())
+		Expect(list.Items).To(HaveLen(2))
+		Expect(list.Items[0].Name).To(Equal("a"))
+		Expect(list.Items[1].Name).To(Equal("b"))
+		Expect(list.ResourceVersion).To(Equal("4"))
+		Expect(list.Continue).NotTo(BeEmpty())
+
+		pred.Continue = list.Continue
+		Expect(s.GetList(ctx, "/configmaps/ns", storage.ListOptions{Recursive: true, Predicate: pred}, list)).To(Succeed())
+		Expect(list.Items).To(HaveLen(1))
+		Expect(list.Items[0].Name).To(Equal("c"))
+		Expect(list.Continue).To(BeEmpty())
+
+		stats, err := s.Stats(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stats.ObjectCount).To(Equal(int64(4)))
+	})
+
+	It("should watch objects matching the predicate", func() {
+		Expect(s.Create(ctx, "/configmaps/ns/a", configMap("a", map[string]string{"app": "foo"}), &corev1.ConfigMap{}, 0)).To(Succeed())
+		w, err := s.Watch(ctx, "/configmaps/ns", storage.ListOptions{Recursive: true, ResourceVersion: "0", Predicate: labelPredicate("foo")})
+		Expect(err).NotTo(HaveOccurred())
+		defer w.Stop()
+
+		Eventually(w.ResultChan()).Should(Receive(HaveField("Type", watch.Added)))
+		update("/configmaps/ns/a", func(cm *corev1.ConfigMap) { cm.Data = map[string]string{"k": "v"} })
+		Eventually(w.ResultChan()).Should(Receive(HaveField("Type", watch.Modified)))
+		// Objects no longer matching the predicate are deleted from the watch.
+		update("/configmaps/ns/a", func(cm *corev1.ConfigMap) { cm.Labels = nil })
+		Eventually(w.ResultChan()).Should(Receive(HaveField("Type", watch.Deleted)))
+	})
+
+	It("should resume watches from a resource version", func() {
+		Expect(s.Create(ctx, "/configmaps/ns/a", configMap("a", nil), &corev1.ConfigMap{}, 0)).To(Succeed())
+		Expect(s.Create(ctx, "/configmaps/ns/b", configMap("b", nil), &corev1.ConfigMap{}, 0)).To(Succeed())
+		w, err := s.Watch(ctx, "/configmaps/ns", storage.ListOptions{Recursive: true, ResourceVersion: "1", Predicate: storage.Everything})
+		Expect(err).NotTo(HaveOccurred())
+		defer w.Stop()
+
+		var ev watch.Event
+		Eventually(w.ResultChan()).Should(Receive(&ev))
+		Expect(ev.Type).To(Equal(watch.Added))
+		Expect(ev.Object.(*corev1.ConfigMap).Name).To(Equal("b"))
+		Consistently(w.ResultChan()).ShouldNot(Receive())
+	})
+
+	It("should end the initial events with a bookmark if requested", func() {
+		Expect(s.Create(ctx, "/configmaps/ns/a", configMap("a", nil), &corev1.ConfigMap{}, 0)).To(Succeed())
+		sendInitialEvents := true
+		pred := storage.Everything
+		pred.AllowWatchBookmarks = true
+		w, err := s.Watch(ctx, "/configmaps/ns", storage.ListOptions{Recursive: true, SendInitialEvents: &sendInitialEvents, Predicate: pred})
+		Expect(err).NotTo(HaveOccurred())
+		defer w.Stop()
+
+		Eventually(w.ResultChan()).Should(Receive(HaveField("Type", watch.Added)))
+		var ev watch.Event
+		Eventually(w.ResultChan()).Should(Receive(&ev))
+		Expect(ev.Type).To(Equal(watch.Bookmark))
+		Expect(ev.Object.(*corev1.ConfigMap).Annotations).To(HaveKeyWithValue(metav1.InitialEventsAnnotationKey, "true"))
+	})
+})
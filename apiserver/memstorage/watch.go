@@ -0,0 +1,125 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package memstorage
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/storage"
+)
+
+// watcher sends the events of the objects matching a key and a predicate.
+type watcher struct {
+	key       string
+	recursive bool
+	pred      storage.SelectionPredicate
+	// initialEventsEnd is the bookmark sent after the initial events, if requested.
+	initialEventsEnd runtime.Object
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	incoming chan event
+	result   chan watch.Event
+}
+
+var _ watch.Interface = &watcher{}
+
+func newWatcher(ctx context.Context, key string, recursive bool, pred storage.SelectionPredicate) *watcher {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &watcher{
+		key:       key,
+		recursive: recursive,
+		pred:      pred,
+		ctx:       ctx,
+		cancel:    cancel,
+		incoming:  make(chan event, watchBufferLength),
+		result:    make(chan watch.Event),
+	}
+}
+
+func (w *watcher) Stop() { w.cancel() }
+
+func (w *watcher) ResultChan() <-chan watch.Event { return w.result }
+
+// run sends the initial events, then the incoming ones until the watch is stopped or falls
+// behind.
+func (w *watcher) run(s *store, initial []event) {
+	defer close(w.result)
+	defer func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if _, ok := s.watchers[w]; ok {
+			delete(s.watchers, w)
+			close(w.incoming)
+		}
+	}()
+	for _, e := range initial {
+		if !w.send(e) {
+			return
+		}
+	}
+	if w.initialEventsEnd != nil && !w.sendEvent(watch.Event{Type: watch.Bookmark, Object: w.initialEventsEnd}) {
+		return
+	}
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case e, ok := <-w.incoming:
+			if !ok || !w.send(e) {
+				return
+			}
+		}
+	}
+}
+
+// send sends e if it concerns an object matching the watch. Objects which start or stop
+// matching the predicate are sent as added or deleted. It returns false if the watch is
+// stopped.
+func (w *watcher) send(e event) bool {
+	if !matchesKey(e.key, w.key, w.recursive) {
+		return true
+	}
+	prev := e.prev != nil && w.matches(e.prev)
+	var ev watch.Event
+	switch cur := e.typ != watch.Deleted && w.matches(e.obj); {
+	case e.typ == watch.Deleted && prev:
+		ev = watch.Event{Type: watch.Deleted, Object: e.obj.DeepCopyObject()}
+	case cur && prev:
+		ev = watch.Event{Type: watch.Modified, Object: e.obj.DeepCopyObject()}
+	case cur:
+		ev = watch.Event{Type: watch.Added, Object: e.obj.DeepCopyObject()}
+	case prev:
+		obj := e.prev.DeepCopyObject()
+		if err := versioner.UpdateObject(obj, e.rev); err != nil {
+			return true
+		}
+		ev = watch.Event{Type: watch.Deleted, Object: obj}
+	default:
+		return true
+	}
+
+	return w.sendEvent(ev)
+}
+
+func (w *watcher) sendEvent(ev watch.Event) bool {
+	select {
+	case w.result <- ev:
+		return true
+	case <-w.ctx.Done():
+		return false
+	}
+}
+
+func (w *watcher) matches(obj runtime.Object) bool {
+	if w.pred.Empty() {
+		return true
+	}
+	ok, err := w.pred.Matches(obj)
+
+	return err == nil && ok
+}
@@ -0,0 +1,218 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package mirror sends copies of sampled read requests to a secondary server, e.g. a new version
+// of the server validated before a migration, and compares the status codes of its responses
+// with the ones of the server. The responses of the secondary server are discarded.
+package mirror
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/endpoints/responsewriter"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/client-go/transport"
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+)
+
+const (
+	// DefaultTimeout is the timeout of mirrored requests if no client is configured.
+	DefaultTimeout = 10 * time.Second
+	// DefaultMaxInFlight is the default limit of concurrently mirrored requests.
+	DefaultMaxInFlight = 10
+
+	// codeError is reported as code of the secondary server if the request failed.
+	codeError = "error"
+	// codeDropped is reported as code of the secondary server if the request has been dropped,
+	// because MaxInFlight requests are in flight.
+	codeDropped = "dropped"
+)
+
+var (
+	mirroredRequests = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      "kit",
+			Subsystem:      "mirror",
+			Name:           "requests_total",
+			Help:           "Number of mirrored requests, partitioned by resource, verb and the status codes of the server and the secondary server.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"group", "resource", "verb", "code", "mirror_code"},
+	)
+
+	registerMetricsOnce sync.Once
+)
+
+// RegisterMetrics registers the metrics of this package with the legacy registry,
+// which is served on /metrics. It is safe to call multiple times.
+func RegisterMetrics() {
+	registerMetricsOnce.Do(func() {
+		legacyregistry.MustRegister(mirroredRequests)
+	})
+}
+
+// Config configures request mirroring.
+type Config struct {
+	// URL is the base URL of the secondary server, e.g. https://foo-apiserver-next.foo.svc.
+	URL string
+	// SampleRate is the fraction of get and list requests mirrored, between 0 and 1. Watches
+	// and writes are never mirrored.
+	SampleRate float64
+	// Client sends the mirrored requests with its credentials. Defaults to a client with
+	// DefaultTimeout, which should only be used in tests.
+	Client *http.Client
+	// Impersonate sends mirrored requests as the user of the request, which requires the
+	// credentials of Client to be allowed to impersonate users and groups.
+	Impersonate bool
+	// MaxInFlight limits the concurrently mirrored requests, further requests are dropped.
+	// Defaults to DefaultMaxInFlight.
+	MaxInFlight int
+}
+
+// Validate returns an error if the URL or the sample rate are invalid.
+func (c Config) Validate() error {
+	u, err := url.Parse(c.URL)
+	if err != nil {
+		return fmt.Errorf("invalid URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("invalid URL %q: an absolute http or https URL is required", c.URL)
+	}
+	if c.SampleRate < 0 || c.SampleRate > 1 {
+		return fmt.Errorf("sample rate %v is not between 0 and 1", c.SampleRate)
+	}
+	if c.MaxInFlight < 0 {
+		return fmt.Errorf("max in flight %d must not be negative", c.MaxInFlight)
+	}
+
+	return nil
+}
+
+// BuildHandlerChainFunc returns a handler chain builder that mirrors requests after they have been
+// authenticated and authorized by the chain built by delegate.
+func BuildHandlerChainFunc(c Config, delegate func(http.Handler, *genericapiserver.Config) http.Handler) func(http.Handler, *genericapiserver.Config) http.Handler {
+	return func(apiHandler http.Handler, config *genericapiserver.Config) http.Handler {
+		return delegate(WithMirror(apiHandler, c), config)
+	}
+}
+
+// WithMirror mirrors sampled get and list requests served by handler to the secondary server once
+// handler responded, so the latency of requests is not affected. It requires the request info of
+// the request, which is set by the generic handler chain.
+func WithMirror(handler http.Handler, c Config) http.Handler {
+	if c.Client == nil {
+		c.Client = &http.Client{Timeout: DefaultTimeout}
+	}
+	if c.MaxInFlight == 0 {
+		c.MaxInFlight = DefaultMaxInFlight
+	}
+	inFlight := make(chan struct{}, c.MaxInFlight)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		info, ok := request.RequestInfoFrom(req.Context())
+		if !ok || !info.IsResourceRequest || (info.Verb != "get" && info.Verb != "list") ||
+			rand.Float64() >= c.SampleRate { //nolint:gosec // sampling only
+			handler.ServeHTTP(w, req)
+			return
+		}
+		rw := &statusRecorder{ResponseWriter: w, code: http.StatusOK}
+		handler.ServeHTTP(responsewriter.WrapForHTTP1Or2(rw), req)
+
+		count := func(mirrorCode string) {
+			mirroredRequests.WithLabelValues(info.APIGroup, info.Resource, info.Verb, strconv.Itoa(rw.code), mirrorCode).Inc()
+		}
+		select {
+		case inFlight <- struct{}{}:
+		default:
+			count(codeDropped)
+			return
+		}
+		mirrored, err := newRequest(req, c)
+		if err != nil {
+			<-inFlight
+			count(codeError)
+
+			return
+		}
+		go func() {
+			defer func() { <-inFlight }()
+			count(send(c.Client, mirrored))
+		}()
+	})
+}
+
+// newRequest returns a copy of req to the secondary server.
+func newRequest(req *http.Request, c Config) (*http.Request, error) {
+	target, err := url.JoinPath(c.URL, req.URL.Path)
+	if err != nil {
+		return nil, err
+	}
+	if req.URL.RawQuery != "" {
+		target += "?" + req.URL.RawQuery
+	}
+	// The request must not be cancelled with the request of the client, which is done already.
+	mirrored, err := http.NewRequestWithContext(context.WithoutCancel(req.Context()), req.Method, target, nil)
+	if err != nil {
+		return nil, err
+	}
+	mirrored.Header.Set("Accept", req.Header.Get("Accept"))
+	if u, ok := request.UserFrom(req.Context()); ok && c.Impersonate {
+		mirrored.Header.Set(transport.ImpersonateUserHeader, u.GetName())
+		if uid := u.GetUID(); uid != "" {
+			mirrored.Header.Set(transport.ImpersonateUIDHeader, uid)
+		}
+		for _, group := range u.GetGroups() {
+			mirrored.Header.Add(transport.ImpersonateGroupHeader, group)
+		}
+	}
+
+	return mirrored, nil
+}
+
+// send sends req and returns the status code of the response, discarding its body.
+func send(client *http.Client, req *http.Request) string {
+	resp, err := client.Do(req)
+	if err != nil {
+		return codeError
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	return strconv.Itoa(resp.StatusCode)
+}
+
+// statusRecorder records the status code written to the response.
+type statusRecorder struct {
+	http.ResponseWriter
+	code        int
+	wroteHeader bool
+}
+
+var _ responsewriter.UserProvidedDecorator = &statusRecorder{}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	if !r.wroteHeader {
+		r.code = code
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	return r.ResponseWriter.Write(b)
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package mirror
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/component-base/metrics/testutil"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WithMirror", func() {
+	var (
+		secondary *httptest.Server
+		mirrored  chan *http.Request
+		resolver  = &request.RequestInfoFactory{
+			APIPrefixes:          sets.NewString("apis"),
+			GrouplessAPIPrefixes: sets.NewString(),
+		}
+	)
+
+	BeforeEach(func() {
+		RegisterMetrics()
+		mirroredRequests.Reset()
+		mirrored = make(chan *http.Request, 10)
+		secondary = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			mirrored <- req
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		DeferCleanup(secondary.Close)
+	})
+
+	// serve emulates the generic handler chain, which sets the request info and user.
+	serve := func(c Config, method, target string) int {
+		h := WithMirror(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}), c)
+		req := httptest.NewRequest(method, target, nil)
+		info, err := resolver.NewRequestInfo(req)
+		Expect(err).NotTo(HaveOccurred())
+		ctx := request.WithRequestInfo(req.Context(), info)
+		ctx = request.WithUser(ctx, &user.DefaultInfo{Name: "alice", UID: "1", Groups: []string{"devs", "ops"}})
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req.WithContext(ctx))
+
+		return rec.Code
+	}
+
+	requests := func(verb, code, mirrorCode string) float64 {
+		GinkgoHelper()
+		v, err := testutil.GetCounterMetricValue(mirroredRequests.WithLabelValues("foo.opendefense.cloud", "bars", verb, code, mirrorCode))
+		Expect(err).NotTo(HaveOccurred())
+
+		return v
+	}
+
+	It("should mirror sampled reads and compare the status codes", func() {
+		c := Config{URL: secondary.URL + "/prefix", SampleRate: 1, Impersonate: true}
+		Expect(serve(c, http.MethodGet, "/apis/foo.opendefense.cloud/v1alpha1/namespaces/ns/bars?limit=5")).To(Equal(http.StatusOK))
+
+		var req *http.Request
+		Eventually(mirrored).Should(Receive(&req))
+		Expect(req.Method).To(Equal(http.MethodGet))
+		Expect(req.URL.Path).To(Equal("/prefix/apis/foo.opendefense.cloud/v1alpha1/namespaces/ns/bars"))
+		Expect(req.URL.RawQuery).To(Equal("limit=5"))
+		Expect(req.Header.Get("Impersonate-User")).To(Equal("alice"))
+		Expect(req.Header.Get("Impersonate-Uid")).To(Equal("1"))
+		Expect(req.Header.Values("Impersonate-Group")).To(Equal([]string{"devs", "ops"}))
+		Eventually(func() float64 { return requests("list", "200", "404") }).Should(Equal(1.0))
+	})
+
+	It("should not impersonate users by default", func() {
+		serve(Config{URL: secondary.URL, SampleRate: 1}, http.MethodGet, "/apis/foo.opendefense.cloud/v1alpha1/namespaces/ns/bars/b1")
+
+		var req *http.Request
+		Eventually(mirrored).Should(Receive(&req))
+		Expect(req.Header.Get("Impersonate-User")).To(BeEmpty())
+	})
+
+	It("should not mirror writes, watches or unsampled requests", func() {
+		c := Config{URL: secondary.URL, SampleRate: 1}
+		serve(c, http.MethodPost, "/apis/foo.opendefense.cloud/v1alpha1/namespaces/ns/bars")
+		serve(c, http.MethodGet, "/apis/foo.opendefense.cloud/v1alpha1/namespaces/ns/bars?watch=true")
+		serve(c, http.MethodGet, "/healthz")
+		serve(Config{URL: secondary.URL}, http.MethodGet, "/apis/foo.opendefense.cloud/v1alpha1/namespaces/ns/bars")
+
+		Consistently(mirrored).ShouldNot(Receive())
+	})
+
+	It("should count errors of the secondary server", func() {
+		secondary.Close()
+		serve(Config{URL: secondary.URL, SampleRate: 1}, http.MethodGet, "/apis/foo.opendefense.cloud/v1alpha1/namespaces/ns/bars/b1")
+
+		Eventually(func() float64 { return requests("get", "200", "error") }).Should(Equal(1.0))
+	})
+})
+
+var _ = Describe("Config", func() {
+	DescribeTable("Validate",
+		func(c Config, valid bool) {
+			if valid {
+				Expect(c.Validate()).To(Succeed())
+			} else {
+				Expect(c.Validate()).NotTo(Succeed())
+			}
+		},
+		Entry("valid", Config{URL: "https://foo-apiserver-next.foo.svc", SampleRate: 0.1}, true),
+		Entry("relative URL", Config{URL: "/apis"}, false),
+		Entry("unsupported scheme", Config{URL: "ftp://foo"}, false),
+		Entry("sample rate above 1", Config{URL: "https://foo", SampleRate: 2}, false),
+		Entry("negative max in flight", Config{URL: "https://foo", MaxInFlight: -1}, false),
+	)
+})
@@ -0,0 +1,185 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/admission/plugin/namespace/lifecycle"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+
+	"go.opendefense.cloud/kit/apiserver/profile"
+)
+
+// Module bundles the resources, admission plugins, informers and post-start hooks of a feature
+// area, so large servers are composed of modules installed with Builder.WithModule:
+//
+//	var Inventory = apiserver.Module{
+//	    Name: "inventory",
+//	    Resources: []apiserver.ResourceHandler{
+//	        apiserver.Resource(&v1alpha1.Host{}, v1alpha1.SchemeGroupVersion),
+//	        apiserver.Resource(&v1alpha1.Rack{}, v1alpha1.SchemeGroupVersion),
+//	    },
+//	    PostStartHooks: map[string]server.PostStartHookFunc{"inventory-sync": runSync},
+//	}
+type Module struct {
+	// Name identifies the module in errors.
+	Name string
+	// Resources are registered with Builder.With.
+	Resources []ResourceHandler
+	// GroupVersions are served in addition to the group versions of the resources, e.g. for
+	// the API groups installed by APIGroupFns.
+	GroupVersions []schema.GroupVersion
+	// APIGroupFns are registered with Builder.WithAPIGroupFn.
+	APIGroupFns []APIGroupFn
+	// AdmissionPlugins are registered and enabled in order, see AdmissionPlugin.
+	AdmissionPlugins []AdmissionPlugin
+	// SharedInformerFactories are started with the server.
+	SharedInformerFactories []SharedInformerFactory
+	// PostStartHooks are run once the server has started, by their unique names.
+	PostStartHooks map[string]genericapiserver.PostStartHookFunc
+	// Flags are added to the command of the server.
+	Flags []AddFlagsFn
+}
+
+// AdmissionPlugin is an admission plugin of a Module. Plugins run after the namespace lifecycle
+// plugin and the defaulting profiles, and before admission policies and webhooks. They can be
+// disabled with --disable-admission-plugins.
+type AdmissionPlugin struct {
+	Name    string
+	Factory admission.Factory
+}
+
+// WithModule installs the resources, admission plugins, informers, post-start hooks and flags
+// of m.
+func (b *Builder) WithModule(m Module) *Builder {
+	for _, rh := range m.Resources {
+		b.With(rh)
+	}
+	b.WithGroupVersions(m.GroupVersions...)
+	for _, fn := range m.APIGroupFns {
+		b.WithAPIGroupFn(fn)
+	}
+	for _, f := range m.SharedInformerFactories {
+		b.WithSharedInformerFactory(f)
+	}
+	names := make([]string, 0, len(m.PostStartHooks))
+	for name := range m.PostStartHooks {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		b.WithPostStartHook(name, m.PostStartHooks[name])
+	}
+	b.WithFlags(m.Flags...)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, p := range m.AdmissionPlugins {
+		b.admissionPlugins = append(b.admissionPlugins, modulePlugin{module: m.Name, AdmissionPlugin: p})
+	}
+
+	return b
+}
+
+var (
+	// modulesMu guards modules.
+	modulesMu sync.Mutex
+	// modules are the modules registered by RegisterModule by their names.
+	modules = map[string]Module{}
+)
+
+// RegisterModule registers m by its name, so servers can select it with
+// Builder.WithRegisteredModules. It is typically called by the package of the module, which
+// the main package imports for its side effects, so build tags control the composition of a
+// server:
+//
+//	func init() {
+//	    apiserver.RegisterModule(Inventory)
+//	}
+//
+// It panics if the name is empty or has been registered before.
+func RegisterModule(m Module) {
+	modulesMu.Lock()
+	defer modulesMu.Unlock()
+	if m.Name == "" {
+		panic("module registered without name")
+	}
+	if _, ok := modules[m.Name]; ok {
+		panic(fmt.Sprintf("module %q is registered more than once", m.Name))
+	}
+	modules[m.Name] = m
+}
+
+// RegisteredModules returns the sorted names of the modules registered by RegisterModule.
+func RegisteredModules() []string {
+	modulesMu.Lock()
+	defer modulesMu.Unlock()
+	names := make([]string, 0, len(modules))
+	for name := range modules {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// WithRegisteredModules installs the registered modules of the given names, e.g. read from the
+// configuration of the server, or all registered modules in the order of their names if no
+// names are given. Completing the Builder fails if a module has not been registered.
+func (b *Builder) WithRegisteredModules(names ...string) *Builder {
+	if len(names) == 0 {
+		names = RegisteredModules()
+	}
+	for _, name := range names {
+		modulesMu.Lock()
+		m, ok := modules[name]
+		modulesMu.Unlock()
+		if !ok {
+			b.mu.Lock()
+			b.unknownModules = append(b.unknownModules, name)
+			b.mu.Unlock()
+
+			continue
+		}
+		b.WithModule(m)
+	}
+
+	return b
+}
+
+// modulePlugin is an admission plugin of the named module.
+type modulePlugin struct {
+	AdmissionPlugin
+	module string
+}
+
+// applyAdmissionPlugins registers the admission plugins of the modules and enables them after
+// the namespace lifecycle plugin and the defaulting profiles.
+func (c *completedConfig) applyAdmissionPlugins() error {
+	if len(c.admissionPlugins) == 0 {
+		return nil
+	}
+	admissionOptions := c.recommendedOptions.Admission
+	order := slices.Clone(admissionOptions.RecommendedPluginOrder)
+	i := max(slices.Index(order, lifecycle.PluginName), slices.Index(order, profile.PluginName)) + 1
+	names := make([]string, 0, len(c.admissionPlugins))
+	for _, p := range c.admissionPlugins {
+		if p.Name == "" || p.Factory == nil {
+			return fmt.Errorf("admission plugin %q of module %q requires a name and a factory", p.Name, p.module)
+		}
+		if slices.Contains(order, p.Name) || slices.Contains(names, p.Name) {
+			return fmt.Errorf("admission plugin %q of module %q is registered more than once", p.Name, p.module)
+		}
+		admissionOptions.Plugins.Register(p.Name, p.Factory)
+		names = append(names, p.Name)
+	}
+	admissionOptions.RecommendedPluginOrder = slices.Insert(order, i, names...)
+
+	return nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"context"
+	"io"
+
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/admission/plugin/namespace/lifecycle"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	basecompatibility "k8s.io/component-base/compatibility"
+
+	"go.opendefense.cloud/kit/apiserver/profile"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// startedInformers is a SharedInformerFactory which does nothing.
+type startedInformers struct{}
+
+func (startedInformers) Start(context.Context) {}
+
+// noopPlugin is an admission.Factory of plugins which do nothing.
+func noopPlugin(io.Reader) (admission.Interface, error) {
+	return admission.NewHandler(admission.Create), nil
+}
+
+var _ = Describe("WithModule", func() {
+	var (
+		b  *Builder
+		gv = schema.GroupVersion{Group: "test.opendefense.cloud", Version: "v1"}
+	)
+
+	BeforeEach(func() {
+		b = NewBuilder(runtime.NewScheme()).WithComponentName("test")
+		b.componentGlobalsRegistry = basecompatibility.NewComponentGlobalsRegistry()
+	})
+
+	It("should install the parts of the module", func() {
+		obj := &mockResourceObject{gr: schema.GroupResource{Group: gv.Group, Resource: "testresources"}}
+		other := schema.GroupVersion{Group: "other.opendefense.cloud", Version: "v1"}
+		hook := func(genericapiserver.PostStartHookContext) error { return nil }
+		b.WithModule(Module{
+			Name:                    "test",
+			Resources:               []ResourceHandler{Resource(obj, gv)},
+			GroupVersions:           []schema.GroupVersion{other},
+			APIGroupFns:             []APIGroupFn{nil},
+			SharedInformerFactories: []SharedInformerFactory{startedInformers{}},
+			PostStartHooks:          map[string]genericapiserver.PostStartHookFunc{"second": hook, "first": hook},
+			Flags:                   []AddFlagsFn{func(*pflag.FlagSet) {}},
+		})
+
+		Expect(b.groupResources).To(ConsistOf(obj.gr))
+		Expect(b.groupVersions).To(Equal([]schema.GroupVersion{gv, other}))
+		Expect(b.apiGroupFns).To(HaveLen(1))
+		Expect(b.sharedInformerFactories).To(HaveLen(1))
+		Expect(b.postStartHooks).To(HaveLen(2))
+		Expect(b.postStartHooks[0].name).To(Equal("first"))
+		Expect(b.addFlagsFns).To(HaveLen(1))
+	})
+
+	It("should enable admission plugins after the defaulting profiles", func() {
+		b.WithGroupVersions(gv).
+			WithDefaultingProfiles(profile.NewRegistry()).
+			WithModule(Module{Name: "a", AdmissionPlugins: []AdmissionPlugin{{Name: "First", Factory: noopPlugin}}}).
+			WithModule(Module{Name: "b", AdmissionPlugins: []AdmissionPlugin{{Name: "Second", Factory: noopPlugin}}})
+		c, err := b.complete()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.recommendedOptions.Admission.Plugins.Registered()).To(ContainElements("First", "Second"))
+		order := c.recommendedOptions.Admission.RecommendedPluginOrder
+		Expect(order[:4]).To(Equal([]string{lifecycle.PluginName, profile.PluginName, "First", "Second"}))
+	})
+
+	It("should reject admission plugins registered more than once", func() {
+		b.WithGroupVersions(gv).
+			WithModule(Module{Name: "a", AdmissionPlugins: []AdmissionPlugin{{Name: "Plugin", Factory: noopPlugin}}}).
+			WithModule(Module{Name: "b", AdmissionPlugins: []AdmissionPlugin{{Name: "Plugin", Factory: noopPlugin}}})
+		_, err := b.complete()
+		Expect(err).To(MatchError(`admission plugin "Plugin" of module "b" is registered more than once`))
+	})
+
+	It("should reject admission plugins without factory", func() {
+		b.WithGroupVersions(gv).WithModule(Module{Name: "a", AdmissionPlugins: []AdmissionPlugin{{Name: "Plugin"}}})
+		_, err := b.complete()
+		Expect(err).To(MatchError(ContainSubstring("requires a name and a factory")))
+	})
+})
+
+var _ = Describe("RegisterModule", func() {
+	var b *Builder
+
+	BeforeEach(func() {
+		b = NewBuilder(runtime.NewScheme()).WithComponentName("test").
+			WithGroupVersions(schema.GroupVersion{Group: "test.opendefense.cloud", Version: "v1"})
+		b.componentGlobalsRegistry = basecompatibility.NewComponentGlobalsRegistry()
+
+		modulesMu.Lock()
+		registered := modules
+		modules = map[string]Module{}
+		modulesMu.Unlock()
+		DeferCleanup(func() {
+			modulesMu.Lock()
+			defer modulesMu.Unlock()
+			modules = registered
+		})
+
+		hook := func(genericapiserver.PostStartHookContext) error { return nil }
+		RegisterModule(Module{Name: "b", PostStartHooks: map[string]genericapiserver.PostStartHookFunc{"b": hook}})
+		RegisterModule(Module{Name: "a", PostStartHooks: map[string]genericapiserver.PostStartHookFunc{"a": hook}})
+	})
+
+	It("should reject modules registered more than once", func() {
+		Expect(func() { RegisterModule(Module{Name: "a"}) }).To(PanicWith(`module "a" is registered more than once`))
+		Expect(func() { RegisterModule(Module{}) }).To(Panic())
+		Expect(RegisteredModules()).To(Equal([]string{"a", "b"}))
+	})
+
+	It("should install all registered modules by default", func() {
+		b.WithRegisteredModules()
+		Expect(b.postStartHooks).To(HaveLen(2))
+		Expect(b.postStartHooks[0].name).To(Equal("a"))
+	})
+
+	It("should install the selected modules", func() {
+		b.WithRegisteredModules("b")
+		Expect(b.postStartHooks).To(HaveLen(1))
+		Expect(b.postStartHooks[0].name).To(Equal("b"))
+	})
+
+	It("should fail completing with unknown modules", func() {
+		_, err := b.WithRegisteredModules("c").complete()
+		Expect(err).To(MatchError(ContainSubstring(`module "c" is not registered, registered modules are [a b]`)))
+	})
+})
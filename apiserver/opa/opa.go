@@ -0,0 +1,325 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package opa evaluates Rego policies against kit resources using an Open Policy Agent
+// server, e.g. running as a sidecar of the kit API server. Policies are loaded from a
+// directory, which may be a mounted ConfigMap, and pushed to OPA whenever they change.
+// This enables policy-as-code for aggregated APIs without deploying Gatekeeper.
+package opa
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apimachinery/pkg/util/wait"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+
+	"go.opendefense.cloud/kit/apiserver/rest"
+)
+
+const (
+	// DefaultReloadInterval is the interval in which the policy directory is checked for changes.
+	DefaultReloadInterval = 30 * time.Second
+	// DefaultQuery is the rule evaluated for every object, see Config.Query.
+	DefaultQuery = "kit/deny"
+	// policyIDPrefix is prepended to the IDs of the policies managed by a Policies instance.
+	policyIDPrefix = "kit/"
+)
+
+// Config configures the evaluation of Rego policies.
+type Config struct {
+	// URL is the address of the OPA server, e.g. http://localhost:8181.
+	URL string
+	// Dir contains the Rego policies, one module per *.rego file. Files of ConfigMaps mounted
+	// as volumes are picked up as well, including updates of the ConfigMap.
+	Dir string
+	// Query is the slash separated path of the rule evaluated for every object, e.g. kit/deny
+	// for data.kit.deny. The rule yields the violations as a set of messages or of objects
+	// with the fields msg and, optionally, field. Defaults to DefaultQuery.
+	Query string
+	// Client is used to talk to OPA. Defaults to http.DefaultClient.
+	Client *http.Client
+}
+
+// Policies loads Rego policies from a directory into an OPA server and evaluates them.
+type Policies struct {
+	config Config
+
+	mu        sync.Mutex
+	checksums map[string][]byte
+	// listed is set once the policies in OPA, e.g. loaded by a previous process, have been listed.
+	listed bool
+}
+
+// New validates config and returns Policies. The policies are loaded by Reload.
+func New(config Config) (*Policies, error) {
+	if config.URL == "" {
+		return nil, fmt.Errorf("OPA URL is required")
+	}
+	if _, err := url.Parse(config.URL); err != nil {
+		return nil, fmt.Errorf("invalid OPA URL: %w", err)
+	}
+	if config.Dir == "" {
+		return nil, fmt.Errorf("policy directory is required")
+	}
+	if config.Query == "" {
+		config.Query = DefaultQuery
+	}
+	config.Query = strings.Trim(config.Query, "/")
+	if config.Client == nil {
+		config.Client = http.DefaultClient
+	}
+
+	return &Policies{config: config, checksums: map[string][]byte{}}, nil
+}
+
+// Reload pushes new and changed policies to OPA and removes deleted ones, including policies
+// left over by a previous process. Policies which failed to load or to be removed are retried on
+// the next reload, while the others are reloaded anyway.
+func (p *Policies) Reload(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	files, err := filepath.Glob(filepath.Join(p.config.Dir, "*.rego"))
+	if err != nil {
+		return err
+	}
+	errs := []error{}
+	if !p.listed {
+		if err := p.listLoaded(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("listing policies: %w", err))
+		}
+	}
+	seen := map[string]bool{}
+	for _, file := range files {
+		id := policyIDPrefix + filepath.Base(file)
+		seen[id] = true
+		data, err := os.ReadFile(file) //nolint:gosec // the policy directory is configured by the operator
+		if err != nil {
+			errs = append(errs, fmt.Errorf("reading policy: %w", err))
+			continue
+		}
+		checksum := sha256.Sum256(data)
+		if bytes.Equal(p.checksums[id], checksum[:]) {
+			continue
+		}
+		if err := p.do(ctx, http.MethodPut, "/v1/policies/"+id, "text/plain", data, nil); err != nil {
+			errs = append(errs, fmt.Errorf("loading policy %s: %w", filepath.Base(file), err))
+			continue
+		}
+		p.checksums[id] = checksum[:]
+	}
+	for id := range p.checksums {
+		if seen[id] {
+			continue
+		}
+		if err := p.do(ctx, http.MethodDelete, "/v1/policies/"+id, "", nil, nil); err != nil {
+			errs = append(errs, fmt.Errorf("removing policy %s: %w", strings.TrimPrefix(id, policyIDPrefix), err))
+			continue
+		}
+		delete(p.checksums, id)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// listLoaded records the policies managed by Policies which are loaded into OPA already, e.g. by
+// a previous process, so they are removed if they have been deleted from the directory.
+func (p *Policies) listLoaded(ctx context.Context) error {
+	var resp struct {
+		Result []struct {
+			ID string `json:"id"`
+		} `json:"result"`
+	}
+	if err := p.do(ctx, http.MethodGet, "/v1/policies", "", nil, &resp); err != nil {
+		return err
+	}
+	for _, policy := range resp.Result {
+		if _, ok := p.checksums[policy.ID]; !ok && strings.HasPrefix(policy.ID, policyIDPrefix) {
+			// Without a checksum, the policy is pushed again if it still exists.
+			p.checksums[policy.ID] = nil
+		}
+	}
+	p.listed = true
+
+	return nil
+}
+
+// Run reloads the policies every interval until ctx is done.
+func (p *Policies) Run(ctx context.Context, interval time.Duration) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := p.Reload(ctx); err != nil {
+			utilruntime.HandleErrorWithContext(ctx, err, "Failed to reload policies", "dir", p.config.Dir)
+		}
+	}, interval)
+}
+
+// Input is the document the policies are evaluated against, available as input in Rego.
+type Input struct {
+	Operation string         `json:"operation"`
+	Object    map[string]any `json:"object"`
+	OldObject map[string]any `json:"oldObject,omitempty"`
+	UserInfo  *UserInfo      `json:"userInfo,omitempty"`
+}
+
+// UserInfo describes the user performing the request.
+type UserInfo struct {
+	Username string   `json:"username"`
+	Groups   []string `json:"groups,omitempty"`
+}
+
+// violation is a single result of the query.
+type violation struct {
+	Msg   string `json:"msg"`
+	Field string `json:"field"`
+}
+
+func (v *violation) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &v.Msg); err == nil {
+		return nil
+	}
+	type plain violation
+
+	return json.Unmarshal(data, (*plain)(v))
+}
+
+// Evaluate evaluates the policies for obj and returns a Forbidden error per violation.
+// old is nil on create.
+func (p *Policies) Evaluate(ctx context.Context, obj, old runtime.Object) (field.ErrorList, error) {
+	input := Input{Operation: "CREATE"}
+	if old != nil {
+		input.Operation = "UPDATE"
+	}
+	var err error
+	if input.Object, err = runtime.DefaultUnstructuredConverter.ToUnstructured(obj); err != nil {
+		return nil, err
+	}
+	if old != nil {
+		if input.OldObject, err = runtime.DefaultUnstructuredConverter.ToUnstructured(old); err != nil {
+			return nil, err
+		}
+	}
+	if u, ok := genericapirequest.UserFrom(ctx); ok {
+		input.UserInfo = &UserInfo{Username: u.GetName(), Groups: u.GetGroups()}
+	}
+	body, err := json.Marshal(map[string]any{"input": input})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Result []violation `json:"result"`
+	}
+	if err := p.do(ctx, http.MethodPost, "/v1/data/"+p.config.Query, "application/json", body, &resp); err != nil {
+		return nil, err
+	}
+	slices.SortFunc(resp.Result, func(a, b violation) int { return strings.Compare(a.Msg, b.Msg) })
+
+	errs := field.ErrorList{}
+	for _, v := range resp.Result {
+		errs = append(errs, field.Forbidden(parseFieldPath(v.Field), v.Msg))
+	}
+
+	return errs, nil
+}
+
+// parseFieldPath parses a field path reported by a policy, e.g. spec.containers[0].image or
+// metadata.labels[app.kubernetes.io/name]. It returns nil for an empty path.
+func parseFieldPath(s string) *field.Path {
+	var path *field.Path
+	child := func(name string) {
+		if path == nil {
+			path = field.NewPath(name)
+		} else {
+			path = path.Child(name)
+		}
+	}
+	for s != "" {
+		switch s[0] {
+		case '.':
+			s = s[1:]
+		case '[':
+			end := strings.IndexByte(s, ']')
+			if end < 0 {
+				// Keep the remainder of a malformed path as is.
+				child(s)
+				return path
+			}
+			subscript := s[1:end]
+			s = s[end+1:]
+			if path == nil {
+				path = field.NewPath("")
+			}
+			if i, err := strconv.Atoi(subscript); err == nil {
+				path = path.Index(i)
+			} else {
+				path = path.Key(strings.Trim(subscript, `"'`))
+			}
+		default:
+			end := strings.IndexAny(s, ".[")
+			if end < 0 {
+				end = len(s)
+			}
+			child(s[:end])
+			s = s[end:]
+		}
+	}
+
+	return path
+}
+
+// Validator returns an ExternalValidator evaluating the policies, which is added to resources
+// with ResourceHandler.WithExternalValidators. Timeout and failure handling can be adjusted on
+// the returned validator.
+func (p *Policies) Validator() *rest.ExternalValidator {
+	return &rest.ExternalValidator{
+		Name:     "opa",
+		Validate: p.Evaluate,
+	}
+}
+
+// do sends a request to OPA and decodes the JSON response into out, if set.
+func (p *Policies) do(ctx context.Context, method, path, contentType string, body []byte, out any) error {
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(p.config.URL, "/")+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	resp, err := p.config.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("OPA returned %s: %s", resp.Status, strings.TrimSpace(string(data)))
+	}
+	if out == nil {
+		return nil
+	}
+
+	return json.Unmarshal(data, out)
+}
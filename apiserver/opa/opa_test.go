@@ -0,0 +1,197 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package opa
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type testObj struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              testSpec `json:"spec"`
+}
+
+type testSpec struct {
+	Image string `json:"image"`
+}
+
+func (t *testObj) DeepCopyObject() runtime.Object {
+	clone := *t
+
+	return &clone
+}
+
+// fakeOPA records the loaded policies and answers queries with result.
+type fakeOPA struct {
+	mu       sync.Mutex
+	policies map[string]string
+	input    map[string]any
+	result   string
+}
+
+func (f *fakeOPA) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	body, _ := io.ReadAll(r.Body)
+	switch {
+	case r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, "/v1/policies/"):
+		if strings.Contains(string(body), "syntax error") {
+			http.Error(w, `{"code":"invalid_parameter"}`, http.StatusBadRequest)
+			return
+		}
+		f.policies[strings.TrimPrefix(r.URL.Path, "/v1/policies/")] = string(body)
+	case r.Method == http.MethodGet && r.URL.Path == "/v1/policies":
+		result := []map[string]string{}
+		for id := range f.policies {
+			result = append(result, map[string]string{"id": id})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"result": result})
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/v1/policies/"):
+		delete(f.policies, strings.TrimPrefix(r.URL.Path, "/v1/policies/"))
+	case r.Method == http.MethodPost && r.URL.Path == "/v1/data/kit/deny":
+		var req struct {
+			Input map[string]any `json:"input"`
+		}
+		_ = json.Unmarshal(body, &req)
+		f.input = req.Input
+		_, _ = w.Write([]byte(f.result))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+var _ = Describe("Policies", func() {
+	var (
+		ctx    = context.Background()
+		dir    string
+		opa    *fakeOPA
+		server *httptest.Server
+		p      *Policies
+	)
+
+	write := func(name, content string) {
+		GinkgoHelper()
+		Expect(os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		opa = &fakeOPA{policies: map[string]string{}, result: `{}`}
+		server = httptest.NewServer(opa)
+		DeferCleanup(server.Close)
+
+		var err error
+		p, err = New(Config{URL: server.URL, Dir: dir})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should require the OPA URL and the policy directory", func() {
+		_, err := New(Config{Dir: dir})
+		Expect(err).To(MatchError("OPA URL is required"))
+		_, err = New(Config{URL: server.URL})
+		Expect(err).To(MatchError("policy directory is required"))
+	})
+
+	It("should load, update and remove policies", func() {
+		write("images.rego", "package kit\n")
+		write("README.md", "not a policy")
+		Expect(p.Reload(ctx)).To(Succeed())
+		Expect(opa.policies).To(Equal(map[string]string{"kit/images.rego": "package kit\n"}))
+
+		write("images.rego", "package kit\n\ndeny contains msg if { false }\n")
+		Expect(p.Reload(ctx)).To(Succeed())
+		Expect(opa.policies).To(HaveKeyWithValue("kit/images.rego", ContainSubstring("deny")))
+
+		Expect(os.Remove(filepath.Join(dir, "images.rego"))).To(Succeed())
+		Expect(p.Reload(ctx)).To(Succeed())
+		Expect(opa.policies).To(BeEmpty())
+	})
+
+	It("should retry policies which failed to load", func() {
+		write("broken.rego", "syntax error")
+		Expect(p.Reload(ctx)).To(MatchError(ContainSubstring("loading policy broken.rego: OPA returned 400")))
+
+		write("broken.rego", "package kit\n")
+		Expect(p.Reload(ctx)).To(Succeed())
+		Expect(opa.policies).To(HaveKey("kit/broken.rego"))
+	})
+
+	It("should load the other policies if one fails", func() {
+		write("broken.rego", "syntax error")
+		write("images.rego", "package kit\n")
+		Expect(p.Reload(ctx)).To(MatchError(ContainSubstring("loading policy broken.rego")))
+		Expect(opa.policies).To(Equal(map[string]string{"kit/images.rego": "package kit\n"}))
+	})
+
+	It("should remove policies left over by a previous process", func() {
+		opa.policies["kit/old.rego"] = "package kit\n"
+		opa.policies["other/policy.rego"] = "package other\n"
+		write("images.rego", "package kit\n")
+		Expect(p.Reload(ctx)).To(Succeed())
+		Expect(opa.policies).To(Equal(map[string]string{
+			"kit/images.rego":   "package kit\n",
+			"other/policy.rego": "package other\n",
+		}))
+	})
+
+	It("should evaluate the policies against the object", func() {
+		reqCtx := genericapirequest.WithUser(ctx, &user.DefaultInfo{Name: "alice", Groups: []string{"devs"}})
+		obj := &testObj{ObjectMeta: metav1.ObjectMeta{Name: "foo"}, Spec: testSpec{Image: "latest"}}
+		errs, err := p.Evaluate(reqCtx, obj, obj.DeepCopyObject())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(errs).To(BeEmpty())
+
+		Expect(opa.input).To(HaveKeyWithValue("operation", "UPDATE"))
+		Expect(opa.input).To(HaveKeyWithValue("object", HaveKeyWithValue("spec", HaveKeyWithValue("image", "latest"))))
+		Expect(opa.input).To(HaveKey("oldObject"))
+		Expect(opa.input).To(HaveKeyWithValue("userInfo", HaveKeyWithValue("username", "alice")))
+	})
+
+	It("should return violations as forbidden field errors", func() {
+		opa.result = `{"result": ["images must be pinned", {"msg": "name is reserved", "field": "metadata.name"}]}`
+		errs, err := p.Evaluate(ctx, &testObj{}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(opa.input).To(HaveKeyWithValue("operation", "CREATE"))
+		Expect(errs).To(HaveLen(2))
+		Expect(errs[0].Detail).To(Equal("images must be pinned"))
+		Expect(errs[1].Field).To(Equal("metadata.name"))
+		Expect(errs[1].Detail).To(Equal("name is reserved"))
+	})
+
+	It("should parse field paths with subscripts", func() {
+		Expect(parseFieldPath("")).To(BeNil())
+		Expect(parseFieldPath("spec.items[0].name").String()).To(Equal("spec.items[0].name"))
+		Expect(parseFieldPath("metadata.labels[app.kubernetes.io/name]").String()).To(Equal("metadata.labels[app.kubernetes.io/name]"))
+		Expect(parseFieldPath(`metadata.annotations["note"]`).String()).To(Equal("metadata.annotations[note]"))
+	})
+
+	It("should return an error if OPA fails", func() {
+		server.Close()
+		_, err := p.Evaluate(ctx, &testObj{}, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should provide an external validator", func() {
+		v := p.Validator()
+		Expect(v.Name).To(Equal("opa"))
+		Expect(v.Validate).NotTo(BeNil())
+	})
+})
@@ -0,0 +1,214 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/klog/v2"
+)
+
+// PostStartHookInformersSynced is the name of the post-start hook which completes once the
+// informers of the server have been started and their caches synced. Hooks reading from
+// informers run after it with RunAfter(PostStartHookInformersSynced).
+const PostStartHookInformersSynced = "informers-synced"
+
+// informerShutdownTimeout bounds the time the server waits for its informers to stop.
+const informerShutdownTimeout = 30 * time.Second
+
+// FailurePolicy defines how the server handles a failing post-start hook.
+type FailurePolicy string
+
+const (
+	// FailurePolicyFail terminates the server if the hook fails. It is the default.
+	FailurePolicyFail FailurePolicy = "Fail"
+	// FailurePolicyIgnore logs the error of the hook. Hooks running after it are started anyway.
+	FailurePolicyIgnore FailurePolicy = "Ignore"
+)
+
+// PostStartHookOption configures a post-start hook registered with WithPostStartHook.
+type PostStartHookOption func(*postStartHook)
+
+// RunAfter runs the hook once the named hooks completed, e.g. after the bootstrap hook of a
+// singleton, "bootstrap-<resource>.<group>", or after PostStartHookInformersSynced. Hooks
+// without dependencies are started concurrently when the server has started.
+func RunAfter(names ...string) PostStartHookOption {
+	return func(h *postStartHook) {
+		h.after = append(h.after, names...)
+	}
+}
+
+// OnFailure sets the failure policy of the hook, which is FailurePolicyFail by default.
+func OnFailure(policy FailurePolicy) PostStartHookOption {
+	return func(h *postStartHook) {
+		h.failurePolicy = policy
+	}
+}
+
+// cacheSyncWaiter is implemented by the informer factories of client-go.
+type cacheSyncWaiter interface {
+	WaitForCacheSync(stopCh <-chan struct{}) map[reflect.Type]bool
+}
+
+// informerShutdowner is implemented by the informer factories of client-go.
+type informerShutdowner interface {
+	Shutdown()
+}
+
+// informersHookName returns the name of the post-start hook starting the informers.
+func (c *completedConfig) informersHookName() string {
+	return fmt.Sprintf("start-%s-server-informers", c.componentName)
+}
+
+// validatePostStartHooks returns an error if hook names are not unique, dependencies are
+// unknown or cyclic or a failure policy is invalid.
+func (c *completedConfig) validatePostStartHooks() error {
+	names := sets.New(c.informersHookName(), PostStartHookInformersSynced)
+	deps := map[string][]string{}
+	for _, hook := range c.postStartHooks {
+		if names.Has(hook.name) {
+			return fmt.Errorf("post-start hook %q is registered more than once", hook.name)
+		}
+		names.Insert(hook.name)
+		deps[hook.name] = hook.after
+		switch hook.failurePolicy {
+		case "", FailurePolicyFail, FailurePolicyIgnore:
+		default:
+			return fmt.Errorf("post-start hook %q has an invalid failure policy %q", hook.name, hook.failurePolicy)
+		}
+	}
+	for _, hook := range c.postStartHooks {
+		for _, name := range hook.after {
+			if !names.Has(name) {
+				return fmt.Errorf("post-start hook %q runs after unknown hook %q", hook.name, name)
+			}
+		}
+	}
+	// Walk the dependencies depth-first, a hook reached again on the current path is a cycle.
+	visited, path := sets.New[string](), []string{}
+	var visit func(name string) error
+	visit = func(name string) error {
+		if i := slices.Index(path, name); i >= 0 {
+			return fmt.Errorf("post-start hooks have a cyclic dependency: %v", append(path[i:], name))
+		}
+		if visited.Has(name) {
+			return nil
+		}
+		path = append(path, name)
+		for _, dep := range deps[name] {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		visited.Insert(name)
+
+		return nil
+	}
+	for _, hook := range c.postStartHooks {
+		if err := visit(hook.name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addPostStartHooks adds the hooks to server, each of them waiting for its dependencies, and
+// returns the channels closed once they completed by hook name. The informers-synced hook is only
+// added if a hook depends on it, as it waits for informers of the server, which may be served by a
+// kube-apiserver. Failing hooks are reported to fail.
+func addPostStartHooks(server *genericapiserver.GenericAPIServer, hooks []postStartHook, informersSynced postStartHook,
+	fail func(error)) (map[string]chan struct{}, error) {
+	done := map[string]chan struct{}{}
+	for _, hook := range hooks {
+		done[hook.name] = make(chan struct{})
+	}
+	if slices.ContainsFunc(hooks, func(h postStartHook) bool { return slices.Contains(h.after, PostStartHookInformersSynced) }) {
+		hooks = append(hooks, informersSynced)
+		done[informersSynced.name] = make(chan struct{})
+	}
+	for _, hook := range hooks {
+		if err := server.AddPostStartHook(hook.name, hook.run(done, fail)); err != nil {
+			return nil, err
+		}
+	}
+
+	return done, nil
+}
+
+// run returns the hook function waiting for the dependencies of the hook and closing its done
+// channel once it completed. Errors are reported to fail, which stops the server, instead of being
+// returned to the generic API server, which would exit the process. The hook then stays incomplete
+// until the server stopped, so the server does not become ready.
+func (h postStartHook) run(done map[string]chan struct{}, fail func(error)) genericapiserver.PostStartHookFunc {
+	return func(hookCtx genericapiserver.PostStartHookContext) error {
+		for _, name := range h.after {
+			select {
+			case <-done[name]:
+			case <-hookCtx.Done():
+				klog.FromContext(hookCtx).V(2).Info("Server stopped before post-start hook ran", "hook", h.name, "waitingFor", name)
+
+				return nil
+			}
+		}
+		if err := h.fn(hookCtx); err != nil {
+			if h.failurePolicy != FailurePolicyIgnore {
+				fail(fmt.Errorf("post-start hook %q failed: %w", h.name, err))
+				<-hookCtx.Done()
+
+				return nil
+			}
+			klog.FromContext(hookCtx).Error(err, "Post-start hook failed, ignoring", "hook", h.name)
+		}
+		close(done[h.name])
+
+		return nil
+	}
+}
+
+// waitForCacheSync waits until the informers of all factories synced.
+func waitForCacheSync(hookCtx genericapiserver.PostStartHookContext, factories ...SharedInformerFactory) error {
+	for _, factory := range factories {
+		w, ok := factory.(cacheSyncWaiter)
+		if !ok {
+			continue
+		}
+		for typ, synced := range w.WaitForCacheSync(hookCtx.Done()) {
+			if !synced {
+				return fmt.Errorf("informer of %v has not synced", typ)
+			}
+		}
+	}
+
+	return nil
+}
+
+// shutdownInformers waits until the informers of all factories terminated, once the channel they
+// have been started with is closed, so they release their caches when the server stops. It fails
+// after timeout, e.g. if an event handler of an informer blocks.
+func shutdownInformers(timeout time.Duration, factories ...SharedInformerFactory) error {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for _, factory := range factories {
+			if s, ok := factory.(informerShutdowner); ok {
+				s.Shutdown()
+			}
+		}
+	}()
+	t := time.NewTimer(timeout)
+	defer t.Stop()
+	select {
+	case <-done:
+		return nil
+	case <-t.C:
+		return fmt.Errorf("informers have not stopped within %s", timeout)
+	}
+}
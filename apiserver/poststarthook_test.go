@@ -0,0 +1,196 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// syncedFactory is an informer factory reporting its informers as synced or not.
+type syncedFactory struct {
+	synced bool
+}
+
+func (f syncedFactory) Start(context.Context) {}
+
+func (f syncedFactory) WaitForCacheSync(<-chan struct{}) map[reflect.Type]bool {
+	return map[reflect.Type]bool{reflect.TypeFor[string](): f.synced}
+}
+
+// blockingFactory is an informer factory whose informers only stop once released.
+type blockingFactory struct {
+	released chan struct{}
+}
+
+func (blockingFactory) Start(context.Context) {}
+
+func (f blockingFactory) Shutdown() { <-f.released }
+
+// shutdownRecorder records that Shutdown of the wrapped factory returned.
+type shutdownRecorder struct {
+	SharedInformerFactory
+	stopped bool
+}
+
+func (r *shutdownRecorder) Shutdown() {
+	r.SharedInformerFactory.(informerShutdowner).Shutdown()
+	r.stopped = true
+}
+
+var _ = Describe("post-start hooks", func() {
+	var (
+		noop    = func(genericapiserver.PostStartHookContext) error { return nil }
+		failing = func(genericapiserver.PostStartHookContext) error { return errors.New("failed") }
+	)
+
+	validate := func(hooks ...postStartHook) error {
+		c := &completedConfig{builderConfig: builderConfig{componentName: "test", postStartHooks: hooks}}
+
+		return c.validatePostStartHooks()
+	}
+
+	Describe("validation", func() {
+		It("should accept dependencies on registered hooks", func() {
+			Expect(validate(
+				postStartHook{name: "a", fn: noop, after: []string{"start-test-server-informers", PostStartHookInformersSynced}},
+				postStartHook{name: "b", fn: noop, after: []string{"a"}, failurePolicy: FailurePolicyIgnore},
+			)).To(Succeed())
+		})
+
+		It("should reject duplicate names", func() {
+			Expect(validate(postStartHook{name: "a", fn: noop}, postStartHook{name: "a", fn: noop})).
+				To(MatchError(ContainSubstring(`"a" is registered more than once`)))
+			Expect(validate(postStartHook{name: PostStartHookInformersSynced, fn: noop})).
+				To(MatchError(ContainSubstring("registered more than once")))
+		})
+
+		It("should reject unknown dependencies", func() {
+			Expect(validate(postStartHook{name: "a", fn: noop, after: []string{"b"}})).
+				To(MatchError(ContainSubstring(`"a" runs after unknown hook "b"`)))
+		})
+
+		It("should reject cyclic dependencies", func() {
+			Expect(validate(
+				postStartHook{name: "a", fn: noop, after: []string{"b"}},
+				postStartHook{name: "b", fn: noop, after: []string{"c"}},
+				postStartHook{name: "c", fn: noop, after: []string{"a"}},
+			)).To(MatchError(ContainSubstring("cyclic dependency: [a b c a]")))
+		})
+
+		It("should reject invalid failure policies", func() {
+			Expect(validate(postStartHook{name: "a", fn: noop, failurePolicy: "Retry"})).
+				To(MatchError(ContainSubstring(`invalid failure policy "Retry"`)))
+		})
+
+		It("should apply the options of the Builder", func() {
+			b := NewBuilder(runtime.NewScheme()).WithPostStartHook("a", noop, RunAfter("b", "c"), OnFailure(FailurePolicyIgnore))
+			Expect(b.postStartHooks).To(HaveLen(1))
+			Expect(b.postStartHooks[0].after).To(Equal([]string{"b", "c"}))
+			Expect(b.postStartHooks[0].failurePolicy).To(Equal(FailurePolicyIgnore))
+		})
+	})
+
+	Describe("run", func() {
+		var (
+			done   map[string]chan struct{}
+			failed chan error
+			fail   func(error)
+		)
+
+		BeforeEach(func() {
+			done = map[string]chan struct{}{"a": make(chan struct{}), "b": make(chan struct{})}
+			failed = make(chan error, 1)
+			fail = func(err error) { failed <- err }
+		})
+
+		It("should wait for its dependencies", func() {
+			ran := make(chan struct{})
+			hook := postStartHook{name: "b", after: []string{"a"}, fn: func(genericapiserver.PostStartHookContext) error {
+				close(ran)
+				return nil
+			}}
+			errCh := make(chan error)
+			go func() {
+				errCh <- hook.run(done, fail)(genericapiserver.PostStartHookContext{Context: context.Background()})
+			}()
+
+			Consistently(ran).ShouldNot(BeClosed())
+			close(done["a"])
+			Eventually(ran).Should(BeClosed())
+			Eventually(errCh).Should(Receive(BeNil()))
+			Expect(done["b"]).To(BeClosed())
+		})
+
+		It("should stop waiting once the server stops", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			hook := postStartHook{name: "b", after: []string{"a"}, fn: noop}
+			Expect(hook.run(done, fail)(genericapiserver.PostStartHookContext{Context: ctx})).To(Succeed())
+			Expect(done["b"]).NotTo(BeClosed())
+			Expect(failed).NotTo(Receive())
+		})
+
+		It("should report failures and stay incomplete until the server stops by default", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			hook := postStartHook{name: "a", fn: failing}
+			errCh := make(chan error)
+			go func() { errCh <- hook.run(done, fail)(genericapiserver.PostStartHookContext{Context: ctx}) }()
+
+			Eventually(failed).Should(Receive(MatchError(`post-start hook "a" failed: failed`)))
+			Consistently(errCh).ShouldNot(Receive())
+			cancel()
+			Eventually(errCh).Should(Receive(BeNil()))
+			Expect(done["a"]).NotTo(BeClosed())
+		})
+
+		It("should ignore failures if requested and complete", func() {
+			hook := postStartHook{name: "a", fn: failing, failurePolicy: FailurePolicyIgnore}
+			Expect(hook.run(done, fail)(genericapiserver.PostStartHookContext{Context: context.Background()})).To(Succeed())
+			Expect(done["a"]).To(BeClosed())
+			Expect(failed).NotTo(Receive())
+		})
+	})
+
+	It("should wait for the informers to sync", func() {
+		hookCtx := genericapiserver.PostStartHookContext{Context: context.Background()}
+		Expect(waitForCacheSync(hookCtx, syncedFactory{synced: true}, startedInformers{})).To(Succeed())
+		Expect(waitForCacheSync(hookCtx, syncedFactory{synced: true}, syncedFactory{})).
+			To(MatchError(ContainSubstring("has not synced")))
+	})
+})
+
+var _ = Describe("shutdownInformers", func() {
+	It("should stop the goroutines of the informers", func() {
+		clientGoFactory := informers.NewSharedInformerFactory(fake.NewClientset(), 0)
+		informer := clientGoFactory.Core().V1().Namespaces().Informer()
+		factory := InformerFactory(clientGoFactory)
+		ctx, cancel := context.WithCancel(context.Background())
+		factory.Start(ctx)
+		Expect(waitForCacheSync(genericapiserver.PostStartHookContext{Context: ctx}, factory)).To(Succeed())
+
+		cancel()
+		recorder := &shutdownRecorder{SharedInformerFactory: factory}
+		Expect(shutdownInformers(time.Minute, recorder, syncedFactory{})).To(Succeed())
+		Expect(recorder.stopped).To(BeTrue())
+		Expect(informer.IsStopped()).To(BeTrue())
+	})
+
+	It("should give up on informers which do not stop", func() {
+		factory := blockingFactory{released: make(chan struct{})}
+		DeferCleanup(func() { close(factory.released) })
+		Expect(shutdownInformers(10*time.Millisecond, factory)).
+			To(MatchError(ContainSubstring("have not stopped within 10ms")))
+	})
+})
@@ -0,0 +1,117 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package profile
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/admission/initializer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+)
+
+const (
+	// PluginName is the name of the admission plugin applying the profiles.
+	PluginName = "DefaultingProfiles"
+	// AuditAnnotationProfile is the audit annotation recording the profile applied to an object.
+	AuditAnnotationProfile = "defaultingprofiles.admission.kit.opendefense.cloud/profile"
+)
+
+// Register registers the admission plugin applying the profiles of r.
+func Register(plugins *admission.Plugins, r *Registry) {
+	plugins.Register(PluginName, func(io.Reader) (admission.Interface, error) {
+		return NewPlugin(r), nil
+	})
+}
+
+// Plugin is an admission plugin defaulting created objects by the profile selected for
+// their namespace.
+type Plugin struct {
+	*admission.Handler
+	registry        *Registry
+	client          kubernetes.Interface
+	namespaceLister corelisters.NamespaceLister
+}
+
+var (
+	_ admission.MutationInterface                  = &Plugin{}
+	_ initializer.WantsExternalKubeInformerFactory = &Plugin{}
+	_ initializer.WantsExternalKubeClientSet       = &Plugin{}
+)
+
+// NewPlugin returns the admission plugin applying the profiles of r.
+func NewPlugin(r *Registry) *Plugin {
+	return &Plugin{
+		Handler:  admission.NewHandler(admission.Create),
+		registry: r,
+	}
+}
+
+// SetExternalKubeInformerFactory implements the WantsExternalKubeInformerFactory interface.
+func (p *Plugin) SetExternalKubeInformerFactory(f informers.SharedInformerFactory) {
+	namespaceInformer := f.Core().V1().Namespaces()
+	p.namespaceLister = namespaceInformer.Lister()
+	p.SetReadyFunc(namespaceInformer.Informer().HasSynced)
+}
+
+// SetExternalKubeClientSet implements the WantsExternalKubeClientSet interface.
+func (p *Plugin) SetExternalKubeClientSet(client kubernetes.Interface) {
+	p.client = client
+}
+
+// ValidateInitialization implements the InitializationValidator interface.
+func (p *Plugin) ValidateInitialization() error {
+	if p.namespaceLister == nil {
+		return fmt.Errorf("missing namespaceLister")
+	}
+	if p.client == nil {
+		return fmt.Errorf("missing client")
+	}
+
+	return nil
+}
+
+// Admit defaults namespaced objects by the profile selected for their namespace.
+func (p *Plugin) Admit(ctx context.Context, a admission.Attributes, o admission.ObjectInterfaces) error {
+	if a.GetNamespace() == "" || a.GetSubresource() != "" || a.GetObject() == nil {
+		return nil
+	}
+	if !p.WaitForReady() {
+		return admission.NewForbidden(a, fmt.Errorf("not yet ready to handle request"))
+	}
+	ns, err := p.namespace(ctx, a.GetNamespace())
+	if err != nil {
+		return err
+	}
+	name, profile, ok, err := p.registry.Select(ns)
+	if err != nil {
+		return admission.NewForbidden(a, err)
+	}
+	if !ok {
+		return nil
+	}
+	if err := profile.Default(ctx, a.GetObject()); err != nil {
+		return fmt.Errorf("defaulting by profile %q: %w", name, err)
+	}
+
+	return a.AddAnnotation(AuditAnnotationProfile, name)
+}
+
+// namespace returns the namespace from the cache. Namespaces created recently may not be cached
+// yet, they are read from the kube-apiserver.
+func (p *Plugin) namespace(ctx context.Context, name string) (*corev1.Namespace, error) {
+	ns, err := p.namespaceLister.Get(name)
+	if err == nil || !apierrors.IsNotFound(err) {
+		return ns, err
+	}
+
+	return p.client.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package profile defaults created objects by profiles selected by the labels or annotations
+// of their namespace, e.g. to set different defaults per environment.
+package profile
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// AnnotationProfile selects the profile of a namespace by name. It takes precedence over the
+// selectors of the profiles.
+const AnnotationProfile = "profile.kit.opendefense.cloud/name"
+
+// Profile defaults the objects created in the namespaces it is selected for.
+type Profile struct {
+	// Selector selects namespaces by their labels. Profiles without selector are only
+	// selected by AnnotationProfile.
+	Selector labels.Selector
+	// Default defaults obj, which is of the internal version of its resource. Objects of all
+	// resources are passed, so Default usually switches on their type.
+	Default func(ctx context.Context, obj runtime.Object) error
+}
+
+// Registry holds named profiles. It is safe for concurrent use.
+type Registry struct {
+	mu       sync.RWMutex
+	profiles map[string]Profile
+	// names holds the names of the profiles in the order they have been registered.
+	names []string
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{profiles: map[string]Profile{}}
+}
+
+// Register adds a profile. It returns an error if a profile with the same name exists.
+func (r *Registry) Register(name string, p Profile) error {
+	if p.Default == nil {
+		return fmt.Errorf("defaulting profile %q has no Default function", name)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.profiles[name]; ok {
+		return fmt.Errorf("defaulting profile %q is already registered", name)
+	}
+	r.profiles[name] = p
+	r.names = append(r.names, name)
+
+	return nil
+}
+
+// MustRegister is like Register but panics on error.
+func (r *Registry) MustRegister(name string, p Profile) {
+	if err := r.Register(name, p); err != nil {
+		panic(err)
+	}
+}
+
+// Names returns the sorted names of all registered profiles.
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := slices.Clone(r.names)
+	slices.Sort(names)
+
+	return names
+}
+
+// Select returns the name of the profile selected for the namespace ns, which is the one named by
+// its AnnotationProfile annotation or else the first registered profile whose selector matches its
+// labels. It returns false if no profile is selected and an error for unknown profile names.
+func (r *Registry) Select(ns metav1.Object) (string, Profile, bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if name, ok := ns.GetAnnotations()[AnnotationProfile]; ok {
+		p, ok := r.profiles[name]
+		if !ok {
+			return "", Profile{}, false, fmt.Errorf("namespace %s selects unknown defaulting profile %q", ns.GetName(), name)
+		}
+
+		return name, p, true, nil
+	}
+	for _, name := range r.names {
+		p := r.profiles[name]
+		if p.Selector != nil && p.Selector.Matches(labels.Set(ns.GetLabels())) {
+			return name, p, true, nil
+		}
+	}
+
+	return "", Profile{}, false, nil
+}
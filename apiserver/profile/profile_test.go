@@ -0,0 +1,172 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package profile
+
+import (
+	"context"
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// recordingAttributes records the annotations added by the plugin.
+type recordingAttributes struct {
+	admission.Attributes
+	annotations map[string]string
+}
+
+func (a *recordingAttributes) AddAnnotation(key, value string) error {
+	a.annotations[key] = value
+	return nil
+}
+
+// setMessage returns a Default function setting the data of config maps.
+func setMessage(message string) func(context.Context, runtime.Object) error {
+	return func(ctx context.Context, obj runtime.Object) error {
+		if cm, ok := obj.(*corev1.ConfigMap); ok && cm.Data == nil {
+			cm.Data = map[string]string{"message": message}
+		}
+
+		return nil
+	}
+}
+
+var _ = Describe("Registry", func() {
+	var r *Registry
+
+	BeforeEach(func() {
+		r = NewRegistry()
+		r.MustRegister("prod", Profile{Selector: labels.SelectorFromSet(labels.Set{"env": "prod"}), Default: setMessage("prod")})
+		r.MustRegister("any", Profile{Selector: labels.Everything(), Default: setMessage("any")})
+		r.MustRegister("manual", Profile{Default: setMessage("manual")})
+	})
+
+	namespace := func(lbls, annotations map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns", Labels: lbls, Annotations: annotations}}
+	}
+
+	It("should reject duplicate and incomplete profiles", func() {
+		Expect(r.Register("prod", Profile{Default: setMessage("")})).To(MatchError(ContainSubstring("already registered")))
+		Expect(r.Register("empty", Profile{})).To(MatchError(ContainSubstring("no Default function")))
+		Expect(r.Names()).To(Equal([]string{"any", "manual", "prod"}))
+	})
+
+	It("should select the first profile matching the labels of the namespace", func() {
+		name, _, ok, err := r.Select(namespace(map[string]string{"env": "prod"}, nil))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeTrue())
+		Expect(name).To(Equal("prod"))
+
+		name, _, _, _ = r.Select(namespace(nil, nil))
+		Expect(name).To(Equal("any"))
+	})
+
+	It("should select the profile named by the annotation of the namespace", func() {
+		name, _, ok, err := r.Select(namespace(map[string]string{"env": "prod"}, map[string]string{AnnotationProfile: "manual"}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeTrue())
+		Expect(name).To(Equal("manual"))
+
+		_, _, _, err = r.Select(namespace(nil, map[string]string{AnnotationProfile: "unknown"}))
+		Expect(err).To(MatchError(ContainSubstring(`unknown defaulting profile "unknown"`)))
+	})
+
+	It("should not select profiles without selector", func() {
+		r = NewRegistry()
+		r.MustRegister("manual", Profile{Default: setMessage("manual")})
+		_, _, ok, err := r.Select(namespace(nil, nil))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeFalse())
+	})
+})
+
+var _ = Describe("Plugin", func() {
+	var (
+		ctx    context.Context
+		plugin *Plugin
+		client *fake.Clientset
+	)
+
+	BeforeEach(func() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(context.Background())
+		DeferCleanup(cancel)
+
+		r := NewRegistry()
+		r.MustRegister("prod", Profile{Selector: labels.SelectorFromSet(labels.Set{"env": "prod"}), Default: setMessage("prod")})
+		r.MustRegister("failing", Profile{Default: func(context.Context, runtime.Object) error { return errors.New("failed") }})
+		client = fake.NewClientset(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "prod", Labels: map[string]string{"env": "prod"}}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "dev"}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "broken", Annotations: map[string]string{AnnotationProfile: "failing"}}},
+		)
+		factory := informers.NewSharedInformerFactory(client, 0)
+		plugin = NewPlugin(r)
+		plugin.SetExternalKubeInformerFactory(factory)
+		plugin.SetExternalKubeClientSet(client)
+		Expect(plugin.ValidateInitialization()).To(Succeed())
+		factory.Start(ctx.Done())
+		factory.WaitForCacheSync(ctx.Done())
+	})
+
+	admit := func(namespace string) (*corev1.ConfigMap, *recordingAttributes, error) {
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: namespace}}
+		a := &recordingAttributes{
+			Attributes: admission.NewAttributesRecord(cm, nil, corev1.SchemeGroupVersion.WithKind("ConfigMap"), namespace, "test",
+				corev1.SchemeGroupVersion.WithResource("configmaps"), "", admission.Create, &metav1.CreateOptions{}, false, nil),
+			annotations: map[string]string{},
+		}
+
+		return cm, a, plugin.Admit(ctx, a, nil)
+	}
+
+	It("should only handle creates", func() {
+		Expect(plugin.Handles(admission.Create)).To(BeTrue())
+		Expect(plugin.Handles(admission.Update)).To(BeFalse())
+	})
+
+	It("should default objects by the profile of their namespace", func() {
+		cm, a, err := admit("prod")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cm.Data).To(HaveKeyWithValue("message", "prod"))
+		Expect(a.annotations).To(HaveKeyWithValue(AuditAnnotationProfile, "prod"))
+	})
+
+	It("should not default objects in namespaces without profile", func() {
+		cm, a, err := admit("dev")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cm.Data).To(BeNil())
+		Expect(a.annotations).To(BeEmpty())
+	})
+
+	It("should read namespaces missing in the cache", func() {
+		_, err := client.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name: "new", Labels: map[string]string{"env": "prod"},
+		}}, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		plugin.namespaceLister = informers.NewSharedInformerFactory(fake.NewClientset(), 0).Core().V1().Namespaces().Lister()
+
+		cm, _, err := admit("new")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cm.Data).To(HaveKeyWithValue("message", "prod"))
+
+		_, _, err = admit("missing")
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should fail if the profile fails", func() {
+		_, _, err := admit("broken")
+		Expect(err).To(MatchError(ContainSubstring(`defaulting by profile "failing": failed`)))
+	})
+})
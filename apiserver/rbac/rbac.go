@@ -0,0 +1,109 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package rbac generates ClusterRoles aggregated into the default view, edit and admin roles
+// of Kubernetes, so users bound to them can access the resources of an aggregated API server.
+package rbac
+
+import (
+	"slices"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"go.opendefense.cloud/kit/apiserver"
+)
+
+// Labels aggregating ClusterRoles into the default user-facing roles.
+const (
+	LabelAggregateToView  = "rbac.authorization.k8s.io/aggregate-to-view"
+	LabelAggregateToEdit  = "rbac.authorization.k8s.io/aggregate-to-edit"
+	LabelAggregateToAdmin = "rbac.authorization.k8s.io/aggregate-to-admin"
+)
+
+var (
+	readVerbs  = []string{"get", "list", "watch"}
+	writeVerbs = []string{"create", "update", "patch", "delete", "deletecollection"}
+)
+
+// subresourceVerbs are the verbs granted by the edit role on subresources. The status
+// subresource is left to controllers.
+var subresourceVerbs = map[string][]string{
+	"diff":      {"create"},
+	"normalize": {"create"},
+	"undelete":  {"create"},
+}
+
+// AggregatedClusterRoles returns the ClusterRoles <prefix>-view and <prefix>-edit for the given
+// resources, e.g. from apiserver.Builder.Resources. The view role grants read access and is
+// aggregated into the view, edit and admin roles, the edit role grants write access and is
+// aggregated into the edit and admin roles.
+func AggregatedClusterRoles(prefix string, resources []apiserver.ResourceInfo) []rbacv1.ClusterRole {
+	view := rbacv1.ClusterRole{
+		TypeMeta: metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "ClusterRole"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: prefix + "-view",
+			Labels: map[string]string{
+				LabelAggregateToView:  "true",
+				LabelAggregateToEdit:  "true",
+				LabelAggregateToAdmin: "true",
+			},
+		},
+		Rules: rules(resources, readVerbs, nil),
+	}
+	edit := rbacv1.ClusterRole{
+		TypeMeta: view.TypeMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			Name: prefix + "-edit",
+			Labels: map[string]string{
+				LabelAggregateToEdit:  "true",
+				LabelAggregateToAdmin: "true",
+			},
+		},
+		Rules: rules(resources, writeVerbs, subresourceVerbs),
+	}
+
+	return []rbacv1.ClusterRole{view, edit}
+}
+
+// rules returns a rule per group granting verbs on its resources, and a rule per group and
+// subresource granting its verbs.
+func rules(resources []apiserver.ResourceInfo, verbs []string, subresources map[string][]string) []rbacv1.PolicyRule {
+	byGroup := map[string]sets.Set[string]{}
+	bySubresource := map[string]map[string]sets.Set[string]{}
+	for _, r := range resources {
+		if byGroup[r.Group] == nil {
+			byGroup[r.Group] = sets.New[string]()
+			bySubresource[r.Group] = map[string]sets.Set[string]{}
+		}
+		byGroup[r.Group].Insert(r.Resource)
+		for _, sub := range r.Subresources {
+			if _, ok := subresources[sub]; !ok {
+				continue
+			}
+			if bySubresource[r.Group][sub] == nil {
+				bySubresource[r.Group][sub] = sets.New[string]()
+			}
+			bySubresource[r.Group][sub].Insert(r.Resource + "/" + sub)
+		}
+	}
+
+	result := []rbacv1.PolicyRule{}
+	for _, group := range sets.List(sets.KeySet(byGroup)) {
+		result = append(result, rbacv1.PolicyRule{
+			APIGroups: []string{group},
+			Resources: sets.List(byGroup[group]),
+			Verbs:     slices.Clone(verbs),
+		})
+		for _, sub := range sets.List(sets.KeySet(bySubresource[group])) {
+			result = append(result, rbacv1.PolicyRule{
+				APIGroups: []string{group},
+				Resources: sets.List(bySubresource[group][sub]),
+				Verbs:     slices.Clone(subresources[sub]),
+			})
+		}
+	}
+
+	return result
+}
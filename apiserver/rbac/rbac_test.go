@@ -0,0 +1,46 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rbac
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+
+	"go.opendefense.cloud/kit/apiserver"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AggregatedClusterRoles", func() {
+	resources := []apiserver.ResourceInfo{
+		{Group: "foo.example.com", Version: "v1", Resource: "bars", Subresources: []string{"status", "undelete"}},
+		{Group: "foo.example.com", Version: "v2", Resource: "bars", Subresources: []string{"status", "undelete"}},
+		{Group: "foo.example.com", Version: "v1", Resource: "bazs"},
+		{Group: "other.example.com", Version: "v1", Resource: "quxs"},
+	}
+
+	It("should grant read access aggregated into all roles", func() {
+		view := AggregatedClusterRoles("foo", resources)[0]
+		Expect(view.Name).To(Equal("foo-view"))
+		Expect(view.Labels).To(HaveKeyWithValue(LabelAggregateToView, "true"))
+		Expect(view.Labels).To(HaveKeyWithValue(LabelAggregateToEdit, "true"))
+		Expect(view.Labels).To(HaveKeyWithValue(LabelAggregateToAdmin, "true"))
+		Expect(view.Rules).To(Equal([]rbacv1.PolicyRule{
+			{APIGroups: []string{"foo.example.com"}, Resources: []string{"bars", "bazs"}, Verbs: []string{"get", "list", "watch"}},
+			{APIGroups: []string{"other.example.com"}, Resources: []string{"quxs"}, Verbs: []string{"get", "list", "watch"}},
+		}))
+	})
+
+	It("should grant write access aggregated into the edit and admin roles", func() {
+		edit := AggregatedClusterRoles("foo", resources)[1]
+		Expect(edit.Name).To(Equal("foo-edit"))
+		Expect(edit.Labels).NotTo(HaveKey(LabelAggregateToView))
+		Expect(edit.Labels).To(HaveKeyWithValue(LabelAggregateToAdmin, "true"))
+		Expect(edit.Rules).To(HaveLen(3))
+		Expect(edit.Rules[0].Verbs).To(ContainElements("create", "delete", "deletecollection"))
+		Expect(edit.Rules[1]).To(Equal(rbacv1.PolicyRule{
+			APIGroups: []string{"foo.example.com"}, Resources: []string{"bars/undelete"}, Verbs: []string{"create"},
+		}))
+	})
+})
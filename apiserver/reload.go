@@ -0,0 +1,52 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"context"
+
+	genericapiserver "k8s.io/apiserver/pkg/server"
+
+	"go.opendefense.cloud/kit/apiserver/reload"
+)
+
+// WithReload reloads settings which are safe to change at runtime when the process receives
+// SIGHUP: the audit policy file set by --audit-policy-file and the settings of fns by name, e.g.
+// rate limits or an authorization policy overlay of the server. Log verbosity can be changed at
+// runtime with PUT /debug/flags/v already.
+func (b *Builder) WithReload(fns map[string]reload.Func) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.reload = true
+	if b.reloadFns == nil {
+		b.reloadFns = map[string]reload.Func{}
+	}
+	for name, fn := range fns {
+		b.reloadFns[name] = fn
+	}
+
+	return b
+}
+
+// applyReload makes the audit policy of rc reloadable and reloads the settings until ctx is done.
+func (c *completedConfig) applyReload(ctx context.Context, rc *genericapiserver.RecommendedConfig) error {
+	if !c.reload {
+		return nil
+	}
+	r := reload.New()
+	for name, fn := range c.reloadFns {
+		r.Register(name, fn)
+	}
+	if audit := c.recommendedOptions.Audit; audit != nil && audit.PolicyFile != "" {
+		policy, err := reload.NewAuditPolicy(audit.PolicyFile)
+		if err != nil {
+			return err
+		}
+		rc.AuditPolicyRuleEvaluator = policy
+		r.Register("audit-policy", policy.Reload)
+	}
+	go r.Run(ctx)
+
+	return nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package reload applies settings which are safe to change at runtime, e.g. the audit policy,
+// without restarting the server. Settings are reloaded when the process receives SIGHUP, e.g.
+// sent by a config reloader sidecar after a mounted ConfigMap changed.
+package reload
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"os/signal"
+	"slices"
+	"sync"
+	"syscall"
+
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apiserver/pkg/audit"
+	"k8s.io/apiserver/pkg/audit/policy"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/klog/v2"
+)
+
+// Func reloads a setting. On error, the previous setting must be kept.
+type Func func(ctx context.Context) error
+
+// Reloader runs the registered reload functions.
+type Reloader struct {
+	mu    sync.Mutex
+	funcs map[string]Func
+}
+
+// New returns a Reloader without reload functions.
+func New() *Reloader {
+	return &Reloader{funcs: map[string]Func{}}
+}
+
+// Register registers fn by name, replacing a function registered with the same name.
+func (r *Reloader) Register(name string, fn Func) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.funcs[name] = fn
+}
+
+// Reload runs all reload functions ordered by name, one reload at a time. A failing function does
+// not prevent the others from being run.
+func (r *Reloader) Reload(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	errs := []error{}
+	for _, name := range slices.Sorted(maps.Keys(r.funcs)) {
+		if err := r.funcs[name](ctx); err != nil {
+			errs = append(errs, fmt.Errorf("reloading %s: %w", name, err))
+			continue
+		}
+		klog.FromContext(ctx).V(2).Info("Reloaded settings", "name", name)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// Run reloads the settings whenever the process receives one of signals, SIGHUP by default,
+// until ctx is done.
+func (r *Reloader) Run(ctx context.Context, signals ...os.Signal) {
+	if len(signals) == 0 {
+		signals = []os.Signal{syscall.SIGHUP}
+	}
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, signals...)
+	defer signal.Stop(ch)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ch:
+			if err := r.Reload(ctx); err != nil {
+				utilruntime.HandleErrorWithContext(ctx, err, "Failed to reload settings")
+			}
+		}
+	}
+}
+
+// AuditPolicy evaluates the audit policy read from a file, which is read again on reload.
+type AuditPolicy struct {
+	path string
+
+	mu        sync.RWMutex
+	evaluator audit.PolicyRuleEvaluator
+}
+
+var _ audit.PolicyRuleEvaluator = &AuditPolicy{}
+
+// NewAuditPolicy loads the audit policy from path.
+func NewAuditPolicy(path string) (*AuditPolicy, error) {
+	p := &AuditPolicy{path: path}
+	if err := p.Reload(context.Background()); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Reload reads the audit policy file. On error the previously loaded policy is kept.
+func (p *AuditPolicy) Reload(context.Context) error {
+	loaded, err := policy.LoadPolicyFromFile(p.path)
+	if err != nil {
+		return fmt.Errorf("loading audit policy file: %w", err)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.evaluator = policy.NewPolicyRuleEvaluator(loaded)
+
+	return nil
+}
+
+// EvaluatePolicyRule implements audit.PolicyRuleEvaluator.
+func (p *AuditPolicy) EvaluatePolicyRule(attrs authorizer.Attributes) audit.RequestAuditConfig {
+	p.mu.RLock()
+	evaluator := p.evaluator
+	p.mu.RUnlock()
+
+	return evaluator.EvaluatePolicyRule(attrs)
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package reload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	auditinternal "k8s.io/apiserver/pkg/apis/audit"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const policyTemplate = `apiVersion: audit.k8s.io/v1
+kind: Policy
+rules:
+- level: %s
+`
+
+var _ = Describe("Reloader", func() {
+	It("should run all functions by name and aggregate their errors", func() {
+		r := New()
+		calls := []string{}
+		r.Register("b", func(context.Context) error {
+			calls = append(calls, "b")
+			return errors.New("invalid")
+		})
+		r.Register("a", func(context.Context) error {
+			calls = append(calls, "a")
+			return nil
+		})
+
+		Expect(r.Reload(context.Background())).To(MatchError("reloading b: invalid"))
+		Expect(calls).To(Equal([]string{"a", "b"}))
+	})
+
+	It("should reload on signal until ctx is done", func() {
+		r := New()
+		reloaded := make(chan struct{}, 1)
+		r.Register("a", func(context.Context) error {
+			select {
+			case reloaded <- struct{}{}:
+			default:
+			}
+
+			return nil
+		})
+		// Keep the signal from terminating the test before Run is notified.
+		received := make(chan os.Signal, 1)
+		signal.Notify(received, syscall.SIGUSR1)
+		defer signal.Stop(received)
+		ctx, cancel := context.WithCancel(context.Background())
+		stopped := make(chan struct{})
+		go func() {
+			defer close(stopped)
+			r.Run(ctx, syscall.SIGUSR1)
+		}()
+
+		Eventually(func() bool {
+			Expect(syscall.Kill(os.Getpid(), syscall.SIGUSR1)).To(Succeed())
+			select {
+			case <-reloaded:
+				return true
+			default:
+				return false
+			}
+		}).Should(BeTrue())
+		cancel()
+		Eventually(stopped).Should(BeClosed())
+	})
+})
+
+var _ = Describe("AuditPolicy", func() {
+	var (
+		path  string
+		attrs = authorizer.AttributesRecord{User: &user.DefaultInfo{Name: "alice"}, Verb: "get"}
+	)
+
+	write := func(level string) {
+		Expect(os.WriteFile(path, fmt.Appendf(nil, policyTemplate, level), 0o600)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "policy.yaml")
+		write("Metadata")
+	})
+
+	It("should evaluate the reloaded policy", func() {
+		p, err := NewAuditPolicy(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(p.EvaluatePolicyRule(attrs).Level).To(Equal(auditinternal.LevelMetadata))
+
+		write("RequestResponse")
+		Expect(p.Reload(context.Background())).To(Succeed())
+		Expect(p.EvaluatePolicyRule(attrs).Level).To(Equal(auditinternal.LevelRequestResponse))
+	})
+
+	It("should keep the policy if the file is invalid", func() {
+		p, err := NewAuditPolicy(path)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(os.WriteFile(path, []byte("kind: Unknown"), 0o600)).To(Succeed())
+		Expect(p.Reload(context.Background())).To(MatchError(ContainSubstring("loading audit policy file")))
+		Expect(p.EvaluatePolicyRule(attrs).Level).To(Equal(auditinternal.LevelMetadata))
+	})
+
+	It("should fail without a policy file", func() {
+		_, err := NewAuditPolicy(filepath.Join(GinkgoT().TempDir(), "missing.yaml"))
+		Expect(err).To(HaveOccurred())
+	})
+})
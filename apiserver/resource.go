@@ -5,7 +5,13 @@ package apiserver
 
 import (
 	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -18,8 +24,270 @@ import (
 
 // ResourceHandler holds the configuration for registering a resource with the API server.
 type ResourceHandler struct {
+	groupResource schema.GroupResource
 	groupVersions []schema.GroupVersion
-	apiGroupFn    APIGroupFn
+	options       *resourceOptions
+	// newAPIGroup returns the APIGroupFn and post-start hooks of the resource for the given options.
+	// It is called with a copy of the options for every completion of the Builder.
+	newAPIGroup func(opts *resourceOptions) (APIGroupFn, []postStartHook)
+	// validateFn checks the resource and its options against the scheme when the Builder is completed.
+	validateFn func(*runtime.Scheme, *resourceOptions) error
+	// infoFn describes the served resource for every group version.
+	infoFn func(*runtime.Scheme, *resourceOptions) []ResourceInfo
+}
+
+// ResourceInfo describes a resource served in a group version.
+type ResourceInfo struct {
+	Group    string
+	Version  string
+	Resource string
+	// Kind is empty if the type of the resource is not registered in the scheme.
+	Kind       string
+	Namespaced bool
+	// Subresources are the names of the served subresources, e.g. status.
+	Subresources []string
+	// Stability is the stability level of the resource, see ResourceHandler.WithStability.
+	Stability Stability
+}
+
+// resourceOptions holds optional per-resource configuration set through ResourceHandler methods.
+type resourceOptions struct {
+	verbs              []string
+	statusSubResource  bool
+	strictStatus       bool
+	externalValidators []*rest.ExternalValidator
+	storageHooks       []func(rest.Storage) error
+	softDelete         time.Duration
+	ratcheting         bool
+	maxPageSize        *int64
+	canarySelector     rest.CanarySelector
+	canaryStrategy     func(stable rest.Strategy) rest.Strategy
+	decorateStrategy   []func(rest.Strategy) rest.Strategy
+	lazyStorage        bool
+	storagePrefix      string
+	inflightLimit      rest.InflightLimit
+	stability          Stability
+	normalize          bool
+	diff               bool
+	// store is set once the API group has been built and can be used by post-start hooks.
+	store rest.Storage
+}
+
+// clone returns a copy of the options without the store, so modifying the copy does not modify
+// the original. The With methods of ResourceHandler modify copies, so copies of a handler don't
+// share their options.
+func (o *resourceOptions) clone() *resourceOptions {
+	if o == nil {
+		return &resourceOptions{}
+	}
+
+	return &resourceOptions{
+		verbs:              slices.Clone(o.verbs),
+		statusSubResource:  o.statusSubResource,
+		strictStatus:       o.strictStatus,
+		externalValidators: slices.Clone(o.externalValidators),
+		storageHooks:       slices.Clone(o.storageHooks),
+		softDelete:         o.softDelete,
+		ratcheting:         o.ratcheting,
+		maxPageSize:        o.maxPageSize,
+		canarySelector:     o.canarySelector,
+		canaryStrategy:     o.canaryStrategy,
+		decorateStrategy:   slices.Clone(o.decorateStrategy),
+		lazyStorage:        o.lazyStorage,
+		storagePrefix:      o.storagePrefix,
+		inflightLimit:      o.inflightLimit,
+		stability:          o.stability,
+		normalize:          o.normalize,
+		diff:               o.diff,
+	}
+}
+
+// WithVerbs restricts the verbs served for the resource, e.g. to make it read-only:
+//
+//	apiserver.Resource(&foo.Bar{}, v1alpha1.SchemeGroupVersion).
+//	    WithVerbs(rest.VerbGet, rest.VerbList, rest.VerbWatch)
+//
+// Other verbs are not advertised by discovery and requests using them are rejected with a
+// MethodNotAllowed status. The status subresource is not affected.
+func (rh ResourceHandler) WithVerbs(verbs ...string) ResourceHandler {
+	rh.options = rh.options.clone()
+	rh.options.verbs = verbs
+	return rh
+}
+
+// WithStatusSubResource serves the /status subresource for a resource with a field named Status
+// which does not implement resource.ObjectWithStatusSubResource. The field is copied by reflection,
+// using its DeepCopy method if present.
+func (rh ResourceHandler) WithStatusSubResource() ResourceHandler {
+	rh.options = rh.options.clone()
+	rh.options.statusSubResource = true
+	return rh
+}
+
+// WithStrictStatus rejects updates through the status subresource which change any field except
+// metadata and status with an Invalid status. By default, such changes are silently reset.
+func (rh ResourceHandler) WithStrictStatus() ResourceHandler {
+	rh.options = rh.options.clone()
+	rh.options.strictStatus = true
+	return rh
+}
+
+// WithExternalValidators validates created and updated objects with external services, e.g.
+// policy engines, once the built-in validation succeeded:
+//
+//	apiserver.Resource(&foo.Bar{}, v1alpha1.SchemeGroupVersion).
+//	    WithExternalValidators(&rest.ExternalValidator{
+//	        Name:          "opa",
+//	        Validate:      opaValidate,
+//	        Timeout:       time.Second,
+//	        FailurePolicy: rest.FailOpen,
+//	    })
+//
+// See rest.ExternalValidator for the deadline and circuit breaker of each validator.
+func (rh ResourceHandler) WithExternalValidators(validators ...*rest.ExternalValidator) ResourceHandler {
+	rh.options = rh.options.clone()
+	rh.options.externalValidators = append(rh.options.externalValidators, validators...)
+	return rh
+}
+
+// WithStorageHook calls fn with the storage of the resource once its API group has been built,
+// e.g. to let components of the server read the resource directly from storage. The server
+// fails to start if fn returns an error.
+func (rh ResourceHandler) WithStorageHook(fn func(rest.Storage) error) ResourceHandler {
+	rh.options = rh.options.clone()
+	rh.options.storageHooks = append(rh.options.storageHooks, fn)
+	return rh
+}
+
+// WithSoftDelete keeps deleted objects for the given retention instead of removing them
+// immediately. Until the retention expired, deleted objects are still served with their
+// deletionTimestamp set to the end of the retention and can be restored by a POST to the
+// undelete subresource:
+//
+//	apiserver.Resource(&foo.Bar{}, v1alpha1.SchemeGroupVersion).
+//	    WithSoftDelete(24 * time.Hour)
+//
+// Expired objects are removed by a post-start hook. Deletions with a grace period of 0 remove
+// objects immediately, see rest.DefaultStrategy.CheckGracefulDelete. Resources implementing
+// resource.GracefulDeleter cannot be soft-deleted.
+func (rh ResourceHandler) WithSoftDelete(retention time.Duration) ResourceHandler {
+	rh.options = rh.options.clone()
+	rh.options.softDelete = retention
+	return rh
+}
+
+// WithNormalize serves the normalize subresource, which responds to a POST with the submitted
+// object as it would be created, without persisting it, so GitOps pipelines can normalize their
+// manifests and avoid perpetual diffs:
+//
+//	kubectl create --raw /apis/foo.opendefense.cloud/v1alpha1/namespaces/default/bars/bar/normalize -f bar.json
+//
+// It is authorized as create of the subresource, see rest.NewNormalizeStore.
+func (rh ResourceHandler) WithNormalize() ResourceHandler {
+	rh.options = rh.options.clone()
+	rh.options.normalize = true
+	return rh
+}
+
+// WithDiff serves the diff subresource, which responds to a POST of an object with the changes
+// it would make to the stored object, as rest.ObjectDiff, so CI pipelines can preview changes
+// after defaulting and the hooks of the strategy:
+//
+//	kubectl create --raw /apis/foo.opendefense.cloud/v1alpha1/namespaces/default/bars/bar/diff -f bar.json
+//
+// It is authorized as create of the subresource, see rest.NewDiffStore.
+func (rh ResourceHandler) WithDiff() ResourceHandler {
+	rh.options = rh.options.clone()
+	rh.options.diff = true
+	return rh
+}
+
+// WithValidationRatcheting lets updates of objects violating tightened validation succeed as long
+// as the invalid fields are not changed, so existing objects are not locked. Errors returned by
+// ValidateUpdate are dropped if the value at their field path has not been changed, see
+// rest.RatchetErrors. Create validation is not affected.
+func (rh ResourceHandler) WithValidationRatcheting() ResourceHandler {
+	rh.options = rh.options.clone()
+	rh.options.ratcheting = true
+	return rh
+}
+
+// WithLazyStorage defers the creation of the storage of the resource, including its watch cache,
+// until the resource is first accessed, e.g. for rarely used resources of servers serving many
+// optional APIs. The first request waits for the watch cache to be initialized. Post-start hooks
+// accessing the resource, like the one of WithSoftDelete, create the storage at startup. See
+// rest.LazyRESTOptionsGetter.
+func (rh ResourceHandler) WithLazyStorage() ResourceHandler {
+	rh.options = rh.options.clone()
+	rh.options.lazyStorage = true
+	return rh
+}
+
+// WithStoragePrefix keeps the objects of the resource under prefix in etcd instead of the prefix
+// configured by --etcd-prefix, so large resources can be backed up and restored separately. The
+// compaction interval applies to all keys of etcd and is configured for the whole server, see
+// Builder.WithContinueTokenLifetime. See rest.StoragePrefixRESTOptionsGetter.
+func (rh ResourceHandler) WithStoragePrefix(prefix string) ResourceHandler {
+	rh.options = rh.options.clone()
+	rh.options.storagePrefix = prefix
+	return rh
+}
+
+// WithMaxPageSize limits the number of objects returned by list requests with a limit to n,
+// rest.DefaultMaxPageSize by default. Clients requesting a larger limit continue the list after
+// n objects. Lists without a limit are not affected. A size of 0 disables the limit.
+func (rh ResourceHandler) WithMaxPageSize(n int64) ResourceHandler {
+	rh.options = rh.options.clone()
+	rh.options.maxPageSize = &n
+	return rh
+}
+
+// WithInflightLimit limits the number of concurrent expensive lists of the resource, e.g. lists
+// without a selector, independent of --max-requests-inflight, so a heavy resource cannot starve
+// all others:
+//
+//	apiserver.Resource(&foo.Bar{}, v1alpha1.SchemeGroupVersion).
+//	    WithInflightLimit(rest.InflightLimit{MaxLists: 4})
+//
+// Further lists are rejected with a TooManyRequests status and a Retry-After header. See
+// rest.InflightLimit.
+func (rh ResourceHandler) WithInflightLimit(l rest.InflightLimit) ResourceHandler {
+	rh.options = rh.options.clone()
+	rh.options.inflightLimit = l
+	return rh
+}
+
+// WithCanary serves the requests selected by selector with the strategy returned by canary, e.g.
+// a new implementation rolled out to selected clients before all of them:
+//
+//	apiserver.Resource(&foo.Bar{}, v1alpha1.SchemeGroupVersion).
+//	    WithCanary(rest.CanaryGroups("canary-testers"), func(stable rest.Strategy) rest.Strategy {
+//	        return newBarStrategy(stable)
+//	    })
+//
+// Clients opt in themselves with selector rest.CanaryRequested by sending the rest.CanaryHeader.
+// Both implementations share the storage of the resource, see rest.NewCanaryStrategy.
+func (rh ResourceHandler) WithCanary(selector rest.CanarySelector, canary func(stable rest.Strategy) rest.Strategy) ResourceHandler {
+	rh.options = rh.options.clone()
+	rh.options.canarySelector = selector
+	rh.options.canaryStrategy = canary
+	return rh
+}
+
+// WithStrategy decorates the strategy of the resource with fn, e.g. to layer cross-cutting
+// behaviors like audit stamps or quota checks on top of it with rest.Compose:
+//
+//	apiserver.Resource(&foo.Bar{}, v1alpha1.SchemeGroupVersion).
+//	    WithStrategy(func(s rest.Strategy) rest.Strategy {
+//	        return rest.Compose(s, rest.Hooks[*foo.Bar]{Validate: validateQuota})
+//	    })
+//
+// Decorators are applied in the order they are added, after WithCanary. The status subresource
+// keeps preparing updates itself, but validates them with the decorated strategy.
+func (rh ResourceHandler) WithStrategy(fn func(rest.Strategy) rest.Strategy) ResourceHandler {
+	rh.options = rh.options.clone()
+	rh.options.decorateStrategy = append(rh.options.decorateStrategy, fn)
+	return rh
 }
 
 // Resource registers a Kubernetes resource with the API server.
@@ -30,61 +298,236 @@ type ResourceHandler struct {
 //
 // The gvs parameter specifies which group versions to register.
 //
-// To customize the resource's short names or singular name in kubectl, implement
-// ShortNamesProvider or SingularNameProvider on the resource type T:
+// To customize the resource's short names, categories or singular name in kubectl, implement
+// ShortNamesProvider, CategoriesProvider or SingularNameProvider on the resource type T:
 //
 //	func (b *Bar) ShortNames() []string {
 //	    return []string{"br"}
 //	}
 //
+//	func (b *Bar) Categories() []string {
+//	    return []string{"all"}
+//	}
+//
 //	func (b *Bar) GetSingularName() string {
 //	    return "bar"
 //	}
 func Resource[E resource.Object, T resource.ObjectWithDeepCopy[E]](obj T, gvs ...schema.GroupVersion) ResourceHandler {
 	return ResourceHandler{
+		groupResource: obj.GetGroupResource(),
 		groupVersions: gvs,
-		apiGroupFn: func(scheme *runtime.Scheme, codecs serializer.CodecFactory, c *server.CompletedConfig) server.APIGroupInfo {
+		options:       &resourceOptions{},
+		validateFn: func(scheme *runtime.Scheme, opts *resourceOptions) error {
+			if opts.statusSubResource && !resource.HasStatusField(obj) {
+				return fmt.Errorf("%s has no field named Status for the status subresource", obj.GetGroupResource())
+			}
+			if opts.softDelete != 0 && opts.softDelete < time.Second {
+				return fmt.Errorf("soft delete retention of %s must be at least 1s, got %s", obj.GetGroupResource(), opts.softDelete)
+			}
+			if opts.maxPageSize != nil && *opts.maxPageSize < 0 {
+				return fmt.Errorf("max page size of %s must not be negative, got %d", obj.GetGroupResource(), *opts.maxPageSize)
+			}
+			if err := opts.stability.validate(); err != nil {
+				return fmt.Errorf("invalid stability of %s: %w", obj.GetGroupResource(), err)
+			}
+			if _, ok := any(obj).(resource.GracefulDeleter); ok && opts.softDelete != 0 {
+				return fmt.Errorf("%s implements resource.GracefulDeleter and cannot be soft-deleted", obj.GetGroupResource())
+			}
+
+			return validateListKind(scheme, obj, gvs)
+		},
+		infoFn: func(scheme *runtime.Scheme, opts *resourceOptions) []ResourceInfo {
 			gr := obj.GetGroupResource()
-			strategy := rest.NewDefaultStrategy(obj, scheme, gr)
-			store, err := rest.NewStore(scheme, obj.New, obj.NewList, gr, strategy, c.RESTOptionsGetter)
-			if err != nil {
+			kind := ""
+			if kinds, _, err := scheme.ObjectKinds(obj); err == nil {
+				kind = kinds[0].Kind
+			}
+			var subresources []string
+			if resource.HasStatus(obj) || opts.statusSubResource {
+				subresources = append(subresources, "status")
+			}
+			if opts.diff {
+				subresources = append(subresources, "diff")
+			}
+			if opts.normalize {
+				subresources = append(subresources, "normalize")
+			}
+			if opts.softDelete > 0 {
+				subresources = append(subresources, "undelete")
+			}
+			infos := make([]ResourceInfo, 0, len(gvs))
+			for _, gv := range gvs {
+				infos = append(infos, ResourceInfo{
+					Group:        gr.Group,
+					Version:      gv.Version,
+					Resource:     gr.Resource,
+					Kind:         kind,
+					Namespaced:   obj.NamespaceScoped(),
+					Subresources: slices.Clone(subresources),
+					Stability:    opts.stability.orStable(),
+				})
+			}
+
+			return infos
+		},
+		newAPIGroup: func(opts *resourceOptions) (APIGroupFn, []postStartHook) {
+			var hooks []postStartHook
+			if opts.softDelete > 0 {
+				hooks = append(hooks, postStartHook{
+					name: fmt.Sprintf("reap-%s", obj.GetGroupResource()),
+					fn: func(hookCtx server.PostStartHookContext) error {
+						go rest.RunReaper(hookCtx, opts.store, rest.DefaultReapInterval)
+						return nil
+					},
+				})
+			}
+			if _, ok := any(obj).(rest.Migrator); ok {
+				hooks = append(hooks, postStartHook{
+					name: fmt.Sprintf("count-legacy-%s", obj.GetGroupResource()),
+					fn: func(hookCtx server.PostStartHookContext) error {
+						go rest.RunLegacyObjectCounter(hookCtx, opts.store, rest.DefaultLegacyCountInterval)
+						return nil
+					},
+				})
+			}
+
+			return newResourceAPIGroupFn[E](obj, gvs, opts), hooks
+		},
+	}
+}
+
+// schemeMu serializes changes of the scheme by API groups, which may be built concurrently, see
+// WithGroupInstallParallelism.
+var schemeMu sync.Mutex
+
+// newResourceAPIGroupFn returns the APIGroupFn serving obj with the given options.
+func newResourceAPIGroupFn[E resource.Object, T resource.ObjectWithDeepCopy[E]](obj T, gvs []schema.GroupVersion, opts *resourceOptions) APIGroupFn {
+	return func(scheme *runtime.Scheme, codecs serializer.CodecFactory, c *server.CompletedConfig) server.APIGroupInfo {
+		gr := obj.GetGroupResource()
+		strategy := rest.NewDefaultStrategy(obj, scheme, gr)
+		strategy.StatusSubResource = opts.statusSubResource
+		strategy.SoftDeleteRetention = opts.softDelete
+		strategy.ValidationRatcheting = opts.ratcheting
+		maxPageSize := int64(rest.DefaultMaxPageSize)
+		if opts.maxPageSize != nil {
+			maxPageSize = *opts.maxPageSize
+		}
+		var storeStrategy rest.Strategy = strategy
+		if opts.canaryStrategy != nil {
+			storeStrategy = rest.NewCanaryStrategy(strategy, opts.canaryStrategy(strategy), opts.canarySelector, gr)
+		}
+		for _, fn := range opts.decorateStrategy {
+			storeStrategy = fn(storeStrategy)
+		}
+		optsGetter := c.RESTOptionsGetter
+		if opts.storagePrefix != "" {
+			optsGetter = rest.StoragePrefixRESTOptionsGetter(optsGetter, opts.storagePrefix)
+		}
+		if opts.lazyStorage {
+			optsGetter = rest.LazyRESTOptionsGetter(optsGetter)
+		}
+		storeOpts := []rest.StoreOption{
+			rest.WithVerbs(opts.verbs...), rest.WithExternalValidators(opts.externalValidators...), rest.WithMaxPageSize(maxPageSize),
+			rest.WithInflightLimit(opts.inflightLimit),
+		}
+		// List alpha and beta resources in the category of their stability level.
+		if s := opts.stability.orStable(); s != StabilityStable {
+			storeOpts = append(storeOpts, rest.WithCategories(string(s)))
+		}
+		store, err := rest.NewStore(scheme, obj.New, obj.NewList, gr, storeStrategy, optsGetter, storeOpts...)
+		if err != nil {
+			panic(err)
+		}
+		schemeMu.Lock()
+		err = rest.AddFieldLabelConversions(scheme, obj, gvs...)
+		schemeMu.Unlock()
+		if err != nil {
+			panic(err)
+		}
+
+		opts.store = store
+		for _, fn := range opts.storageHooks {
+			if err := fn(store); err != nil {
 				panic(err)
 			}
+		}
+
+		storage := map[string]rest.Storage{}
+		storage[gr.Resource] = store
 
-			storage := map[string]rest.Storage{}
-			storage[gr.Resource] = store
-
-			if _, ok := any(obj).(resource.ObjectWithStatusSubResource); ok {
-				statusPrepareForUpdate := func(ctx context.Context, obj, old runtime.Object) {
-					// We copy status to old
-					statusObj := any(obj).(resource.ObjectWithStatusSubResource)
-					statusObj.CopyStatusTo(old)
-					// And use old (with new status) to reset spec of new obj
-					copyableObj := any(obj).(E)
-					copyableOld := any(old).(T)
-					copyableOld.DeepCopyInto(copyableObj)
-				}
-				// We need to access the underlying *registry.Store for status subresource.
-				// Use rest.Unwrap to handle both wrapped (storeWithShortNames) and unwrapped cases.
-				// Make a value copy so we can modify only the status copy's UpdateStrategy.
-				statusStore := *rest.Unwrap(store)
-				statusStore.UpdateStrategy = &rest.PrepareForUpdaterStrategy{
-					RESTUpdateStrategy: statusStore.UpdateStrategy,
-					OverrideFn:         statusPrepareForUpdate,
-				}
+		if resource.HasStatus(obj) || opts.statusSubResource {
+			statusPrepareForUpdate := func(ctx context.Context, obj, old runtime.Object) {
+				// We copy status to old
+				resource.CopyStatus(obj, old)
+				// And use old (with new status) to reset spec of new obj
+				copyableObj := any(obj).(E)
+				copyableOld := any(old).(T)
+				copyableOld.DeepCopyInto(copyableObj)
+				// Report the generation the status has been computed for
+				rest.PrepareStatusForUpdate(obj)
+			}
+			// We need to access the underlying *registry.Store for status subresource.
+			// Use rest.Unwrap to handle both wrapped (short names, verbs) and unwrapped cases.
+			// Make a value copy so we can modify only the status copy's UpdateStrategy.
+			statusStore := *rest.Unwrap(store)
+			statusStore.UpdateStrategy = &rest.PrepareForUpdaterStrategy{
+				RESTUpdateStrategy: statusStore.UpdateStrategy,
+				OverrideFn:         statusPrepareForUpdate,
+			}
+			if opts.strictStatus {
+				storage[gr.Resource+"/status"] = rest.NewStrictStatusStore(&statusStore)
+			} else {
 				storage[gr.Resource+"/status"] = &statusStore
 			}
+		}
 
-			apiGroupInfo := server.NewDefaultAPIGroupInfo(gr.Group, scheme, metav1.ParameterCodec, codecs)
+		if opts.diff {
+			storage[gr.Resource+"/diff"] = rest.NewDiffStore(store, codecs.UniversalDecoder())
+		}
+		if opts.normalize {
+			storage[gr.Resource+"/normalize"] = rest.NewNormalizeStore(store)
+		}
+		if opts.softDelete > 0 {
+			storage[gr.Resource+"/undelete"] = rest.NewUndeleteStore(store)
+		}
 
-			for _, gv := range gvs {
-				if gv.Group != gr.Group {
-					panic("unexpected group mismatch")
-				}
-				apiGroupInfo.VersionedResourcesStorageMap[gv.Version] = storage
+		apiGroupInfo := server.NewDefaultAPIGroupInfo(gr.Group, scheme, metav1.ParameterCodec, codecs)
+
+		for _, gv := range gvs {
+			if gv.Group != gr.Group {
+				panic("unexpected group mismatch")
 			}
+			apiGroupInfo.VersionedResourcesStorageMap[gv.Version] = storage
+		}
 
-			return apiGroupInfo
-		},
+		return apiGroupInfo
+	}
+}
+
+// validateListKind checks that the list type of obj is registered in the scheme for all gvs and
+// that its items are of the type of obj. Otherwise, requests fail only when they are served.
+func validateListKind(scheme *runtime.Scheme, obj resource.Object, gvs []schema.GroupVersion) error {
+	list := obj.NewList()
+	kinds, _, err := scheme.ObjectKinds(list)
+	if err != nil {
+		return fmt.Errorf("list type %T of %s is not registered in the scheme: %w", list, obj.GetGroupResource(), err)
+	}
+	listKind := kinds[0].Kind
+	for _, gv := range gvs {
+		if !scheme.Recognizes(gv.WithKind(listKind)) {
+			return fmt.Errorf("list kind %s of %s is not registered in the scheme for %s", listKind, obj.GetGroupResource(), gv)
+		}
+	}
+
+	itemsPtr, err := meta.GetItemsPtr(list)
+	if err != nil {
+		return fmt.Errorf("list type %T of %s: %w", list, obj.GetGroupResource(), err)
+	}
+	itemType := reflect.TypeOf(itemsPtr).Elem().Elem()
+	objType := reflect.TypeOf(obj.New())
+	if itemType != objType && (objType.Kind() != reflect.Pointer || itemType != objType.Elem()) {
+		return fmt.Errorf("list type %T of %s has items of type %s, expected %s", list, obj.GetGroupResource(), itemType, objType)
 	}
+
+	return nil
 }
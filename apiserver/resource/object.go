@@ -4,6 +4,8 @@
 package resource
 
 import (
+	"context"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -51,3 +53,25 @@ type ObjectWithStatusSubResource interface {
 	// Used to preserve status on updates where only spec changes are allowed.
 	CopyStatusTo(runtime.Object)
 }
+
+// ObjectWithObservedGeneration is implemented by resources whose status reports the generation last
+// observed by their controller. The API server maintains the generation of such resources, incrementing
+// it on changes outside of metadata and status, and sets the observed generation on every update of
+// the status subresource.
+type ObjectWithObservedGeneration interface {
+	Object
+
+	// SetObservedGeneration sets status.observedGeneration of the receiver.
+	SetObservedGeneration(generation int64)
+}
+
+// GracefulDeleter is implemented by resources which are terminated gracefully when deleted, like
+// Pods. Objects deleted gracefully are kept with their deletionTimestamp set to the end of their
+// grace period, until a controller, like the kubelet for Pods, deletes them with a grace period
+// of 0 once they terminated. Later deletions may only shorten the grace period.
+type GracefulDeleter interface {
+	// CheckGracefulDelete is called with the stored object on delete and returns true if it
+	// is deleted gracefully. The grace period is set in options, which holds the grace period
+	// requested by the client, if any. A grace period of 0 deletes the object immediately.
+	CheckGracefulDelete(ctx context.Context, options *metav1.DeleteOptions) bool
+}
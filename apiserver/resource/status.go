@@ -0,0 +1,73 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// HasStatus returns true if obj has a status subresource by implementing ObjectWithStatusSubResource.
+// Other resources only get the subresource if it is enabled explicitly.
+func HasStatus(obj runtime.Object) bool {
+	_, ok := obj.(ObjectWithStatusSubResource)
+
+	return ok
+}
+
+// HasStatusField returns true if obj is a pointer to a struct with a field named Status, which
+// CopyStatus can copy.
+func HasStatusField(obj runtime.Object) bool {
+	_, ok := statusField(obj)
+
+	return ok
+}
+
+// CopyStatus copies the status of from to to and returns true if from has a status. It must only be
+// called for resources with a status subresource.
+// If from implements ObjectWithStatusSubResource, CopyStatusTo is used. Otherwise the field named
+// Status is copied, using its DeepCopy method if present.
+func CopyStatus(from, to runtime.Object) bool {
+	if s, ok := from.(ObjectWithStatusSubResource); ok {
+		s.CopyStatusTo(to)
+
+		return true
+	}
+	src, ok := statusField(from)
+	if !ok {
+		return false
+	}
+	dst, ok := statusField(to)
+	if !ok || src.Type() != dst.Type() {
+		return false
+	}
+	if deepCopy := src.Addr().MethodByName("DeepCopy"); deepCopy.IsValid() && deepCopy.Type().NumIn() == 0 && deepCopy.Type().NumOut() == 1 {
+		out := deepCopy.Call(nil)[0]
+		if out.Type() == reflect.PointerTo(dst.Type()) {
+			if !out.IsNil() {
+				dst.Set(out.Elem())
+			}
+
+			return true
+		}
+	}
+	dst.Set(src)
+
+	return true
+}
+
+// statusField returns the settable field named Status of the struct obj points to.
+func statusField(obj runtime.Object) (reflect.Value, bool) {
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+	f := v.Elem().FieldByName("Status")
+	if !f.IsValid() || !f.CanSet() {
+		return reflect.Value{}, false
+	}
+
+	return f, true
+}
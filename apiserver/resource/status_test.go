@@ -0,0 +1,132 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type testStatus struct {
+	Conditions []string
+}
+
+func (in *testStatus) DeepCopy() *testStatus {
+	out := &testStatus{}
+	out.Conditions = append([]string{}, in.Conditions...)
+
+	return out
+}
+
+// withStatus has a status field with a DeepCopy method.
+type withStatus struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	Spec   string
+	Status testStatus
+}
+
+func (w *withStatus) DeepCopyObject() runtime.Object {
+	clone := *w
+
+	return &clone
+}
+
+// withPlainStatus has a status field without a DeepCopy method.
+type withPlainStatus struct {
+	metav1.TypeMeta
+	Status string
+}
+
+func (w *withPlainStatus) DeepCopyObject() runtime.Object {
+	clone := *w
+
+	return &clone
+}
+
+// withCopyStatusTo copies its status explicitly.
+type withCopyStatusTo struct {
+	withStatus
+}
+
+func (w *withCopyStatusTo) GetObjectMeta() *metav1.ObjectMeta { return &w.ObjectMeta }
+func (w *withCopyStatusTo) NamespaceScoped() bool             { return true }
+func (w *withCopyStatusTo) New() runtime.Object               { return &withCopyStatusTo{} }
+func (w *withCopyStatusTo) NewList() runtime.Object           { return nil }
+
+func (w *withCopyStatusTo) GetGroupResource() schema.GroupResource {
+	return schema.GroupResource{Group: "test", Resource: "withcopystatustos"}
+}
+
+func (w *withCopyStatusTo) CopyStatusTo(obj runtime.Object) {
+	obj.(*withCopyStatusTo).Status = testStatus{Conditions: []string{"explicit"}}
+}
+
+// withoutStatus has no status field.
+type withoutStatus struct {
+	metav1.TypeMeta
+}
+
+func (w *withoutStatus) DeepCopyObject() runtime.Object {
+	clone := *w
+
+	return &clone
+}
+
+var _ = Describe("HasStatus", func() {
+	It("should only detect objects implementing CopyStatusTo", func() {
+		Expect(HasStatus(&withCopyStatusTo{})).To(BeTrue())
+		Expect(HasStatus(&withStatus{})).To(BeFalse())
+		Expect(HasStatus(&withoutStatus{})).To(BeFalse())
+	})
+})
+
+var _ = Describe("HasStatusField", func() {
+	It("should detect a status field", func() {
+		Expect(HasStatusField(&withStatus{})).To(BeTrue())
+		Expect(HasStatusField(&withPlainStatus{})).To(BeTrue())
+	})
+
+	It("should detect objects without status", func() {
+		Expect(HasStatusField(&withoutStatus{})).To(BeFalse())
+	})
+})
+
+var _ = Describe("CopyStatus", func() {
+	It("should deep copy the status field", func() {
+		from := &withStatus{Spec: "from", Status: testStatus{Conditions: []string{"Ready"}}}
+		to := &withStatus{Spec: "to"}
+		Expect(CopyStatus(from, to)).To(BeTrue())
+		Expect(to.Spec).To(Equal("to"))
+		Expect(to.Status.Conditions).To(Equal([]string{"Ready"}))
+
+		from.Status.Conditions[0] = "changed"
+		Expect(to.Status.Conditions).To(Equal([]string{"Ready"}))
+	})
+
+	It("should copy a status field without DeepCopy method", func() {
+		to := &withPlainStatus{}
+		Expect(CopyStatus(&withPlainStatus{Status: "Ready"}, to)).To(BeTrue())
+		Expect(to.Status).To(Equal("Ready"))
+	})
+
+	It("should prefer CopyStatusTo", func() {
+		to := &withCopyStatusTo{}
+		Expect(CopyStatus(&withCopyStatusTo{}, to)).To(BeTrue())
+		Expect(to.Status.Conditions).To(Equal([]string{"explicit"}))
+	})
+
+	It("should not copy between different status types", func() {
+		to := &withPlainStatus{}
+		Expect(CopyStatus(&withStatus{}, to)).To(BeFalse())
+	})
+
+	It("should not copy without status", func() {
+		Expect(CopyStatus(&withoutStatus{}, &withoutStatus{})).To(BeFalse())
+	})
+})
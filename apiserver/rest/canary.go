@@ -0,0 +1,168 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"go.opendefense.cloud/kit/apiserver/audit"
+)
+
+const (
+	// CanaryHeader is the request header with which clients opt into the canary implementation
+	// of resources selected by CanaryRequested, by setting it to "true".
+	CanaryHeader = "X-Kit-Canary"
+	// AuditAnnotationVariant records whether a request has been served by the stable or the canary
+	// implementation of a resource.
+	AuditAnnotationVariant = "kit.opendefense.cloud/variant"
+
+	variantStable = "stable"
+	variantCanary = "canary"
+)
+
+// CanarySelector returns true if the request in ctx is served by the canary implementation of
+// a resource.
+type CanarySelector func(ctx context.Context) bool
+
+type canaryRequestedKey struct{}
+
+// WithCanaryRequested returns a copy of ctx recording whether the client opted into canary
+// implementations with CanaryHeader.
+func WithCanaryRequested(ctx context.Context, requested bool) context.Context {
+	return context.WithValue(ctx, canaryRequestedKey{}, requested)
+}
+
+// CanaryRequested selects the requests of clients which opted into canary implementations with
+// CanaryHeader.
+func CanaryRequested(ctx context.Context) bool {
+	requested, _ := ctx.Value(canaryRequestedKey{}).(bool)
+
+	return requested
+}
+
+// CanaryUsers selects the requests of the named users.
+func CanaryUsers(names ...string) CanarySelector {
+	return func(ctx context.Context) bool {
+		u, ok := request.UserFrom(ctx)
+
+		return ok && slices.Contains(names, u.GetName())
+	}
+}
+
+// CanaryGroups selects the requests of members of any of the groups.
+func CanaryGroups(groups ...string) CanarySelector {
+	return func(ctx context.Context) bool {
+		u, ok := request.UserFrom(ctx)
+
+		return ok && slices.ContainsFunc(u.GetGroups(), func(g string) bool { return slices.Contains(groups, g) })
+	}
+}
+
+// canaryStrategy serves requests with the canary strategy if they are selected, and with the
+// stable strategy otherwise. Methods without a request context are served by the stable strategy.
+type canaryStrategy struct {
+	Strategy
+	canary   Strategy
+	selector CanarySelector
+	gr       schema.GroupResource
+}
+
+var (
+	_ Strategy                        = &canaryStrategy{}
+	_ rest.RESTGracefulDeleteStrategy = &canaryStrategy{}
+)
+
+// NewCanaryStrategy returns a Strategy serving the requests selected by selector with canary, e.g.
+// a new implementation rolled out to selected clients first, and all other requests with stable.
+// Requests are counted in the kit_canary_requests_total metric and their audit events are
+// annotated with the variant serving them. Both strategies must serve the same object type.
+func NewCanaryStrategy(stable, canary Strategy, selector CanarySelector, gr schema.GroupResource) Strategy {
+	return &canaryStrategy{Strategy: stable, canary: canary, selector: selector, gr: gr}
+}
+
+// pick returns the strategy serving the request in ctx. The operation is counted if it is set.
+func (s *canaryStrategy) pick(ctx context.Context, operation string) Strategy {
+	variant, strategy := variantStable, s.Strategy
+	if s.selector(ctx) {
+		variant, strategy = variantCanary, s.canary
+	}
+	if operation != "" {
+		canaryRequests.WithLabelValues(s.gr.Group, s.gr.Resource, operation, variant).Inc()
+		audit.AddAnnotation(ctx, AuditAnnotationVariant, variant)
+	}
+
+	return strategy
+}
+
+func (s *canaryStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	s.pick(ctx, "create").PrepareForCreate(ctx, obj)
+}
+
+func (s *canaryStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return s.pick(ctx, "").Validate(ctx, obj)
+}
+
+func (s *canaryStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return s.pick(ctx, "").WarningsOnCreate(ctx, obj)
+}
+
+func (s *canaryStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	s.pick(ctx, "update").PrepareForUpdate(ctx, obj, old)
+}
+
+func (s *canaryStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return s.pick(ctx, "").ValidateUpdate(ctx, obj, old)
+}
+
+func (s *canaryStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return s.pick(ctx, "").WarningsOnUpdate(ctx, obj, old)
+}
+
+func (s *canaryStrategy) ConvertToTable(ctx context.Context, obj runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
+	return s.pick(ctx, "").ConvertToTable(ctx, obj, tableOptions)
+}
+
+// CheckGracefulDelete delegates to the selected strategy if it deletes gracefully.
+func (s *canaryStrategy) CheckGracefulDelete(ctx context.Context, obj runtime.Object, options *metav1.DeleteOptions) bool {
+	if g, ok := s.pick(ctx, "delete").(rest.RESTGracefulDeleteStrategy); ok {
+		return g.CheckGracefulDelete(ctx, obj, options)
+	}
+
+	return false
+}
+
+// ShortNames returns the short names of the stable strategy.
+func (s *canaryStrategy) ShortNames() []string {
+	if sn, ok := s.Strategy.(ShortNamesProvider); ok {
+		return sn.ShortNames()
+	}
+
+	return nil
+}
+
+// Categories returns the categories of the stable strategy.
+func (s *canaryStrategy) Categories() []string {
+	if c, ok := s.Strategy.(CategoriesProvider); ok {
+		return c.Categories()
+	}
+
+	return nil
+}
+
+// GetSingularName returns the singular name of the stable strategy.
+func (s *canaryStrategy) GetSingularName() string {
+	if sn, ok := s.Strategy.(SingularNameProvider); ok {
+		return sn.GetSingularName()
+	}
+
+	return ""
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	k8saudit "k8s.io/apiserver/pkg/audit"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/component-base/metrics/testutil"
+
+	"go.opendefense.cloud/kit/apiserver/audit"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// markingStrategy marks the status of created objects as canary and forbids all updates.
+type markingStrategy struct {
+	DefaultStrategy
+}
+
+func (markingStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	obj.(*testObj).Status = "canary"
+}
+
+func (markingStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return field.ErrorList{field.Forbidden(field.NewPath("spec"), "updates are not supported")}
+}
+
+var _ = Describe("Canary strategy", func() {
+	var (
+		gr       = schema.GroupResource{Group: "test.opendefense.cloud", Resource: "testobjs"}
+		stable   *DefaultStrategy
+		strategy Strategy
+	)
+
+	requests := func(operation, variant string) float64 {
+		GinkgoHelper()
+		v, err := testutil.GetCounterMetricValue(canaryRequests.WithLabelValues(gr.Group, gr.Resource, operation, variant))
+		Expect(err).NotTo(HaveOccurred())
+
+		return v
+	}
+
+	BeforeEach(func() {
+		RegisterMetrics()
+		canaryRequests.Reset()
+		stable = NewDefaultStrategy(&categorized{}, runtime.NewScheme(), gr)
+		strategy = NewCanaryStrategy(stable, markingStrategy{*stable}, CanaryRequested, gr)
+	})
+
+	It("should serve selected requests with the canary strategy", func() {
+		ctx := WithCanaryRequested(k8saudit.WithAuditContext(context.Background()), true)
+		obj := &testObj{}
+		strategy.PrepareForCreate(ctx, obj)
+		Expect(obj.Status).To(Equal("canary"))
+		Expect(strategy.ValidateUpdate(ctx, obj, &testObj{})).To(ConsistOf(HaveField("Type", field.ErrorTypeForbidden)))
+		Expect(audit.Annotations(ctx)).To(HaveKeyWithValue(AuditAnnotationVariant, "canary"))
+		Expect(requests("create", "canary")).To(Equal(1.0))
+	})
+
+	It("should serve other requests with the stable strategy", func() {
+		ctx := k8saudit.WithAuditContext(context.Background())
+		obj := &testObj{}
+		strategy.PrepareForCreate(ctx, obj)
+		Expect(obj.Status).To(BeEmpty())
+		Expect(obj.Flag).To(BeTrue())
+		// testObj always reports an invalid spec.
+		Expect(strategy.ValidateUpdate(ctx, obj, &testObj{})).To(ConsistOf(HaveField("Type", field.ErrorTypeInvalid)))
+		strategy.PrepareForUpdate(ctx, obj, &testObj{})
+		Expect(audit.Annotations(ctx)).To(HaveKeyWithValue(AuditAnnotationVariant, "stable"))
+		Expect(requests("create", "stable")).To(Equal(1.0))
+		Expect(requests("update", "stable")).To(Equal(1.0))
+	})
+
+	It("should keep the names of the stable strategy", func() {
+		Expect(strategy.(CategoriesProvider).Categories()).To(Equal([]string{"all"}))
+		Expect(strategy.(ShortNamesProvider).ShortNames()).To(BeEmpty())
+		Expect(strategy.(SingularNameProvider).GetSingularName()).To(Equal(stable.GetSingularName()))
+	})
+
+	It("should delete gracefully if the selected strategy does", func() {
+		stable.SoftDeleteRetention = time.Hour
+		strategy = NewCanaryStrategy(stable, markingStrategy{}, CanaryRequested, gr)
+		graceful := strategy.(interface {
+			CheckGracefulDelete(context.Context, runtime.Object, *metav1.DeleteOptions) bool
+		})
+		Expect(graceful.CheckGracefulDelete(context.Background(), &testObj{}, &metav1.DeleteOptions{})).To(BeTrue())
+		Expect(graceful.CheckGracefulDelete(WithCanaryRequested(context.Background(), true), &testObj{}, &metav1.DeleteOptions{})).To(BeFalse())
+	})
+})
+
+var _ = Describe("Canary selectors", func() {
+	ctx := request.WithUser(context.Background(), &user.DefaultInfo{Name: "alice", Groups: []string{"devs"}})
+
+	It("should select users", func() {
+		Expect(CanaryUsers("alice")(ctx)).To(BeTrue())
+		Expect(CanaryUsers("bob")(ctx)).To(BeFalse())
+		Expect(CanaryUsers("alice")(context.Background())).To(BeFalse())
+	})
+
+	It("should select groups", func() {
+		Expect(CanaryGroups("ops", "devs")(ctx)).To(BeTrue())
+		Expect(CanaryGroups("ops")(ctx)).To(BeFalse())
+	})
+
+	It("should select clients opting in", func() {
+		Expect(CanaryRequested(ctx)).To(BeFalse())
+		Expect(CanaryRequested(WithCanaryRequested(ctx, true))).To(BeTrue())
+	})
+})
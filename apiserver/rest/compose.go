@@ -0,0 +1,240 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+// PrepareForCreateFunc normalizes an object before it is created. A returned error rejects the
+// request, like an error of PrepareForCreaterWithError.
+type PrepareForCreateFunc[T runtime.Object] func(ctx context.Context, obj T) error
+
+// PrepareForUpdateFunc normalizes an object before it is updated. A returned error rejects the
+// request, like an error of PrepareForUpdaterWithError.
+type PrepareForUpdateFunc[T runtime.Object] func(ctx context.Context, obj, old T) error
+
+// ValidateFunc validates an object on create.
+type ValidateFunc[T runtime.Object] func(ctx context.Context, obj T) field.ErrorList
+
+// ValidateUpdateFunc validates an object on update.
+type ValidateUpdateFunc[T runtime.Object] func(ctx context.Context, obj, old T) field.ErrorList
+
+// ChainPrepareForCreate returns a PrepareForCreateFunc calling fns in order. It stops at the first error.
+func ChainPrepareForCreate[T runtime.Object](fns ...PrepareForCreateFunc[T]) PrepareForCreateFunc[T] {
+	return func(ctx context.Context, obj T) error {
+		for _, fn := range fns {
+			if err := fn(ctx, obj); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// ChainPrepareForUpdate returns a PrepareForUpdateFunc calling fns in order. It stops at the first error.
+func ChainPrepareForUpdate[T runtime.Object](fns ...PrepareForUpdateFunc[T]) PrepareForUpdateFunc[T] {
+	return func(ctx context.Context, obj, old T) error {
+		for _, fn := range fns {
+			if err := fn(ctx, obj, old); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// ComposeValidators returns a ValidateFunc returning the errors of all fns.
+func ComposeValidators[T runtime.Object](fns ...ValidateFunc[T]) ValidateFunc[T] {
+	return func(ctx context.Context, obj T) field.ErrorList {
+		errs := field.ErrorList{}
+		for _, fn := range fns {
+			errs = append(errs, fn(ctx, obj)...)
+		}
+
+		return errs
+	}
+}
+
+// ComposeUpdateValidators returns a ValidateUpdateFunc returning the errors of all fns.
+func ComposeUpdateValidators[T runtime.Object](fns ...ValidateUpdateFunc[T]) ValidateUpdateFunc[T] {
+	return func(ctx context.Context, obj, old T) field.ErrorList {
+		errs := field.ErrorList{}
+		for _, fn := range fns {
+			errs = append(errs, fn(ctx, obj, old)...)
+		}
+
+		return errs
+	}
+}
+
+// Hooks are cross-cutting behaviors of objects of type T, e.g. audit stamps or quota checks,
+// layered on top of a Strategy with Compose. Unset hooks are skipped.
+type Hooks[T runtime.Object] struct {
+	// PrepareForCreate runs after the PrepareForCreate of the strategy.
+	PrepareForCreate PrepareForCreateFunc[T]
+	// PrepareForUpdate runs after the PrepareForUpdate of the strategy.
+	PrepareForUpdate PrepareForUpdateFunc[T]
+	// Validate runs after the Validate of the strategy, its errors are added to those of the strategy.
+	Validate ValidateFunc[T]
+	// ValidateUpdate runs after the ValidateUpdate of the strategy, its errors are added to those
+	// of the strategy.
+	ValidateUpdate ValidateUpdateFunc[T]
+}
+
+// Compose returns strategy with the hooks added, so cross-cutting behaviors don't require a
+// custom strategy:
+//
+//	rest.Compose(strategy, rest.Hooks[*v1alpha1.Bar]{
+//	    PrepareForCreate: rest.ChainPrepareForCreate(stampCreator, checkQuota),
+//	    Validate:         rest.ComposeValidators(validateOwner, validateQuota),
+//	})
+//
+// The hooks are skipped once a prepare hook failed, as the request is rejected anyway. Errors of
+// the prepare hooks are only returned by stores created by NewStore.
+func Compose[T runtime.Object](strategy Strategy, hooks Hooks[T]) Strategy {
+	return &composedStrategy[T]{Strategy: strategy, hooks: hooks}
+}
+
+// composedStrategy runs hooks after the methods of the strategy.
+type composedStrategy[T runtime.Object] struct {
+	Strategy
+	hooks Hooks[T]
+}
+
+var (
+	_ Strategy                        = &composedStrategy[runtime.Object]{}
+	_ rest.RESTGracefulDeleteStrategy = &composedStrategy[runtime.Object]{}
+)
+
+// typed returns obj as T, or an error if it is of another type.
+func typed[T runtime.Object](obj runtime.Object) (T, error) {
+	t, ok := obj.(T)
+	if !ok {
+		return t, fmt.Errorf("%w %T", ErrUnexpectedType, obj)
+	}
+
+	return t, nil
+}
+
+func (s *composedStrategy[T]) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	s.Strategy.PrepareForCreate(ctx, obj)
+	if s.hooks.PrepareForCreate == nil || prepareErrorFrom(ctx) != nil {
+		return
+	}
+	t, err := typed[T](obj)
+	if err == nil {
+		err = s.hooks.PrepareForCreate(ctx, t)
+	}
+	rejectPrepare(ctx, "PrepareForCreate", err)
+}
+
+func (s *composedStrategy[T]) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	s.Strategy.PrepareForUpdate(ctx, obj, old)
+	if s.hooks.PrepareForUpdate == nil || prepareErrorFrom(ctx) != nil {
+		return
+	}
+	t, err := typed[T](obj)
+	if err == nil {
+		var o T
+		if o, err = typed[T](old); err == nil {
+			err = s.hooks.PrepareForUpdate(ctx, t, o)
+		}
+	}
+	rejectPrepare(ctx, "PrepareForUpdate", err)
+}
+
+// Validate adds the errors of the hook to those of the strategy. Rejections by the hook are
+// counted in the kit_validation_rejections_total metric.
+func (s *composedStrategy[T]) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	errs := s.Strategy.Validate(ctx, obj)
+	if s.hooks.Validate == nil || prepareErrorFrom(ctx) != nil {
+		return errs
+	}
+	t, err := typed[T](obj)
+	if err != nil {
+		return append(errs, field.InternalError(nil, err))
+	}
+	hookErrs := s.hooks.Validate(ctx, t)
+	recordValidationRejections(ctx, hookErrs)
+
+	return append(errs, hookErrs...)
+}
+
+// ValidateUpdate adds the errors of the hook to those of the strategy. Rejections by the hook
+// are counted in the kit_validation_rejections_total metric.
+func (s *composedStrategy[T]) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	errs := s.Strategy.ValidateUpdate(ctx, obj, old)
+	if s.hooks.ValidateUpdate == nil || prepareErrorFrom(ctx) != nil {
+		return errs
+	}
+	t, err := typed[T](obj)
+	if err != nil {
+		return append(errs, field.InternalError(nil, err))
+	}
+	o, err := typed[T](old)
+	if err != nil {
+		return append(errs, field.InternalError(nil, err))
+	}
+	hookErrs := s.hooks.ValidateUpdate(ctx, t, o)
+	recordValidationRejections(ctx, hookErrs)
+
+	return append(errs, hookErrs...)
+}
+
+// preparesMayFail returns true if prepare hooks are set, whose errors the store needs to collect.
+func (s *composedStrategy[T]) preparesMayFail() bool {
+	return s.hooks.PrepareForCreate != nil || s.hooks.PrepareForUpdate != nil || strategyPreparesMayFail(s.Strategy)
+}
+
+// CheckGracefulDelete delegates to the strategy if it deletes gracefully.
+func (s *composedStrategy[T]) CheckGracefulDelete(ctx context.Context, obj runtime.Object, options *metav1.DeleteOptions) bool {
+	if g, ok := s.Strategy.(rest.RESTGracefulDeleteStrategy); ok {
+		return g.CheckGracefulDelete(ctx, obj, options)
+	}
+
+	return false
+}
+
+// ShortNames returns the short names of the strategy.
+func (s *composedStrategy[T]) ShortNames() []string {
+	if sn, ok := s.Strategy.(ShortNamesProvider); ok {
+		return sn.ShortNames()
+	}
+
+	return nil
+}
+
+// Categories returns the categories of the strategy.
+func (s *composedStrategy[T]) Categories() []string {
+	if c, ok := s.Strategy.(CategoriesProvider); ok {
+		return c.Categories()
+	}
+
+	return nil
+}
+
+// GetSingularName returns the singular name of the strategy.
+func (s *composedStrategy[T]) GetSingularName() string {
+	if sn, ok := s.Strategy.(SingularNameProvider); ok {
+		return sn.GetSingularName()
+	}
+
+	return ""
+}
+
+// strategyPreparesMayFail returns true if the prepare hooks added to strategy by Compose may fail.
+func strategyPreparesMayFail(strategy Strategy) bool {
+	p, ok := strategy.(interface{ preparesMayFail() bool })
+
+	return ok && p.preparesMayFail()
+}
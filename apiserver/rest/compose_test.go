@@ -0,0 +1,91 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"errors"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Composed strategy", func() {
+	var (
+		calls []string
+		inner *DefaultStrategy
+	)
+
+	stamp := func(name string) PrepareForCreateFunc[*testObj] {
+		return func(_ context.Context, obj *testObj) error {
+			calls = append(calls, name)
+
+			return nil
+		}
+	}
+	require := func(name string) ValidateFunc[*testObj] {
+		return func(_ context.Context, obj *testObj) field.ErrorList {
+			if obj.Labels[name] == "" {
+				return field.ErrorList{field.Required(field.NewPath("metadata", "labels").Key(name), "")}
+			}
+
+			return nil
+		}
+	}
+
+	BeforeEach(func() {
+		calls = nil
+		inner = NewDefaultStrategy(&categorized{}, runtime.NewScheme(), schema.GroupResource{Group: "arc", Resource: "testobjs"})
+	})
+
+	It("should run the prepare hooks in order until one fails", func() {
+		strategy := Compose(inner, Hooks[*testObj]{
+			PrepareForCreate: ChainPrepareForCreate(stamp("a"), func(context.Context, *testObj) error {
+				return errors.New("quota exceeded")
+			}, stamp("b")),
+		})
+		Expect(strategyPreparesMayFail(strategy)).To(BeTrue())
+		ctx, p := withPrepareError(context.Background())
+		strategy.PrepareForCreate(ctx, &testObj{})
+		Expect(calls).To(Equal([]string{"a"}))
+		Expect(p.get()).To(MatchError("quota exceeded"))
+		Expect(strategy.Validate(ctx, &testObj{})).To(ConsistOf(HaveField("Type", field.ErrorTypeInternal)))
+	})
+
+	It("should add the errors of all validators to those of the strategy", func() {
+		strategy := Compose(inner, Hooks[*testObj]{
+			Validate: ComposeValidators(require("team"), require("owner")),
+			ValidateUpdate: ComposeUpdateValidators(func(_ context.Context, obj, old *testObj) field.ErrorList {
+				if obj.Flag != old.Flag {
+					return field.ErrorList{field.Forbidden(field.NewPath("flag"), "is immutable")}
+				}
+
+				return nil
+			}),
+		})
+		Expect(strategyPreparesMayFail(strategy)).To(BeFalse())
+		obj := &testObj{}
+		obj.Labels = map[string]string{"team": "arc"}
+		// testObj always reports an invalid spec.
+		Expect(strategy.Validate(context.Background(), obj)).
+			To(ConsistOf(HaveField("Field", "spec"), HaveField("Field", "metadata.labels[owner]")))
+		Expect(strategy.ValidateUpdate(context.Background(), &testObj{Flag: true}, &testObj{})).
+			To(ConsistOf(HaveField("Field", "spec"), HaveField("Type", field.ErrorTypeForbidden)))
+	})
+
+	It("should reject objects of other types", func() {
+		strategy := Compose(inner, Hooks[*testObj]{Validate: require("team")})
+		Expect(strategy.Validate(context.Background(), &statusTestObj{})).
+			To(ConsistOf(HaveField("Type", field.ErrorTypeInternal)))
+	})
+
+	It("should keep the optional interfaces of the strategy", func() {
+		strategy := Compose(inner, Hooks[*testObj]{})
+		Expect(strategy.(CategoriesProvider).Categories()).To(Equal([]string{"all"}))
+	})
+})
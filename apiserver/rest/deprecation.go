@@ -0,0 +1,62 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	"go.opendefense.cloud/kit/apiserver/audit"
+)
+
+// AuditAnnotationDeprecatedFields records the deprecated fields set by a write, separated by commas.
+const AuditAnnotationDeprecatedFields = "kit.opendefense.cloud/deprecated-fields"
+
+// deprecationWarnings records the deprecated fields set in obj and returns warnings for the ones
+// not set in old, which is nil on create. Fields kept by an update, e.g. of the status, are
+// recorded, but not warned about again.
+func deprecationWarnings(ctx context.Context, obj, old runtime.Object) []string {
+	d, ok := obj.(FieldDeprecator)
+	if !ok {
+		return nil
+	}
+	fields := d.DeprecatedFields()
+	if len(fields) == 0 {
+		return nil
+	}
+	previous := sets.New[string]()
+	if o, ok := old.(FieldDeprecator); ok {
+		for _, f := range o.DeprecatedFields() {
+			previous.Insert(f.Path.String())
+		}
+	}
+	group, resource := "", ""
+	if info, ok := request.RequestInfoFrom(ctx); ok {
+		group, resource = info.APIGroup, info.Resource
+	}
+	userAgent := UserAgentFrom(ctx)
+	paths := make([]string, 0, len(fields))
+	var warnings []string
+	for _, f := range fields {
+		path := f.Path.String()
+		paths = append(paths, path)
+		deprecatedFieldWrites.WithLabelValues(group, resource, path, userAgent).Inc()
+		if previous.Has(path) {
+			continue
+		}
+		warning := path + " is deprecated"
+		if f.Message != "" {
+			warning = fmt.Sprintf("%s: %s", warning, f.Message)
+		}
+		warnings = append(warnings, warning)
+	}
+	audit.AddAnnotation(ctx, AuditAnnotationDeprecatedFields, strings.Join(paths, ","))
+
+	return warnings
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	k8saudit "k8s.io/apiserver/pkg/audit"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/component-base/metrics/testutil"
+
+	"go.opendefense.cloud/kit/apiserver/audit"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// deprecatedObj deprecates its flag.
+type deprecatedObj struct {
+	testObj
+}
+
+// DeprecatedFields implements FieldDeprecator
+func (d *deprecatedObj) DeprecatedFields() []DeprecatedField {
+	if !d.Flag {
+		return nil
+	}
+
+	return []DeprecatedField{{Path: field.NewPath("flag"), Message: "use status instead"}}
+}
+
+var _ = Describe("Field deprecation", func() {
+	var ctx context.Context
+
+	writes := func() float64 {
+		GinkgoHelper()
+		v, err := testutil.GetCounterMetricValue(deprecatedFieldWrites.WithLabelValues("test.opendefense.cloud", "testobjs", "flag", "kubectl"))
+		Expect(err).NotTo(HaveOccurred())
+
+		return v
+	}
+
+	BeforeEach(func() {
+		RegisterMetrics()
+		deprecatedFieldWrites.Reset()
+		ctx = request.WithRequestInfo(k8saudit.WithAuditContext(context.Background()), &request.RequestInfo{
+			APIGroup: "test.opendefense.cloud",
+			Resource: "testobjs",
+		})
+		ctx = WithUserAgent(ctx, "kubectl/v1.35.0")
+	})
+
+	It("should warn about deprecated fields set on create", func() {
+		obj := &deprecatedObj{testObj{Flag: true}}
+		Expect(DefaultStrategy{}.WarningsOnCreate(ctx, obj)).To(Equal([]string{"flag is deprecated: use status instead"}))
+		Expect(audit.Annotations(ctx)).To(HaveKeyWithValue(AuditAnnotationDeprecatedFields, "flag"))
+		Expect(writes()).To(Equal(1.0))
+	})
+
+	It("should only warn about deprecated fields newly set by updates", func() {
+		obj, old := &deprecatedObj{testObj{Flag: true}}, &deprecatedObj{}
+		Expect(DefaultStrategy{}.WarningsOnUpdate(ctx, obj, old)).To(HaveLen(1))
+		Expect(DefaultStrategy{}.WarningsOnUpdate(ctx, obj, obj)).To(BeEmpty())
+		Expect(writes()).To(Equal(2.0))
+	})
+
+	It("should ignore objects without deprecated fields set", func() {
+		Expect(DefaultStrategy{}.WarningsOnCreate(ctx, &deprecatedObj{})).To(BeEmpty())
+		Expect(DefaultStrategy{}.WarningsOnCreate(ctx, &testObj{Flag: true})).To(BeEmpty())
+		Expect(audit.Annotations(ctx)).NotTo(HaveKey(AuditAnnotationDeprecatedFields))
+		Expect(writes()).To(BeZero())
+	})
+})
@@ -0,0 +1,140 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"go.opendefense.cloud/kit/apiserver/diff"
+)
+
+// maxDiffBodyBytes limits the size of the objects submitted to the diff subresource, like the
+// request body limit of the generic API server.
+const maxDiffBodyBytes = 3 * 1024 * 1024
+
+// diffIgnoredFields are the fields set by the server on create, which submitted manifests lack.
+var diffIgnoredFields = []string{
+	"metadata.uid",
+	"metadata.creationTimestamp",
+	"metadata.deletionTimestamp",
+	"metadata.deletionGracePeriodSeconds",
+}
+
+// ObjectDiff is the response of the diff subresource.
+type ObjectDiff struct {
+	// Exists is false if the object does not exist, all fields of the submitted object are
+	// reported as added then.
+	Exists bool `json:"exists"`
+	// Changes are the changes of the stored object by the submitted object, ordered by path.
+	Changes diff.Diff `json:"changes"`
+}
+
+// NewDiffStore returns the storage of the diff subresource of store, which responds to a POST
+// of an object with the ObjectDiff between the stored object and the submitted object after
+// defaulting, the hooks of the strategy and validation, without persisting it. CI pipelines
+// preview changes with it more precisely than client-side diffs. Objects are decoded from the
+// request body with decoder, e.g. the universal decoder of the codecs of the server. Admission
+// is not run.
+func NewDiffStore(store rest.Storage, decoder runtime.Decoder) rest.Storage {
+	return &diffStore{store: Unwrap(store), decoder: decoder}
+}
+
+// diffStore serves the diff subresource.
+type diffStore struct {
+	store   *genericregistry.Store
+	decoder runtime.Decoder
+}
+
+var (
+	_ rest.Connecter       = &diffStore{}
+	_ rest.StorageMetadata = &diffStore{}
+)
+
+func (s *diffStore) New() runtime.Object { return s.store.New() }
+
+func (s *diffStore) Destroy() {}
+
+func (s *diffStore) NamespaceScoped() bool { return s.store.NamespaceScoped() }
+
+// ConnectMethods returns the methods served by the subresource.
+func (s *diffStore) ConnectMethods() []string { return []string{http.MethodPost} }
+
+// ProducesMIMETypes returns the media type of the ObjectDiff.
+func (s *diffStore) ProducesMIMETypes(string) []string { return []string{"application/json"} }
+
+// ProducesObject returns nil, the ObjectDiff has no OpenAPI definition.
+func (s *diffStore) ProducesObject(string) any { return nil }
+
+// NewConnectOptions returns nil, the subresource has no options.
+func (s *diffStore) NewConnectOptions() (runtime.Object, bool, string) { return nil, false, "" }
+
+// Connect returns a handler responding with the diff of the named object.
+func (s *diffStore) Connect(ctx context.Context, name string, _ runtime.Object, responder rest.Responder) (http.Handler, error) {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, maxDiffBodyBytes))
+		if err != nil {
+			if tooLarge := (*http.MaxBytesError)(nil); errors.As(err, &tooLarge) {
+				responder.Error(apierrors.NewRequestEntityTooLargeError(err.Error()))
+				return
+			}
+			responder.Error(apierrors.NewBadRequest(err.Error()))
+			return
+		}
+		obj, _, err := s.decoder.Decode(body, nil, s.store.New())
+		if err != nil {
+			responder.Error(apierrors.NewBadRequest(err.Error()))
+			return
+		}
+		// Like stored objects, the submitted object is compared without its kind.
+		obj.GetObjectKind().SetGroupVersionKind(schema.GroupVersionKind{})
+		d, err := s.diff(ctx, name, obj)
+		if err != nil {
+			responder.Error(err)
+			return
+		}
+		data, err := json.Marshal(d)
+		if err != nil {
+			responder.Error(apierrors.NewInternalError(err))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(data)
+	}), nil
+}
+
+// diff returns the diff between the stored object of name and obj as it would be written.
+func (s *diffStore) diff(ctx context.Context, name string, obj runtime.Object) (*ObjectDiff, error) {
+	old, err := s.store.Get(ctx, name, &metav1.GetOptions{})
+	exists := err == nil
+	switch {
+	case apierrors.IsNotFound(err):
+		if err := prepareCreate(ctx, s.store, name, obj); err != nil {
+			return nil, err
+		}
+		old = s.store.New()
+	case err != nil:
+		return nil, err
+	default:
+		if err := prepareUpdate(ctx, s.store, name, obj, old); err != nil {
+			return nil, err
+		}
+	}
+	changes, err := diff.Objects(old, obj, diff.WithIgnoredFields(diffIgnoredFields...))
+	if err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+
+	return &ObjectDiff{Exists: exists, Changes: changes}, nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// validStrategy is a DefaultStrategy accepting all objects, as testObj always reports an
+// invalid spec.
+type validStrategy struct {
+	*DefaultStrategy
+}
+
+func (validStrategy) Validate(context.Context, runtime.Object) field.ErrorList { return nil }
+
+func (validStrategy) ValidateUpdate(context.Context, runtime.Object, runtime.Object) field.ErrorList {
+	return nil
+}
+
+var _ = Describe("diff subresource", func() {
+	var (
+		ctx   = genericapirequest.WithNamespace(context.Background(), "ns")
+		store rest.Connecter
+	)
+
+	BeforeEach(func() {
+		gv := schema.GroupVersion{Group: "arc", Version: "v1"}
+		scheme := runtime.NewScheme()
+		scheme.AddKnownTypes(gv, &testObj{}, &testObjList{})
+		gr := schema.GroupResource{Group: gv.Group, Resource: "testobjs"}
+		strategy := validStrategy{NewDefaultStrategy(&testObj{}, scheme, gr)}
+		mem := &memoryStorage{objs: map[string]*testObj{
+			"/testobjs/ns/test": {ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns", UID: "uid", ResourceVersion: "1"}},
+		}, rv: 1}
+		store = NewDiffStore(&genericregistry.Store{
+			NewFunc:                  func() runtime.Object { return &testObj{} },
+			NewListFunc:              func() runtime.Object { return &testObjList{} },
+			DefaultQualifiedResource: gr,
+			KeyRootFunc:              func(ctx context.Context) string { return "/testobjs" },
+			KeyFunc: func(ctx context.Context, name string) (string, error) {
+				return genericregistry.NamespaceKeyFunc(ctx, "/testobjs", name)
+			},
+			CreateStrategy: strategy,
+			UpdateStrategy: strategy,
+			Storage:        genericregistry.DryRunnableStorage{Storage: mem},
+		}, serializer.NewCodecFactory(scheme).UniversalDeserializer()).(rest.Connecter)
+	})
+
+	post := func(name, body string) (*ObjectDiff, error) {
+		responder := &fakeResponder{}
+		handler, err := store.Connect(ctx, name, nil, responder)
+		Expect(err).NotTo(HaveOccurred())
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+		if responder.err != nil {
+			return nil, responder.err
+		}
+		d := &ObjectDiff{}
+		Expect(json.Unmarshal(rec.Body.Bytes(), d)).To(Succeed())
+
+		return d, nil
+	}
+
+	It("should report the changes of the stored object", func() {
+		d, err := post("test", `{"apiVersion":"arc/v1","kind":"testObj","metadata":{"resourceVersion":"1","labels":{"team":"arc"}},"Status":"ready"}`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(d.Exists).To(BeTrue())
+		// The status is kept by the update.
+		Expect(d.Changes.Paths()).To(Equal([]string{"Flag", "metadata.labels.team"}))
+	})
+
+	It("should report all fields of objects which don't exist", func() {
+		d, err := post("new", `{"apiVersion":"arc/v1","kind":"testObj","Status":"ready"}`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(d.Exists).To(BeFalse())
+		Expect(d.Changes.Paths()).To(ContainElements("Flag", "Status", "metadata.name", "metadata.namespace"))
+	})
+
+	It("should reject invalid objects", func() {
+		_, err := post("test", `{`)
+		Expect(apierrors.IsBadRequest(err)).To(BeTrue())
+		_, err = post("test", `{"apiVersion":"arc/v1","kind":"testObj","metadata":{"name":"other"}}`)
+		Expect(apierrors.IsBadRequest(err)).To(BeTrue())
+	})
+})
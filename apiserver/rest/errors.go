@@ -0,0 +1,37 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import "errors"
+
+// The errors of the package are wrapped with the details of the failure, so callers check them
+// with errors.Is:
+//
+//	if errors.Is(err, rest.ErrUnknownVerb) {
+//	    ...
+//	}
+var (
+	// ErrStoreInit is returned by NewStore if the store cannot be set up, wrapping the cause.
+	ErrStoreInit = errors.New("cannot initialize store")
+	// ErrUnknownVerb is returned for verbs passed to WithVerbs which are not in AllVerbs.
+	ErrUnknownVerb = errors.New("unknown verb")
+	// ErrInvalidExternalValidator is returned for external validators which are incomplete or
+	// have an unknown failure policy.
+	ErrInvalidExternalValidator = errors.New("invalid external validator")
+	// ErrNotResourceObject is returned for objects which don't implement resource.Object, e.g. by
+	// GetAttrs.
+	ErrNotResourceObject = errors.New("object does not have metadata")
+	// ErrUnexpectedType is returned for objects of another type than the one served, e.g. to the
+	// hooks of a composed strategy.
+	ErrUnexpectedType = errors.New("unexpected object type")
+	// ErrFieldLabelNotSupported is returned for field selectors with fields which are not
+	// selectable, see SelectableFieldsProvider.
+	ErrFieldLabelNotSupported = errors.New("field label not supported")
+	// ErrStorageNotInitialized is returned for the statistics of storage created by
+	// LazyRESTOptionsGetter which has not been accessed yet.
+	ErrStorageNotInitialized = errors.New("storage has not been initialized yet")
+	// ErrStorageDestroyed is returned by storage created by LazyRESTOptionsGetter which is
+	// accessed after it has been destroyed.
+	ErrStorageDestroyed = errors.New("storage has been destroyed")
+)
@@ -0,0 +1,258 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/utils/clock"
+)
+
+// FailurePolicy defines how a request is handled if an external validator fails,
+// times out or its circuit breaker is open.
+type FailurePolicy string
+
+const (
+	// FailClosed rejects the request with an internal error.
+	FailClosed FailurePolicy = "FailClosed"
+	// FailOpen accepts the request as if the validator did not report any errors.
+	FailOpen FailurePolicy = "FailOpen"
+)
+
+// Default settings of an ExternalValidator.
+const (
+	DefaultExternalValidationTimeout = 3 * time.Second
+	DefaultFailureThreshold          = 5
+	DefaultCooldown                  = 30 * time.Second
+)
+
+// errCircuitOpen is returned for calls skipped while the circuit breaker is open.
+var errCircuitOpen = errors.New("circuit breaker is open")
+
+// ExternalValidateFunc validates obj using an external service, e.g. a policy engine.
+// old is nil on create. Validation errors reject the request, while a returned error is
+// handled according to the FailurePolicy of the ExternalValidator.
+type ExternalValidateFunc func(ctx context.Context, obj, old runtime.Object) (field.ErrorList, error)
+
+// ExternalValidator runs an ExternalValidateFunc with a deadline and a circuit breaker.
+// It is run after the validation of the strategy succeeded, on create and update including
+// updates of the status subresource. All validators of a store are run concurrently, so the
+// latency of a request is bounded by the largest Timeout.
+//
+// After FailureThreshold consecutive failures the circuit breaker opens and calls are skipped
+// for the Cooldown, as if they failed. An ExternalValidator may be passed to several stores:
+// each store runs its own copy with its own circuit breaker, which the status subresource
+// shares with its resource. Changes to the ExternalValidator after the store has been created
+// have no effect.
+type ExternalValidator struct {
+	// Name identifies the validator in errors and metrics.
+	Name string
+	// Validate is called to validate the object.
+	Validate ExternalValidateFunc
+	// Timeout bounds the duration of Validate. Defaults to DefaultExternalValidationTimeout.
+	Timeout time.Duration
+	// FailurePolicy defines how failures are handled. Defaults to FailClosed.
+	FailurePolicy FailurePolicy
+	// FailureThreshold is the number of consecutive failures which open the circuit breaker.
+	// Defaults to DefaultFailureThreshold.
+	FailureThreshold int
+	// Cooldown is the duration the circuit breaker stays open before a single call is tried
+	// again. Defaults to DefaultCooldown.
+	Cooldown time.Duration
+
+	clock    clock.PassiveClock
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// complete returns a copy of v with unset fields defaulted and a closed circuit breaker,
+// or an error for invalid fields. v itself is not modified, so it may be completed concurrently.
+func (v *ExternalValidator) complete() (*ExternalValidator, error) {
+	if v.Name == "" {
+		return nil, fmt.Errorf("%w: a name is required", ErrInvalidExternalValidator)
+	}
+	if v.Validate == nil {
+		return nil, fmt.Errorf("%w %q: a Validate func is required", ErrInvalidExternalValidator, v.Name)
+	}
+	c := &ExternalValidator{
+		Name:             v.Name,
+		Validate:         v.Validate,
+		Timeout:          v.Timeout,
+		FailurePolicy:    v.FailurePolicy,
+		FailureThreshold: v.FailureThreshold,
+		Cooldown:         v.Cooldown,
+		clock:            v.clock,
+	}
+	switch c.FailurePolicy {
+	case "":
+		c.FailurePolicy = FailClosed
+	case FailClosed, FailOpen:
+	default:
+		return nil, fmt.Errorf("%w %q: unknown failure policy %q", ErrInvalidExternalValidator, c.Name, c.FailurePolicy)
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = DefaultExternalValidationTimeout
+	}
+	if c.FailureThreshold <= 0 {
+		c.FailureThreshold = DefaultFailureThreshold
+	}
+	if c.Cooldown <= 0 {
+		c.Cooldown = DefaultCooldown
+	}
+	if c.clock == nil {
+		c.clock = clock.RealClock{}
+	}
+
+	return c, nil
+}
+
+// allow returns false while the circuit breaker is open. Once the cooldown elapsed,
+// a single call is allowed to probe whether the service recovered.
+func (v *ExternalValidator) allow() bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.failures < v.FailureThreshold {
+		return true
+	}
+	if v.probing || v.clock.Since(v.openedAt) < v.Cooldown {
+		return false
+	}
+	v.probing = true
+
+	return true
+}
+
+// done records the outcome of a call.
+func (v *ExternalValidator) done(err error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.probing = false
+	if err == nil {
+		v.failures = 0
+		return
+	}
+	v.failures++
+	if v.failures >= v.FailureThreshold {
+		v.openedAt = v.clock.Now()
+	}
+}
+
+// run validates obj and applies the failure policy.
+func (v *ExternalValidator) run(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	errs, err := v.call(ctx, obj, old)
+	switch {
+	case errors.Is(err, errCircuitOpen):
+		recordExternalValidation(v.Name, externalResultCircuitOpen)
+	case err != nil:
+		recordExternalValidation(v.Name, externalResultError)
+	case len(errs) > 0:
+		recordExternalValidation(v.Name, externalResultDenied)
+		return errs
+	default:
+		recordExternalValidation(v.Name, externalResultAllowed)
+		return nil
+	}
+	if v.FailurePolicy == FailOpen {
+		return nil
+	}
+
+	return field.ErrorList{field.InternalError(nil, fmt.Errorf("external validator %q failed: %w", v.Name, err))}
+}
+
+// call runs Validate with the configured timeout unless the circuit breaker is open.
+func (v *ExternalValidator) call(ctx context.Context, obj, old runtime.Object) (field.ErrorList, error) {
+	if !v.allow() {
+		return nil, errCircuitOpen
+	}
+	ctx, cancel := context.WithTimeout(ctx, v.Timeout)
+	defer cancel()
+
+	type result struct {
+		errs field.ErrorList
+		err  error
+	}
+	// Validate may ignore the context, so it is run asynchronously to keep the deadline.
+	ch := make(chan result, 1)
+	go func() {
+		errs, err := v.Validate(ctx, obj, old)
+		ch <- result{errs: errs, err: err}
+	}()
+
+	var res result
+	select {
+	case res = <-ch:
+	case <-ctx.Done():
+		res.err = ctx.Err()
+	}
+	v.done(res.err)
+
+	return res.errs, res.err
+}
+
+// runExternalValidators runs all validators concurrently and returns their combined errors.
+func runExternalValidators(ctx context.Context, validators []*ExternalValidator, obj, old runtime.Object) field.ErrorList {
+	results := make([]field.ErrorList, len(validators))
+	var wg sync.WaitGroup
+	for i, v := range validators {
+		wg.Go(func() {
+			// Validators get copies, as they run concurrently with each other.
+			var oldCopy runtime.Object
+			if old != nil {
+				oldCopy = old.DeepCopyObject()
+			}
+			results[i] = v.run(ctx, obj.DeepCopyObject(), oldCopy)
+		})
+	}
+	wg.Wait()
+
+	errs := field.ErrorList{}
+	for _, r := range results {
+		errs = append(errs, r...)
+	}
+
+	return errs
+}
+
+// externalValidationCreateStrategy runs external validators after the validation of
+// the wrapped strategy succeeded.
+type externalValidationCreateStrategy struct {
+	rest.RESTCreateStrategy
+	validators []*ExternalValidator
+}
+
+func (s *externalValidationCreateStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	if errs := s.RESTCreateStrategy.Validate(ctx, obj); len(errs) > 0 {
+		return errs
+	}
+	errs := runExternalValidators(ctx, s.validators, obj, nil)
+	recordValidationRejections(ctx, errs)
+
+	return errs
+}
+
+// externalValidationUpdateStrategy runs external validators after the validation of
+// the wrapped strategy succeeded.
+type externalValidationUpdateStrategy struct {
+	rest.RESTUpdateStrategy
+	validators []*ExternalValidator
+}
+
+func (s *externalValidationUpdateStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	if errs := s.RESTUpdateStrategy.ValidateUpdate(ctx, obj, old); len(errs) > 0 {
+		return errs
+	}
+	errs := runExternalValidators(ctx, s.validators, obj, old)
+	recordValidationRejections(ctx, errs)
+
+	return errs
+}
@@ -0,0 +1,151 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ExternalValidator", func() {
+	var (
+		ctx      = context.Background()
+		clock    *clocktesting.FakePassiveClock
+		calls    int
+		err      error
+		template *ExternalValidator
+		v        *ExternalValidator
+	)
+
+	BeforeEach(func() {
+		clock = clocktesting.NewFakePassiveClock(time.Now())
+		calls = 0
+		err = nil
+		template = &ExternalValidator{
+			Name: "policy",
+			Validate: func(ctx context.Context, obj, old runtime.Object) (field.ErrorList, error) {
+				calls++
+				if err != nil {
+					return nil, err
+				}
+				if o, ok := obj.(*testObj); ok && o.Flag {
+					return field.ErrorList{field.Forbidden(field.NewPath("flag"), "denied by policy")}, nil
+				}
+
+				return nil, nil
+			},
+			FailureThreshold: 2,
+			Cooldown:         time.Minute,
+			clock:            clock,
+		}
+		var completeErr error
+		v, completeErr = template.complete()
+		Expect(completeErr).NotTo(HaveOccurred())
+	})
+
+	It("should default unset fields of a copy", func() {
+		Expect(v.FailurePolicy).To(Equal(FailClosed))
+		Expect(v.Timeout).To(Equal(DefaultExternalValidationTimeout))
+		Expect(template.FailurePolicy).To(BeEmpty())
+		Expect(template.Timeout).To(BeZero())
+	})
+
+	It("should reject invalid configuration", func() {
+		_, completeErr := (&ExternalValidator{Name: "policy"}).complete()
+		Expect(completeErr).To(MatchError(ErrInvalidExternalValidator))
+		Expect(completeErr).To(MatchError(ContainSubstring("a Validate func is required")))
+		template.FailurePolicy = "Sometimes"
+		_, completeErr = template.complete()
+		Expect(completeErr).To(MatchError(ContainSubstring(`unknown failure policy "Sometimes"`)))
+	})
+
+	It("should give each completed copy its own circuit breaker", func() {
+		err = errors.New("connection refused")
+		v.run(ctx, &testObj{}, nil)
+		v.run(ctx, &testObj{}, nil)
+		Expect(v.run(ctx, &testObj{}, nil)).To(ConsistOf(HaveField("Detail", ContainSubstring("circuit breaker is open"))))
+
+		other, completeErr := template.complete()
+		Expect(completeErr).NotTo(HaveOccurred())
+		Expect(other.run(ctx, &testObj{}, nil)).To(ConsistOf(HaveField("Detail", ContainSubstring("connection refused"))))
+		Expect(calls).To(Equal(3))
+	})
+
+	It("should return the validation errors of the service", func() {
+		Expect(v.run(ctx, &testObj{}, nil)).To(BeEmpty())
+		Expect(v.run(ctx, &testObj{Flag: true}, nil)).To(ConsistOf(HaveField("Detail", "denied by policy")))
+	})
+
+	It("should reject requests if the service fails and the policy is FailClosed", func() {
+		err = errors.New("connection refused")
+		Expect(v.run(ctx, &testObj{}, nil)).To(ConsistOf(
+			HaveField("Detail", ContainSubstring(`external validator "policy" failed: connection refused`)),
+		))
+	})
+
+	It("should accept requests if the service fails and the policy is FailOpen", func() {
+		v.FailurePolicy = FailOpen
+		err = errors.New("connection refused")
+		Expect(v.run(ctx, &testObj{}, nil)).To(BeEmpty())
+	})
+
+	It("should bound the latency by the timeout", func() {
+		v.Timeout = 10 * time.Millisecond
+		release := make(chan struct{})
+		defer close(release)
+		v.Validate = func(ctx context.Context, obj, old runtime.Object) (field.ErrorList, error) {
+			<-release
+			return nil, nil
+		}
+		Expect(v.run(ctx, &testObj{}, nil)).To(ConsistOf(
+			HaveField("Detail", ContainSubstring("context deadline exceeded")),
+		))
+	})
+
+	It("should skip calls while the circuit breaker is open", func() {
+		err = errors.New("connection refused")
+		v.run(ctx, &testObj{}, nil)
+		v.run(ctx, &testObj{}, nil)
+		Expect(calls).To(Equal(2))
+
+		Expect(v.run(ctx, &testObj{}, nil)).To(ConsistOf(HaveField("Detail", ContainSubstring("circuit breaker is open"))))
+		Expect(calls).To(Equal(2))
+
+		// A single call probes the service once the cooldown elapsed.
+		clock.SetTime(clock.Now().Add(time.Minute))
+		err = nil
+		Expect(v.run(ctx, &testObj{}, nil)).To(BeEmpty())
+		Expect(v.run(ctx, &testObj{}, nil)).To(BeEmpty())
+		Expect(calls).To(Equal(4))
+	})
+
+	It("should reopen the circuit breaker if the probe fails", func() {
+		err = errors.New("connection refused")
+		v.run(ctx, &testObj{}, nil)
+		v.run(ctx, &testObj{}, nil)
+		clock.SetTime(clock.Now().Add(time.Minute))
+		v.run(ctx, &testObj{}, nil)
+		Expect(calls).To(Equal(3))
+		v.run(ctx, &testObj{}, nil)
+		Expect(calls).To(Equal(3))
+	})
+
+	It("should only run after the validation of the strategy succeeded", func() {
+		s := &externalValidationUpdateStrategy{RESTUpdateStrategy: DefaultStrategy{}, validators: []*ExternalValidator{v}}
+		Expect(s.ValidateUpdate(ctx, &testObj{Flag: true}, &testObj{})).To(ConsistOf(HaveField("Field", "spec")))
+		Expect(calls).To(BeZero())
+
+		c := &externalValidationCreateStrategy{RESTCreateStrategy: DefaultStrategy{}, validators: []*ExternalValidator{v}}
+		Expect(c.Validate(ctx, &testObjList{})).To(BeEmpty())
+		Expect(calls).To(Equal(1))
+	})
+})
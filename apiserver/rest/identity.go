@@ -0,0 +1,113 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/storagebackend"
+	"k8s.io/apiserver/pkg/storage/storagebackend/factory"
+	"k8s.io/client-go/tools/cache"
+)
+
+// IdentityFunc sets the identity of an object of the resource gr right before it is written to
+// storage on create, after admission and validation, e.g. a deterministic UID taken from an
+// annotation when importing objects from another server, or a ULID in a dedicated annotation:
+//
+//	func(ctx context.Context, gr schema.GroupResource, obj metav1.Object) error {
+//	    if uid, ok := obj.GetAnnotations()["import.foo.opendefense.cloud/uid"]; ok {
+//	        obj.SetUID(types.UID(uid))
+//	    }
+//	    return nil
+//	}
+//
+// It replaces the random UID set by the server and must not change the name or namespace. Errors fail the create and should be API errors,
+// e.g. apierrors.NewBadRequest. Generated names are customized per type, see NameGenerator.
+type IdentityFunc func(ctx context.Context, gr schema.GroupResource, obj metav1.Object) error
+
+// IdentityRESTOptionsGetter wraps the given getter so that the storage created through it sets
+// the identity of created objects with fn, including objects created by updates. Dry-run creates
+// are not written to storage and keep the UID set by the server.
+func IdentityRESTOptionsGetter(delegate generic.RESTOptionsGetter, fn IdentityFunc) generic.RESTOptionsGetter {
+	return &identityRESTOptionsGetter{delegate: delegate, fn: fn}
+}
+
+type identityRESTOptionsGetter struct {
+	delegate generic.RESTOptionsGetter
+	fn       IdentityFunc
+}
+
+// GetRESTOptions returns the delegate's options with a decorator setting the identity of created objects.
+func (g *identityRESTOptionsGetter) GetRESTOptions(gr schema.GroupResource, example runtime.Object) (generic.RESTOptions, error) {
+	opts, err := g.delegate.GetRESTOptions(gr, example)
+	if err != nil {
+		return opts, err
+	}
+	decorator := opts.Decorator
+	if decorator == nil {
+		decorator = generic.UndecoratedStorage
+	}
+	opts.Decorator = func(
+		config *storagebackend.ConfigForResource,
+		resourcePrefix string,
+		keyFunc func(obj runtime.Object) (string, error),
+		newFunc func() runtime.Object,
+		newListFunc func() runtime.Object,
+		getAttrsFunc storage.AttrFunc,
+		trigger storage.IndexerFuncs,
+		indexers *cache.Indexers) (storage.Interface, factory.DestroyFunc, error) {
+		s, destroy, err := decorator(config, resourcePrefix, keyFunc, newFunc, newListFunc, getAttrsFunc, trigger, indexers)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return &identityStorage{Interface: s, gr: gr, fn: g.fn}, destroy, nil
+	}
+
+	return opts, nil
+}
+
+// identityStorage sets the identity of objects before they are created.
+type identityStorage struct {
+	storage.Interface
+	gr schema.GroupResource
+	fn IdentityFunc
+}
+
+func (s *identityStorage) Create(ctx context.Context, key string, obj, out runtime.Object, ttl uint64) error {
+	if err := s.setIdentity(ctx, obj); err != nil {
+		return err
+	}
+
+	return s.Interface.Create(ctx, key, obj, out, ttl)
+}
+
+func (s *identityStorage) GuaranteedUpdate(ctx context.Context, key string, destination runtime.Object, ignoreNotFound bool,
+	preconditions *storage.Preconditions, tryUpdate storage.UpdateFunc, cachedExistingObject runtime.Object) error {
+	return s.Interface.GuaranteedUpdate(ctx, key, destination, ignoreNotFound, preconditions,
+		func(input runtime.Object, res storage.ResponseMeta) (runtime.Object, *uint64, error) {
+			obj, ttl, err := tryUpdate(input, res)
+			// Objects which don't exist yet have no resource version and are created by the update.
+			if err != nil || res.ResourceVersion != 0 {
+				return obj, ttl, err
+			}
+
+			return obj, ttl, s.setIdentity(ctx, obj)
+		}, cachedExistingObject)
+}
+
+func (s *identityStorage) setIdentity(ctx context.Context, obj runtime.Object) error {
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+
+	return s.fn(ctx, s.gr, m)
+}
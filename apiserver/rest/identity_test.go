@@ -0,0 +1,98 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/storagebackend"
+	"k8s.io/apiserver/pkg/storage/storagebackend/factory"
+	"k8s.io/client-go/tools/cache"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// writingStorage records the last written object. Updates are served with the resource version
+// rv, zero for objects which don't exist yet.
+type writingStorage struct {
+	storage.Interface
+	written runtime.Object
+	rv      uint64
+}
+
+func (w *writingStorage) Create(_ context.Context, _ string, obj, _ runtime.Object, _ uint64) error {
+	w.written = obj
+
+	return nil
+}
+
+func (w *writingStorage) GuaranteedUpdate(_ context.Context, _ string, _ runtime.Object, _ bool,
+	_ *storage.Preconditions, tryUpdate storage.UpdateFunc, _ runtime.Object) error {
+	obj, _, err := tryUpdate(&testObj{}, storage.ResponseMeta{ResourceVersion: w.rv})
+	w.written = obj
+
+	return err
+}
+
+var _ = Describe("IdentityRESTOptionsGetter", func() {
+	var (
+		ctx     = context.Background()
+		gr      = schema.GroupResource{Resource: "testobjs"}
+		backend *writingStorage
+		s       storage.Interface
+	)
+
+	importedUID := func(_ context.Context, got schema.GroupResource, obj metav1.Object) error {
+		Expect(got).To(Equal(gr))
+		if uid, ok := obj.GetAnnotations()["import/uid"]; ok {
+			obj.SetUID(types.UID(uid))
+		}
+
+		return nil
+	}
+
+	BeforeEach(func() {
+		backend = &writingStorage{}
+		delegate := &fixedRESTOptionsGetter{opts: generic.RESTOptions{
+			StorageConfig: &storagebackend.ConfigForResource{},
+			Decorator: func(*storagebackend.ConfigForResource, string, func(runtime.Object) (string, error), func() runtime.Object,
+				func() runtime.Object, storage.AttrFunc, storage.IndexerFuncs, *cache.Indexers) (storage.Interface, factory.DestroyFunc, error) {
+				return backend, func() {}, nil
+			},
+		}}
+		opts, err := IdentityRESTOptionsGetter(delegate, importedUID).GetRESTOptions(gr, &testObj{})
+		Expect(err).NotTo(HaveOccurred())
+		s, _, err = opts.Decorator(opts.StorageConfig, "/testobjs", nil, nil, nil, nil, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should set the identity of created objects", func() {
+		obj := &testObj{ObjectMeta: metav1.ObjectMeta{Name: "a", UID: "random", Annotations: map[string]string{"import/uid": "imported"}}}
+		Expect(s.Create(ctx, "/testobjs/a", obj, &testObj{}, 0)).To(Succeed())
+		Expect(backend.written.(*testObj).UID).To(Equal(types.UID("imported")))
+	})
+
+	It("should set the identity of objects created by updates only", func() {
+		setAnnotation := func(in runtime.Object, _ storage.ResponseMeta) (runtime.Object, *uint64, error) {
+			obj := in.(*testObj)
+			obj.UID = "random"
+			obj.Annotations = map[string]string{"import/uid": "imported"}
+
+			return obj, nil, nil
+		}
+		Expect(s.GuaranteedUpdate(ctx, "/testobjs/a", &testObj{}, true, nil, setAnnotation, nil)).To(Succeed())
+		Expect(backend.written.(*testObj).UID).To(Equal(types.UID("imported")))
+
+		backend.rv = 1
+		Expect(s.GuaranteedUpdate(ctx, "/testobjs/a", &testObj{}, true, nil, setAnnotation, nil)).To(Succeed())
+		Expect(backend.written.(*testObj).UID).To(Equal(types.UID("random")))
+	})
+})
@@ -0,0 +1,62 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apiserver/pkg/registry/generic"
+)
+
+// AuditAnnotationImport records the UID and creation timestamp kept by an import request.
+const AuditAnnotationImport = "kit.opendefense.cloud/import"
+
+// ImportedMetadata are the system-managed metadata fields of an object kept by an import request.
+// Empty fields are set by the server as usual.
+type ImportedMetadata struct {
+	UID               types.UID
+	CreationTimestamp metav1.Time
+}
+
+type importedMetadataKey struct{}
+
+// WithImportedMetadata returns a context of an import request, which creates its object with m.
+// Import requests are authorized before, see apiserver.Builder.WithImportMode.
+func WithImportedMetadata(ctx context.Context, m ImportedMetadata) context.Context {
+	return context.WithValue(ctx, importedMetadataKey{}, m)
+}
+
+// ImportedMetadataFrom returns the imported metadata of the import request in ctx, e.g. to skip
+// defaulting of imported objects in strategies.
+func ImportedMetadataFrom(ctx context.Context) (ImportedMetadata, bool) {
+	m, ok := ctx.Value(importedMetadataKey{}).(ImportedMetadata)
+
+	return m, ok
+}
+
+// ImportRESTOptionsGetter wraps the given getter so that the storage created through it creates
+// the objects of import requests with their imported metadata, see WithImportedMetadata. Existing
+// objects are not changed by import requests.
+func ImportRESTOptionsGetter(delegate generic.RESTOptionsGetter) generic.RESTOptionsGetter {
+	return IdentityRESTOptionsGetter(delegate, setImportedMetadata)
+}
+
+// setImportedMetadata is the IdentityFunc setting the imported metadata of import requests.
+func setImportedMetadata(ctx context.Context, _ schema.GroupResource, obj metav1.Object) error {
+	m, ok := ImportedMetadataFrom(ctx)
+	if !ok {
+		return nil
+	}
+	if m.UID != "" {
+		obj.SetUID(m.UID)
+	}
+	if !m.CreationTimestamp.IsZero() {
+		obj.SetCreationTimestamp(m.CreationTimestamp)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/storagebackend"
+	"k8s.io/apiserver/pkg/storage/storagebackend/factory"
+	"k8s.io/client-go/tools/cache"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ImportRESTOptionsGetter", func() {
+	var (
+		backend *writingStorage
+		s       storage.Interface
+		created = metav1.NewTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	)
+
+	BeforeEach(func() {
+		backend = &writingStorage{}
+		delegate := &fixedRESTOptionsGetter{opts: generic.RESTOptions{
+			StorageConfig: &storagebackend.ConfigForResource{},
+			Decorator: func(*storagebackend.ConfigForResource, string, func(runtime.Object) (string, error), func() runtime.Object,
+				func() runtime.Object, storage.AttrFunc, storage.IndexerFuncs, *cache.Indexers) (storage.Interface, factory.DestroyFunc, error) {
+				return backend, func() {}, nil
+			},
+		}}
+		opts, err := ImportRESTOptionsGetter(delegate).GetRESTOptions(schema.GroupResource{Resource: "testobjs"}, &testObj{})
+		Expect(err).NotTo(HaveOccurred())
+		s, _, err = opts.Decorator(opts.StorageConfig, "/testobjs", nil, nil, nil, nil, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should create the objects of import requests with the imported metadata", func() {
+		ctx := WithImportedMetadata(context.Background(), ImportedMetadata{UID: "imported", CreationTimestamp: created})
+		obj := &testObj{ObjectMeta: metav1.ObjectMeta{Name: "a", UID: "random", CreationTimestamp: metav1.Now()}}
+		Expect(s.Create(ctx, "/testobjs/a", obj, &testObj{}, 0)).To(Succeed())
+		Expect(backend.written.(*testObj).UID).To(Equal(types.UID("imported")))
+		Expect(backend.written.(*testObj).CreationTimestamp).To(Equal(created))
+	})
+
+	It("should keep the metadata set by the server for other requests", func() {
+		obj := &testObj{ObjectMeta: metav1.ObjectMeta{Name: "a", UID: "random"}}
+		Expect(s.Create(context.Background(), "/testobjs/a", obj, &testObj{}, 0)).To(Succeed())
+		Expect(backend.written.(*testObj).UID).To(Equal(types.UID("random")))
+	})
+})
@@ -0,0 +1,92 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// defaultRetryAfter is the delay after which clients rejected by an InflightLimit retry by default.
+const defaultRetryAfter = time.Second
+
+// InflightLimit limits the number of concurrent expensive lists of a resource, independent of the
+// limits of the server like --max-requests-inflight, so one heavy resource cannot starve all
+// others. Rejected lists fail with a TooManyRequests status and a Retry-After header, which
+// client-go retries.
+type InflightLimit struct {
+	// MaxLists is the maximum number of concurrent expensive lists. Lists are not limited if 0.
+	MaxLists int
+	// Expensive returns whether a list is expensive, ExpensiveList if nil.
+	Expensive func(*metainternalversion.ListOptions) bool
+	// RetryAfter is the delay after which rejected clients retry, one second if 0.
+	RetryAfter time.Duration
+}
+
+// ExpensiveList returns whether a list selects all objects of the resource, or of a namespace,
+// as it neither has a label nor a field selector.
+func ExpensiveList(options *metainternalversion.ListOptions) bool {
+	return options == nil ||
+		(options.LabelSelector == nil || options.LabelSelector.Empty()) &&
+			(options.FieldSelector == nil || options.FieldSelector.Empty())
+}
+
+// WithInflightLimit limits the number of concurrent expensive lists of the store, see InflightLimit.
+func WithInflightLimit(l InflightLimit) StoreOption {
+	return func(c *storeConfig) {
+		c.inflightLimit = l
+	}
+}
+
+// inflightLimiter admits up to a maximum number of concurrent expensive lists.
+type inflightLimiter struct {
+	gr             schema.GroupResource
+	expensive      func(*metainternalversion.ListOptions) bool
+	retryAfterSecs int
+	slots          chan struct{}
+}
+
+// newInflightLimiter returns the limiter of l, or nil if l does not limit lists.
+func newInflightLimiter(gr schema.GroupResource, l InflightLimit) *inflightLimiter {
+	if l.MaxLists <= 0 {
+		return nil
+	}
+	expensive := l.Expensive
+	if expensive == nil {
+		expensive = ExpensiveList
+	}
+	retryAfter := l.RetryAfter
+	if retryAfter <= 0 {
+		retryAfter = defaultRetryAfter
+	}
+
+	return &inflightLimiter{
+		gr:             gr,
+		expensive:      expensive,
+		retryAfterSecs: int(math.Ceil(retryAfter.Seconds())),
+		slots:          make(chan struct{}, l.MaxLists),
+	}
+}
+
+// admit returns a function releasing the admission of a list with options, or a TooManyRequests
+// error if the maximum number of expensive lists is in flight.
+func (l *inflightLimiter) admit(options *metainternalversion.ListOptions) (func(), error) {
+	if l == nil || !l.expensive(options) {
+		return func() {}, nil
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return func() { <-l.slots }, nil
+	default:
+		inflightRejections.WithLabelValues(l.gr.Group, l.gr.Resource).Inc()
+
+		return nil, apierrors.NewTooManyRequests(
+			fmt.Sprintf("too many concurrent lists of %s, please try again later", l.gr), l.retryAfterSecs)
+	}
+}
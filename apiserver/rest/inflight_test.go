@@ -0,0 +1,61 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InflightLimit", func() {
+	var (
+		gr       = schema.GroupResource{Group: "arc", Resource: "testobjs"}
+		selected = &metainternalversion.ListOptions{LabelSelector: labels.SelectorFromSet(labels.Set{"app": "a"})}
+	)
+
+	It("should reject expensive lists beyond the limit", func() {
+		limiter := newInflightLimiter(gr, InflightLimit{MaxLists: 1, RetryAfter: 1500 * time.Millisecond})
+		release, err := limiter.admit(&metainternalversion.ListOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = limiter.admit(nil)
+		Expect(apierrors.IsTooManyRequests(err)).To(BeTrue())
+		seconds, ok := apierrors.SuggestsClientDelay(err)
+		Expect(ok).To(BeTrue())
+		Expect(seconds).To(Equal(2))
+
+		// Lists with a selector are not limited.
+		releaseSelected, err := limiter.admit(selected)
+		Expect(err).NotTo(HaveOccurred())
+		releaseSelected()
+
+		release()
+		release, err = limiter.admit(nil)
+		Expect(err).NotTo(HaveOccurred())
+		release()
+	})
+
+	It("should use the given classification of expensive lists", func() {
+		limiter := newInflightLimiter(gr, InflightLimit{MaxLists: 1, Expensive: func(*metainternalversion.ListOptions) bool { return true }})
+		_, err := limiter.admit(selected)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = limiter.admit(selected)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should not limit lists without a maximum", func() {
+		limiter := newInflightLimiter(gr, InflightLimit{})
+		Expect(limiter).To(BeNil())
+		release, err := limiter.admit(nil)
+		Expect(err).NotTo(HaveOccurred())
+		release()
+	})
+})
@@ -69,12 +69,79 @@ type PrepareForUpdater interface {
 	PrepareForUpdate(ctx context.Context, old runtime.Object)
 }
 
+// PrepareForCreaterWithError is the variant of PrepareForCreater which may fail,
+// e.g. if the object is normalized using an external lookup. It takes precedence
+// over PrepareForCreater.
+type PrepareForCreaterWithError interface {
+	// PrepareForCreate is invoked on create before validation to normalize the
+	// object. A returned error rejects the request as described for Mutator.
+	PrepareForCreate(ctx context.Context) error
+}
+
+// PrepareForUpdaterWithError is the variant of PrepareForUpdater which may fail,
+// e.g. if the object is normalized using an external lookup. It takes precedence
+// over PrepareForUpdater.
+type PrepareForUpdaterWithError interface {
+	// PrepareForUpdate is invoked on update before validation to normalize the
+	// object. A returned error rejects the request as described for Mutator.
+	PrepareForUpdate(ctx context.Context, old runtime.Object) error
+}
+
+// Mutator can be implemented by objects to fill computed fields, like hashes or normalized names,
+// on create and update. Unlike PrepareForCreater and PrepareForUpdater, it may fail: a returned
+// error rejects the request like an admission failure, i.e. with a Forbidden status unless it is
+// an API status error already.
+type Mutator interface {
+	// Mutate is invoked on create and update after PrepareForCreate or PrepareForUpdate and
+	// before validation.
+	Mutate(ctx context.Context) error
+}
+
+// Migrator can be implemented by objects whose stored form may be outdated, e.g. objects written
+// before a field has been renamed. Objects read from storage are migrated on the fly, so clients
+// only see the current form, and written objects are migrated before they are stored. This
+// migrates objects lazily, by their next update. The objects still stored in a legacy form are
+// counted in the kit_migration_legacy_objects metric.
+type Migrator interface {
+	// NeedsMigration returns true if the object is in a legacy form. It must not modify the object.
+	NeedsMigration() bool
+	// Migrate converts the object from its legacy form to the current one.
+	Migrate()
+}
+
+// FieldDeprecator can be implemented by objects with deprecated fields. Clients setting them get a
+// warning, the fields are recorded in the audit annotation AuditAnnotationDeprecatedFields and
+// writes setting them are counted in the kit_deprecation_field_writes_total metric, which tells
+// when the fields are no longer used and can be removed.
+type FieldDeprecator interface {
+	// DeprecatedFields returns the deprecated fields set in the object.
+	DeprecatedFields() []DeprecatedField
+}
+
+// DeprecatedField is a deprecated field set in an object.
+type DeprecatedField struct {
+	// Path is the path of the field, e.g. spec.oldField.
+	Path *field.Path
+	// Message is added to the warning, e.g. "use spec.newField instead".
+	Message string
+}
+
 // TableConverter implements an adapted version of rest.TableConverter
 // it can be used by objects to override DefaultStrategy behaviour.
 type TableConverter interface {
 	ConvertToTable(ctx context.Context, tableOptions runtime.Object) (*metav1.Table, error)
 }
 
+// TableRowConverter can be implemented by objects instead of TableConverter to convert large
+// lists to tables with fewer allocations, e.g. for kubectl get. The rows of all items are
+// allocated at once and their cells share a single backing array.
+type TableRowConverter interface {
+	// TableColumns returns the columns of the table, the same for every object.
+	TableColumns() []metav1.TableColumnDefinition
+	// AppendTableCells appends the cells of the row of the object, one per column, to cells.
+	AppendTableCells(ctx context.Context, tableOptions runtime.Object, cells []any) ([]any, error)
+}
+
 // Validater implements a subset of rest.RESTCreateStrategy and
 // it can be used by objects to override DefaultStrategy behaviour.
 type Validater interface {
@@ -102,9 +169,24 @@ type ShortNamesProvider interface {
 	ShortNames() []string
 }
 
+// CategoriesProvider allows a resource to add itself to categories, which kubectl resolves to all
+// resources in them, e.g. "kubectl get all".
+type CategoriesProvider interface {
+	// Categories returns the categories of the resource.
+	Categories() []string
+}
+
 // SingularNameProvider returns the singular name of the resource.
 // This is used by kubectl for discovery and display (e.g., "pod" instead of "pods").
 type SingularNameProvider interface {
 	// GetSingularName returns the singular form of the resource name.
 	GetSingularName() string
 }
+
+// Singleton can be implemented by cluster-scoped resources of which only a single
+// instance with a fixed name may exist, like ClusterVersion. DefaultStrategy rejects
+// creating objects with any other name and uses the fixed name for generateName.
+type Singleton interface {
+	// SingletonName returns the only name allowed for the resource, e.g. "cluster".
+	SingletonName() string
+}
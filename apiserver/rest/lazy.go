@@ -0,0 +1,250 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/storagebackend"
+	"k8s.io/apiserver/pkg/storage/storagebackend/factory"
+	"k8s.io/client-go/tools/cache"
+)
+
+// LazyRESTOptionsGetter wraps the given getter so that the storage created through it, including
+// its watch cache and the connection to etcd with its compaction, is only created once it is
+// accessed by a request or a post-start hook, e.g. for rarely used resources of servers serving
+// many optional APIs. Until then, the storage is ready and reports an unknown number of objects.
+// The first request waits for the watch cache to be initialized.
+func LazyRESTOptionsGetter(delegate generic.RESTOptionsGetter) generic.RESTOptionsGetter {
+	return &lazyRESTOptionsGetter{delegate: delegate}
+}
+
+type lazyRESTOptionsGetter struct {
+	delegate generic.RESTOptionsGetter
+}
+
+// GetRESTOptions returns the delegate's options with a decorator deferring the creation of the storage.
+func (g *lazyRESTOptionsGetter) GetRESTOptions(gr schema.GroupResource, example runtime.Object) (generic.RESTOptions, error) {
+	opts, err := g.delegate.GetRESTOptions(gr, example)
+	if err != nil {
+		return opts, err
+	}
+	decorator := opts.Decorator
+	if decorator == nil {
+		decorator = generic.UndecoratedStorage
+	}
+	opts.Decorator = func(
+		config *storagebackend.ConfigForResource,
+		resourcePrefix string,
+		keyFunc func(obj runtime.Object) (string, error),
+		newFunc func() runtime.Object,
+		newListFunc func() runtime.Object,
+		getAttrsFunc storage.AttrFunc,
+		trigger storage.IndexerFuncs,
+		indexers *cache.Indexers) (storage.Interface, factory.DestroyFunc, error) {
+		s := &lazyStorage{create: func() (storage.Interface, factory.DestroyFunc, error) {
+			return decorator(config, resourcePrefix, keyFunc, newFunc, newListFunc, getAttrsFunc, trigger, indexers)
+		}}
+
+		return s, s.destroy, nil
+	}
+
+	return opts, nil
+}
+
+// lazyStorage creates the wrapped storage on first access. Failed creations are retried by the
+// next access.
+type lazyStorage struct {
+	create func() (storage.Interface, factory.DestroyFunc, error)
+
+	mu        sync.Mutex
+	storage   storage.Interface
+	destroyFn factory.DestroyFunc
+	destroyed bool
+	keysFunc  storage.KeysFunc
+}
+
+// get returns the wrapped storage, creating it if necessary.
+func (s *lazyStorage) get() (storage.Interface, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.destroyed {
+		return nil, ErrStorageDestroyed
+	}
+	if s.storage != nil {
+		return s.storage, nil
+	}
+	created, destroy, err := s.create()
+	if err != nil {
+		return nil, err
+	}
+	if s.keysFunc != nil {
+		if err := created.EnableResourceSizeEstimation(s.keysFunc); err != nil {
+			destroy()
+			return nil, err
+		}
+	}
+	s.storage, s.destroyFn = created, destroy
+
+	return s.storage, nil
+}
+
+// current returns the wrapped storage or nil if it has not been created yet.
+func (s *lazyStorage) current() storage.Interface {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.storage
+}
+
+// destroy destroys the wrapped storage if it has been created and prevents its creation afterwards.
+func (s *lazyStorage) destroy() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.destroyed = true
+	if s.destroyFn != nil {
+		s.destroyFn()
+	}
+	s.storage, s.destroyFn = nil, nil
+}
+
+// Versioner returns the versioner of the wrapped storage, or the versioner of etcd if the
+// storage cannot be created, whose operations fail anyway.
+func (s *lazyStorage) Versioner() storage.Versioner {
+	st, err := s.get()
+	if err != nil {
+		return storage.APIObjectVersioner{}
+	}
+
+	return st.Versioner()
+}
+
+func (s *lazyStorage) Create(ctx context.Context, key string, obj, out runtime.Object, ttl uint64) error {
+	st, err := s.get()
+	if err != nil {
+		return err
+	}
+
+	return st.Create(ctx, key, obj, out, ttl)
+}
+
+func (s *lazyStorage) Delete(
+	ctx context.Context, key string, out runtime.Object, preconditions *storage.Preconditions,
+	validateDeletion storage.ValidateObjectFunc, cachedExistingObject runtime.Object, opts storage.DeleteOptions) error {
+	st, err := s.get()
+	if err != nil {
+		return err
+	}
+
+	return st.Delete(ctx, key, out, preconditions, validateDeletion, cachedExistingObject, opts)
+}
+
+func (s *lazyStorage) Watch(ctx context.Context, key string, opts storage.ListOptions) (watch.Interface, error) {
+	st, err := s.get()
+	if err != nil {
+		return nil, err
+	}
+
+	return st.Watch(ctx, key, opts)
+}
+
+func (s *lazyStorage) Get(ctx context.Context, key string, opts storage.GetOptions, objPtr runtime.Object) error {
+	st, err := s.get()
+	if err != nil {
+		return err
+	}
+
+	return st.Get(ctx, key, opts, objPtr)
+}
+
+func (s *lazyStorage) GetList(ctx context.Context, key string, opts storage.ListOptions, listObj runtime.Object) error {
+	st, err := s.get()
+	if err != nil {
+		return err
+	}
+
+	return st.GetList(ctx, key, opts, listObj)
+}
+
+func (s *lazyStorage) GuaranteedUpdate(
+	ctx context.Context, key string, destination runtime.Object, ignoreNotFound bool,
+	preconditions *storage.Preconditions, tryUpdate storage.UpdateFunc, cachedExistingObject runtime.Object) error {
+	st, err := s.get()
+	if err != nil {
+		return err
+	}
+
+	return st.GuaranteedUpdate(ctx, key, destination, ignoreNotFound, preconditions, tryUpdate, cachedExistingObject)
+}
+
+// Stats fails until the storage has been created, so the number of objects is reported as unknown
+// instead of creating the storage periodically.
+func (s *lazyStorage) Stats(ctx context.Context) (storage.Stats, error) {
+	st := s.current()
+	if st == nil {
+		return storage.Stats{}, ErrStorageNotInitialized
+	}
+
+	return st.Stats(ctx)
+}
+
+// ReadinessCheck succeeds until the storage has been created, as it is created on demand.
+func (s *lazyStorage) ReadinessCheck() error {
+	st := s.current()
+	if st == nil {
+		return nil
+	}
+
+	return st.ReadinessCheck()
+}
+
+func (s *lazyStorage) RequestWatchProgress(ctx context.Context) error {
+	st, err := s.get()
+	if err != nil {
+		return err
+	}
+
+	return st.RequestWatchProgress(ctx)
+}
+
+func (s *lazyStorage) GetCurrentResourceVersion(ctx context.Context) (uint64, error) {
+	st, err := s.get()
+	if err != nil {
+		return 0, err
+	}
+
+	return st.GetCurrentResourceVersion(ctx)
+}
+
+// EnableResourceSizeEstimation enables the estimation once the storage has been created.
+func (s *lazyStorage) EnableResourceSizeEstimation(keysFunc storage.KeysFunc) error {
+	s.mu.Lock()
+	st := s.storage
+	if st == nil {
+		s.keysFunc = keysFunc
+	}
+	s.mu.Unlock()
+	if st == nil {
+		return nil
+	}
+
+	return st.EnableResourceSizeEstimation(keysFunc)
+}
+
+// CompactRevision returns 0 until the storage has been created, as nothing has been compacted
+// through it.
+func (s *lazyStorage) CompactRevision() int64 {
+	st := s.current()
+	if st == nil {
+		return 0
+	}
+
+	return st.CompactRevision()
+}
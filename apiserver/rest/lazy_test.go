@@ -0,0 +1,97 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/storagebackend"
+	"k8s.io/apiserver/pkg/storage/storagebackend/factory"
+	"k8s.io/client-go/tools/cache"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// countingRESTOptionsGetter creates memoryStorage and counts the created and destroyed storage.
+type countingRESTOptionsGetter struct {
+	mem                *memoryStorage
+	err                error
+	created, destroyed int
+}
+
+func (g *countingRESTOptionsGetter) GetRESTOptions(schema.GroupResource, runtime.Object) (generic.RESTOptions, error) {
+	return generic.RESTOptions{
+		StorageConfig: &storagebackend.ConfigForResource{},
+		Decorator: func(*storagebackend.ConfigForResource, string, func(runtime.Object) (string, error), func() runtime.Object,
+			func() runtime.Object, storage.AttrFunc, storage.IndexerFuncs, *cache.Indexers) (storage.Interface, factory.DestroyFunc, error) {
+			if g.err != nil {
+				return nil, nil, g.err
+			}
+			g.created++
+
+			return g.mem, func() { g.destroyed++ }, nil
+		},
+	}, nil
+}
+
+var _ = Describe("LazyRESTOptionsGetter", func() {
+	var (
+		ctx     = context.Background()
+		getter  *countingRESTOptionsGetter
+		lazy    storage.Interface
+		destroy factory.DestroyFunc
+	)
+
+	BeforeEach(func() {
+		getter = &countingRESTOptionsGetter{mem: &memoryStorage{objs: map[string]*testObj{
+			"/testobjs/ns/test": {ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns"}},
+		}}}
+		opts, err := LazyRESTOptionsGetter(getter).GetRESTOptions(schema.GroupResource{Resource: "testobjs"}, &testObj{})
+		Expect(err).NotTo(HaveOccurred())
+		lazy, destroy, err = opts.Decorator(opts.StorageConfig, "/testobjs", nil, nil, nil, nil, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should create the storage on first access", func() {
+		Expect(getter.created).To(BeZero())
+		Expect(lazy.ReadinessCheck()).To(Succeed())
+		Expect(lazy.CompactRevision()).To(BeZero())
+		_, err := lazy.Stats(ctx)
+		Expect(err).To(MatchError(ErrStorageNotInitialized))
+		Expect(getter.created).To(BeZero())
+
+		obj := &testObj{}
+		Expect(lazy.Get(ctx, "/testobjs/ns/test", storage.GetOptions{}, obj)).To(Succeed())
+		Expect(obj.Name).To(Equal("test"))
+		Expect(lazy.Get(ctx, "/testobjs/ns/test", storage.GetOptions{}, obj)).To(Succeed())
+		Expect(getter.created).To(Equal(1))
+
+		destroy()
+		Expect(getter.destroyed).To(Equal(1))
+		Expect(lazy.Get(ctx, "/testobjs/ns/test", storage.GetOptions{}, obj)).To(MatchError(ErrStorageDestroyed))
+		Expect(getter.created).To(Equal(1))
+	})
+
+	It("should retry failed creations", func() {
+		getter.err = errors.New("etcd unavailable")
+		Expect(lazy.Get(ctx, "/testobjs/ns/test", storage.GetOptions{}, &testObj{})).To(MatchError("etcd unavailable"))
+		getter.err = nil
+		Expect(lazy.Get(ctx, "/testobjs/ns/test", storage.GetOptions{}, &testObj{})).To(Succeed())
+		Expect(getter.created).To(Equal(1))
+	})
+
+	It("should not create storage which is destroyed before its first access", func() {
+		destroy()
+		Expect(getter.destroyed).To(BeZero())
+		Expect(lazy.Create(ctx, "/testobjs/ns/new", &testObj{}, &testObj{}, 0)).To(MatchError(ErrStorageDestroyed))
+		Expect(getter.created).To(BeZero())
+	})
+})
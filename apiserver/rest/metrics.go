@@ -0,0 +1,194 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+)
+
+const (
+	unknownUserAgent = "unknown"
+	otherUserAgent   = "other"
+)
+
+// Results of an external validation.
+const (
+	externalResultAllowed     = "allowed"
+	externalResultDenied      = "denied"
+	externalResultError       = "error"
+	externalResultCircuitOpen = "circuit_open"
+)
+
+var (
+	validationRejections = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      "kit",
+			Subsystem:      "validation",
+			Name:           "rejections_total",
+			Help:           "Number of requests rejected by validation, partitioned by resource, verb, reason and client.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"group", "resource", "verb", "reason", "user_agent"},
+	)
+
+	externalValidations = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      "kit",
+			Subsystem:      "validation",
+			Name:           "external_total",
+			Help:           "Number of external validations, partitioned by validator and result.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"validator", "result"},
+	)
+
+	legacyObjectReads = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      "kit",
+			Subsystem:      "migration",
+			Name:           "legacy_reads_total",
+			Help:           "Number of objects migrated from a legacy form when read, partitioned by resource.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"group", "resource"},
+	)
+
+	legacyObjects = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Namespace:      "kit",
+			Subsystem:      "migration",
+			Name:           "legacy_objects",
+			Help:           "Number of objects stored in a legacy form, partitioned by resource.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"group", "resource"},
+	)
+
+	canaryRequests = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      "kit",
+			Subsystem:      "canary",
+			Name:           "requests_total",
+			Help:           "Number of writes to resources with a canary implementation, partitioned by resource, operation and the variant serving them.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"group", "resource", "operation", "variant"},
+	)
+
+	deprecatedFieldWrites = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      "kit",
+			Subsystem:      "deprecation",
+			Name:           "field_writes_total",
+			Help:           "Number of writes setting deprecated fields, partitioned by resource, field and client.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"group", "resource", "field", "user_agent"},
+	)
+
+	inflightRejections = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      "kit",
+			Subsystem:      "inflight",
+			Name:           "rejections_total",
+			Help:           "Number of expensive lists rejected by the inflight limit of their resource, partitioned by resource.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"group", "resource"},
+	)
+
+	registerMetricsOnce sync.Once
+
+	// knownUserAgents are the products reported in the user_agent label; all other clients
+	// are collapsed into "other" so arbitrary user agents cannot inflate the metric cardinality.
+	knownUserAgents = sets.New(
+		"kubectl",
+		"kube-apiserver",
+		"kube-controller-manager",
+		"kube-scheduler",
+		"kubelet",
+		"Go-http-client",
+		"curl",
+		"helm",
+		"argocd-application-controller",
+		"flux",
+		"kit-bench",
+	)
+	knownUserAgentsLock sync.RWMutex
+)
+
+// RegisterUserAgents adds products, e.g. the names of the controllers of a product, to the
+// user agents reported in metrics. Products not registered are reported as "other".
+func RegisterUserAgents(products ...string) {
+	knownUserAgentsLock.Lock()
+	defer knownUserAgentsLock.Unlock()
+	knownUserAgents.Insert(products...)
+}
+
+// RegisterMetrics registers the metrics of this package with the legacy registry,
+// which is served on /metrics. It is safe to call multiple times.
+func RegisterMetrics() {
+	registerMetricsOnce.Do(func() {
+		legacyregistry.MustRegister(validationRejections, externalValidations, legacyObjectReads, legacyObjects, deprecatedFieldWrites, canaryRequests,
+			inflightRejections)
+	})
+}
+
+type userAgentKey struct{}
+
+// WithUserAgent returns a copy of ctx carrying the user agent of the request.
+func WithUserAgent(ctx context.Context, userAgent string) context.Context {
+	return context.WithValue(ctx, userAgentKey{}, userAgent)
+}
+
+// UserAgentFrom returns the product of the user agent stored in ctx, e.g. "kubectl"
+// for "kubectl/v1.35.0 (linux/amd64)", to keep the metric cardinality low. Products
+// not registered with RegisterUserAgents are reported as "other".
+func UserAgentFrom(ctx context.Context) string {
+	userAgent, _ := ctx.Value(userAgentKey{}).(string)
+	product, _, _ := strings.Cut(userAgent, "/")
+	product = strings.TrimSpace(product)
+	if product == "" {
+		return unknownUserAgent
+	}
+	knownUserAgentsLock.RLock()
+	defer knownUserAgentsLock.RUnlock()
+	if !knownUserAgents.Has(product) {
+		return otherUserAgent
+	}
+
+	return product
+}
+
+// recordValidationRejections counts a request rejected with errs once per distinct reason.
+func recordValidationRejections(ctx context.Context, errs field.ErrorList) {
+	if len(errs) == 0 {
+		return
+	}
+	group, resource, verb := "", "", ""
+	if info, ok := request.RequestInfoFrom(ctx); ok {
+		group, resource, verb = info.APIGroup, info.Resource, info.Verb
+	}
+	userAgent := UserAgentFrom(ctx)
+	reasons := sets.New[string]()
+	for _, err := range errs {
+		reasons.Insert(string(err.Type))
+	}
+	for reason := range reasons {
+		validationRejections.WithLabelValues(group, resource, verb, reason, userAgent).Inc()
+	}
+}
+
+// recordExternalValidation counts the result of an external validation.
+func recordExternalValidation(validator, result string) {
+	externalValidations.WithLabelValues(validator, result).Inc()
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/component-base/metrics/testutil"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Validation metrics", func() {
+	rejections := func(verb, userAgent string) float64 {
+		GinkgoHelper()
+		v, err := testutil.GetCounterMetricValue(validationRejections.WithLabelValues("test.opendefense.cloud", "testobjs", verb, "FieldValueInvalid", userAgent))
+		Expect(err).NotTo(HaveOccurred())
+
+		return v
+	}
+
+	BeforeEach(func() {
+		RegisterMetrics()
+		validationRejections.Reset()
+	})
+
+	It("should count rejections by resource, verb, reason and client", func() {
+		ctx := request.WithRequestInfo(context.Background(), &request.RequestInfo{
+			APIGroup: "test.opendefense.cloud",
+			Resource: "testobjs",
+			Verb:     "create",
+		})
+		ctx = WithUserAgent(ctx, "kubectl/v1.35.0 (linux/amd64) kubernetes/abcdef")
+		Expect(DefaultStrategy{}.Validate(ctx, &testObj{})).To(HaveLen(1))
+		Expect(rejections("create", "kubectl")).To(Equal(1.0))
+
+		ctx = request.WithRequestInfo(ctx, &request.RequestInfo{
+			APIGroup: "test.opendefense.cloud",
+			Resource: "testobjs",
+			Verb:     "update",
+		})
+		Expect(DefaultStrategy{}.ValidateUpdate(ctx, &testObj{}, &testObj{})).To(HaveLen(1))
+		Expect(rejections("update", "kubectl")).To(Equal(1.0))
+	})
+
+	It("should not count valid objects", func() {
+		Expect(DefaultStrategy{}.Validate(context.Background(), &testObjList{})).To(BeEmpty())
+		Expect(testutil.GetCounterMetricValue(validationRejections.WithLabelValues("", "", "", "FieldValueInvalid", unknownUserAgent))).To(BeZero())
+	})
+
+	It("should reduce user agents to the product", func() {
+		Expect(UserAgentFrom(context.Background())).To(Equal(unknownUserAgent))
+		Expect(UserAgentFrom(WithUserAgent(context.Background(), "kubectl/v1.35.0"))).To(Equal("kubectl"))
+		Expect(UserAgentFrom(WithUserAgent(context.Background(), "curl/8.5.0"))).To(Equal("curl"))
+	})
+
+	It("should collapse unknown user agents", func() {
+		Expect(UserAgentFrom(WithUserAgent(context.Background(), "controller"))).To(Equal(otherUserAgent))
+		Expect(UserAgentFrom(WithUserAgent(context.Background(), "random-1234/v1"))).To(Equal(otherUserAgent))
+
+		RegisterUserAgents("bar-controller")
+		Expect(UserAgentFrom(WithUserAgent(context.Background(), "bar-controller/v0.1.0 (linux/amd64)"))).To(Equal("bar-controller"))
+	})
+})
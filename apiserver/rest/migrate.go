@@ -0,0 +1,97 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+)
+
+// DefaultLegacyCountInterval is the interval in which the objects stored in a legacy form are counted.
+const DefaultLegacyCountInterval = 10 * time.Minute
+
+// decorateMigrated returns a decorator of the store of gr migrating the objects and list items
+// read from storage, see Migrator.
+func decorateMigrated(gr schema.GroupResource) func(runtime.Object) {
+	return func(obj runtime.Object) {
+		if !meta.IsListType(obj) {
+			migrateRead(gr, obj)
+			return
+		}
+		_ = meta.EachListItem(obj, func(item runtime.Object) error {
+			migrateRead(gr, item)
+			return nil
+		})
+	}
+}
+
+// migrateRead migrates obj if it is stored in a legacy form. Objects read from the watch cache
+// share their data with the cache, so a copy is migrated and assigned to obj.
+func migrateRead(gr schema.GroupResource, obj runtime.Object) {
+	m, ok := obj.(Migrator)
+	if !ok || !m.NeedsMigration() {
+		return
+	}
+	migrated := obj.DeepCopyObject()
+	migrated.(Migrator).Migrate()
+	reflect.ValueOf(obj).Elem().Set(reflect.ValueOf(migrated).Elem())
+	legacyObjectReads.WithLabelValues(gr.Group, gr.Resource).Inc()
+}
+
+// migrateWrite migrates obj before it is written, so objects stored in a legacy form are
+// migrated by their next update.
+func migrateWrite(obj runtime.Object) {
+	if m, ok := obj.(Migrator); ok && m.NeedsMigration() {
+		m.Migrate()
+	}
+}
+
+// RunLegacyObjectCounter counts the objects of store stored in a legacy form in the given
+// interval until ctx is done, see CountLegacyObjects.
+func RunLegacyObjectCounter(ctx context.Context, store rest.Storage, interval time.Duration) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if _, err := CountLegacyObjects(ctx, store); err != nil {
+			utilruntime.HandleErrorWithContext(ctx, err, "Failed to count legacy objects", "resource", Unwrap(store).DefaultQualifiedResource)
+		}
+	}, interval)
+}
+
+// CountLegacyObjects returns the number of objects of store which are stored in a legacy form,
+// see Migrator, and reports it in the kit_migration_legacy_objects metric. Objects are read
+// from storage directly, since the store migrates them.
+func CountLegacyObjects(ctx context.Context, store rest.Storage) (int, error) {
+	s := Unwrap(store)
+	ctx = genericapirequest.WithNamespace(ctx, "")
+	list := s.NewListFunc()
+	// Reading from the watch cache is sufficient for a metric.
+	opts := storage.ListOptions{ResourceVersion: "0", Recursive: true, Predicate: storage.Everything}
+	if err := s.Storage.GetList(ctx, s.KeyRootFunc(ctx), opts, list); err != nil {
+		return 0, err
+	}
+	count := 0
+	err := meta.EachListItem(list, func(item runtime.Object) error {
+		if m, ok := item.(Migrator); ok && m.NeedsMigration() {
+			count++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	gr := s.DefaultQualifiedResource
+	legacyObjects.WithLabelValues(gr.Group, gr.Resource).Set(float64(count))
+
+	return count, nil
+}
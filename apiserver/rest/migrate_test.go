@@ -0,0 +1,128 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/component-base/metrics/testutil"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// legacyObj stores its message in Status in the legacy form and in Labels in the current one.
+type legacyObj struct {
+	testObj
+}
+
+func (l *legacyObj) DeepCopyObject() runtime.Object {
+	clone := *l
+	clone.ObjectMeta = *l.ObjectMeta.DeepCopy()
+
+	return &clone
+}
+
+// NeedsMigration implements Migrator
+func (l *legacyObj) NeedsMigration() bool { return l.Status != "" }
+
+// Migrate implements Migrator
+func (l *legacyObj) Migrate() {
+	if l.Labels == nil {
+		l.Labels = map[string]string{}
+	}
+	l.Labels["message"] = l.Status
+	l.Status = ""
+}
+
+type legacyObjList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+	Items []legacyObj
+}
+
+func (l *legacyObjList) DeepCopyObject() runtime.Object {
+	clone := *l
+	clone.Items = append([]legacyObj(nil), l.Items...)
+
+	return &clone
+}
+
+// listStorage is a storage.Interface listing the given objects.
+type listStorage struct {
+	storage.Interface
+	items []legacyObj
+}
+
+func (s *listStorage) GetList(ctx context.Context, key string, opts storage.ListOptions, list runtime.Object) error {
+	list.(*legacyObjList).Items = s.items
+
+	return nil
+}
+
+var _ = Describe("Migrator", func() {
+	var gr = schema.GroupResource{Group: "arc", Resource: "legacyobjs"}
+
+	BeforeEach(func() {
+		RegisterMetrics()
+	})
+
+	legacy := func(name string) legacyObj {
+		return legacyObj{testObj: testObj{ObjectMeta: metav1.ObjectMeta{Name: name}, Status: "hello"}}
+	}
+
+	It("should migrate legacy objects when read", func() {
+		before, err := testutil.GetCounterMetricValue(legacyObjectReads.WithLabelValues(gr.Group, gr.Resource))
+		Expect(err).NotTo(HaveOccurred())
+
+		obj := legacy("test")
+		labels := map[string]string{"shared": "true"}
+		obj.Labels = labels
+		decorateMigrated(gr)(&obj)
+		Expect(obj.Status).To(BeEmpty())
+		Expect(obj.Labels).To(HaveKeyWithValue("message", "hello"))
+		// Objects from the watch cache must not be modified.
+		Expect(labels).NotTo(HaveKey("message"))
+
+		list := &legacyObjList{Items: []legacyObj{legacy("a"), {testObj: testObj{ObjectMeta: metav1.ObjectMeta{Name: "b"}}}}}
+		decorateMigrated(gr)(list)
+		Expect(list.Items[0].Labels).To(HaveKeyWithValue("message", "hello"))
+		Expect(list.Items[1].Labels).To(BeNil())
+
+		after, err := testutil.GetCounterMetricValue(legacyObjectReads.WithLabelValues(gr.Group, gr.Resource))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(after - before).To(Equal(2.0))
+	})
+
+	It("should migrate legacy objects when written", func() {
+		strategy := DefaultStrategy{}
+		obj := legacy("test")
+		strategy.PrepareForCreate(context.Background(), &obj)
+		Expect(obj.Labels).To(HaveKeyWithValue("message", "hello"))
+
+		obj = legacy("test")
+		strategy.PrepareForUpdate(context.Background(), &obj, &legacyObj{})
+		Expect(obj.Labels).To(HaveKeyWithValue("message", "hello"))
+	})
+
+	It("should count the objects stored in a legacy form", func() {
+		store := &genericregistry.Store{
+			NewListFunc:              func() runtime.Object { return &legacyObjList{} },
+			DefaultQualifiedResource: gr,
+			KeyRootFunc:              func(ctx context.Context) string { return "/legacyobjs" },
+			Storage: genericregistry.DryRunnableStorage{Storage: &listStorage{items: []legacyObj{
+				legacy("a"), legacy("b"), {testObj: testObj{ObjectMeta: metav1.ObjectMeta{Name: "c"}}},
+			}}},
+		}
+		count, err := CountLegacyObjects(context.Background(), store)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(Equal(2))
+		Expect(testutil.GetGaugeMetricValue(legacyObjects.WithLabelValues(gr.Group, gr.Resource))).To(Equal(2.0))
+	})
+})
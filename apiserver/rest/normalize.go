@@ -0,0 +1,114 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+// NewNormalizeStore returns the storage of the normalize subresource of store, which responds to
+// POST with the submitted object as it would be created: defaulted, prepared by the hooks of the
+// strategy, validated and canonicalized, but not persisted. GitOps pipelines normalize their
+// manifests with it, so they don't differ from the objects in the server. Admission is not run
+// and system fields like the UID and the creation timestamp are not set.
+func NewNormalizeStore(store rest.Storage) rest.Storage {
+	return &normalizeStore{store: Unwrap(store)}
+}
+
+// normalizeStore serves the normalize subresource.
+type normalizeStore struct {
+	store *genericregistry.Store
+}
+
+var _ rest.NamedCreater = &normalizeStore{}
+
+func (s *normalizeStore) New() runtime.Object { return s.store.New() }
+
+func (s *normalizeStore) Destroy() {}
+
+func (s *normalizeStore) NamespaceScoped() bool { return s.store.NamespaceScoped() }
+
+// Create returns obj as it would be created with name.
+func (s *normalizeStore) Create(ctx context.Context, name string, obj runtime.Object, _ rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	if err := prepareCreate(ctx, s.store, name, obj); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// setNameAndNamespace defaults the name and namespace of obj to those of the request and returns
+// a BadRequest error if they differ.
+func setNameAndNamespace(ctx context.Context, obj runtime.Object, name string, namespaced bool) error {
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return apierrors.NewBadRequest(err.Error())
+	}
+	if m.GetName() == "" {
+		m.SetName(name)
+	}
+	if m.GetName() != name {
+		return apierrors.NewBadRequest(fmt.Sprintf("the name of the object (%s) does not match the name on the URL (%s)", m.GetName(), name))
+	}
+	if namespaced {
+		namespace := genericapirequest.NamespaceValue(ctx)
+		if m.GetNamespace() == "" {
+			m.SetNamespace(namespace)
+		}
+		if m.GetNamespace() != namespace {
+			return apierrors.NewBadRequest(fmt.Sprintf("the namespace of the object (%s) does not match the namespace on the request (%s)", m.GetNamespace(), namespace))
+		}
+	}
+
+	return nil
+}
+
+// prepareCreate prepares, validates and canonicalizes obj like store creates it, without setting
+// system fields or persisting it.
+func prepareCreate(ctx context.Context, store *genericregistry.Store, name string, obj runtime.Object) error {
+	if err := setNameAndNamespace(ctx, obj, name, store.NamespaceScoped()); err != nil {
+		return err
+	}
+	ctx, p := withPrepareError(ctx)
+	strategy := store.CreateStrategy
+	strategy.PrepareForCreate(ctx, obj)
+	if perr := p.get(); perr != nil {
+		return admissionError(store.DefaultQualifiedResource, name, perr)
+	}
+	if errs := strategy.Validate(ctx, obj); len(errs) > 0 {
+		kinds, _, err := strategy.ObjectKinds(obj)
+		if err != nil {
+			return err
+		}
+
+		return apierrors.NewInvalid(kinds[0].GroupKind(), name, errs)
+	}
+	strategy.Canonicalize(obj)
+
+	return nil
+}
+
+// prepareUpdate prepares, validates and canonicalizes obj like store updates old to it, without
+// persisting it.
+func prepareUpdate(ctx context.Context, store *genericregistry.Store, name string, obj, old runtime.Object) error {
+	if err := setNameAndNamespace(ctx, obj, name, store.NamespaceScoped()); err != nil {
+		return err
+	}
+	ctx, p := withPrepareError(ctx)
+	err := rest.BeforeUpdate(store.UpdateStrategy, ctx, obj, old)
+	if perr := p.get(); perr != nil {
+		return admissionError(store.DefaultQualifiedResource, name, perr)
+	}
+
+	return err
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"errors"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// validMutatorObj is a mutatorObj which passes validation.
+type validMutatorObj struct {
+	mutatorObj
+}
+
+func (v *validMutatorObj) DeepCopyObject() runtime.Object {
+	clone := *v
+
+	return &clone
+}
+
+func (v *validMutatorObj) Validate(context.Context) field.ErrorList { return nil }
+
+var _ = Describe("normalize subresource", func() {
+	var (
+		ctx       = genericapirequest.WithNamespace(context.Background(), "ns")
+		normalize rest.NamedCreater
+	)
+
+	BeforeEach(func() {
+		gv := schema.GroupVersion{Group: "arc", Version: "v1"}
+		scheme := runtime.NewScheme()
+		scheme.AddKnownTypes(gv, &validMutatorObj{})
+		gr := schema.GroupResource{Group: gv.Group, Resource: "validmutatorobjs"}
+		strategy := Compose(NewDefaultStrategy(&validMutatorObj{}, scheme, gr), Hooks[*validMutatorObj]{
+			Validate: func(_ context.Context, obj *validMutatorObj) field.ErrorList {
+				if obj.Status != "" {
+					return field.ErrorList{field.Forbidden(field.NewPath("status"), "must not be set")}
+				}
+
+				return nil
+			},
+		})
+		normalize = NewNormalizeStore(&genericregistry.Store{
+			NewFunc:                  func() runtime.Object { return &validMutatorObj{} },
+			DefaultQualifiedResource: gr,
+			CreateStrategy:           strategy,
+		}).(rest.NamedCreater)
+	})
+
+	It("should return the object as it would be created", func() {
+		obj, err := normalize.Create(ctx, "test", &validMutatorObj{}, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(obj.(*validMutatorObj).Namespace).To(Equal("ns"))
+		Expect(obj.(*validMutatorObj).Labels).To(HaveKeyWithValue("hash", "test"))
+		Expect(obj.(*validMutatorObj).Flag).To(BeTrue())
+		Expect(obj.(*validMutatorObj).UID).To(BeEmpty())
+	})
+
+	It("should reject objects failing the hooks or the validation", func() {
+		_, err := normalize.Create(ctx, "test", &validMutatorObj{mutatorObj{err: errors.New("no hash")}}, nil, nil)
+		Expect(err).To(MatchError(ContainSubstring("no hash")))
+		_, err = normalize.Create(ctx, "test", &validMutatorObj{mutatorObj{testObj: testObj{Status: "ready"}}}, nil, nil)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+	})
+
+	It("should reject objects of another name or namespace", func() {
+		obj := &validMutatorObj{}
+		obj.Name = "other"
+		_, err := normalize.Create(ctx, "test", obj, nil, nil)
+		Expect(apierrors.IsBadRequest(err)).To(BeTrue())
+		obj = &validMutatorObj{}
+		obj.Namespace = "other"
+		_, err = normalize.Create(ctx, "test", obj, nil, nil)
+		Expect(apierrors.IsBadRequest(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,33 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+)
+
+// DefaultMaxPageSize is the default maximum number of objects returned by a paginated list,
+// which matches the page size of kubectl and client-go informers.
+const DefaultMaxPageSize = 500
+
+// WithMaxPageSize limits the number of objects returned by list requests with a limit to n.
+// Clients requesting a larger limit get a continue token after n objects, which they already
+// follow since they paginate. Lists without a limit are served at once, so clients which do
+// not paginate keep working. Lists are not limited if n is 0.
+func WithMaxPageSize(n int64) StoreOption {
+	return func(c *storeConfig) {
+		c.maxPageSize = n
+	}
+}
+
+// limitPage returns options with a limit of at most the maximum page size of the store.
+func (s *wrappedStore) limitPage(options *metainternalversion.ListOptions) *metainternalversion.ListOptions {
+	if s.maxPageSize <= 0 || options == nil || options.Limit <= s.maxPageSize {
+		return options
+	}
+	limited := *options
+	limited.Limit = s.maxPageSize
+
+	return &limited
+}
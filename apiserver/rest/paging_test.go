@@ -0,0 +1,35 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WithMaxPageSize", func() {
+	It("should reduce larger limits to the maximum page size", func() {
+		store := &wrappedStore{maxPageSize: 2}
+		options := &metainternalversion.ListOptions{Limit: 5, Continue: "token"}
+		limited := store.limitPage(options)
+		Expect(limited.Limit).To(Equal(int64(2)))
+		Expect(limited.Continue).To(Equal("token"))
+		Expect(options.Limit).To(Equal(int64(5)))
+
+		Expect(store.limitPage(&metainternalversion.ListOptions{Limit: 1}).Limit).To(Equal(int64(1)))
+	})
+
+	It("should not limit lists without a limit", func() {
+		store := &wrappedStore{maxPageSize: 2}
+		Expect(store.limitPage(&metainternalversion.ListOptions{}).Limit).To(BeZero())
+		Expect(store.limitPage(nil)).To(BeNil())
+	})
+
+	It("should not limit lists without a maximum page size", func() {
+		store := &wrappedStore{}
+		Expect(store.limitPage(&metainternalversion.ListOptions{Limit: 5000}).Limit).To(Equal(int64(5000)))
+	})
+})
@@ -0,0 +1,36 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/registry/generic"
+)
+
+// StoragePrefixRESTOptionsGetter wraps the given getter so that the storage created through it
+// keeps its objects under prefix in etcd instead of the prefix configured by --etcd-prefix, e.g.
+// under /archive/<group>/<resource>/ for the prefix /archive, so large resources can be backed
+// up and restored separately. Moving a resource to another prefix does not move its objects.
+func StoragePrefixRESTOptionsGetter(delegate generic.RESTOptionsGetter, prefix string) generic.RESTOptionsGetter {
+	return &storagePrefixRESTOptionsGetter{delegate: delegate, prefix: prefix}
+}
+
+type storagePrefixRESTOptionsGetter struct {
+	delegate generic.RESTOptionsGetter
+	prefix   string
+}
+
+// GetRESTOptions returns the delegate's options with a copy of the storage configuration using the prefix.
+func (g *storagePrefixRESTOptionsGetter) GetRESTOptions(gr schema.GroupResource, example runtime.Object) (generic.RESTOptions, error) {
+	opts, err := g.delegate.GetRESTOptions(gr, example)
+	if err != nil || opts.StorageConfig == nil {
+		return opts, err
+	}
+	config := *opts.StorageConfig
+	config.Prefix = g.prefix
+	opts.StorageConfig = &config
+
+	return opts, nil
+}
@@ -0,0 +1,37 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/storage/storagebackend"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fixedRESTOptionsGetter returns the same options for every resource.
+type fixedRESTOptionsGetter struct {
+	opts generic.RESTOptions
+}
+
+func (g *fixedRESTOptionsGetter) GetRESTOptions(schema.GroupResource, runtime.Object) (generic.RESTOptions, error) {
+	return g.opts, nil
+}
+
+var _ = Describe("StoragePrefixRESTOptionsGetter", func() {
+	It("should replace the etcd prefix of the storage", func() {
+		config := &storagebackend.ConfigForResource{Config: storagebackend.Config{Prefix: "/registry"}}
+		delegate := &fixedRESTOptionsGetter{opts: generic.RESTOptions{StorageConfig: config, ResourcePrefix: "arc/testobjs"}}
+
+		opts, err := StoragePrefixRESTOptionsGetter(delegate, "/archive").
+			GetRESTOptions(schema.GroupResource{Group: "arc", Resource: "testobjs"}, &testObj{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(opts.StorageConfig.Prefix).To(Equal("/archive"))
+		Expect(opts.ResourcePrefix).To(Equal("arc/testobjs"))
+		Expect(config.Prefix).To(Equal("/registry"))
+	})
+})
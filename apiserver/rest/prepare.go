@@ -0,0 +1,132 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+)
+
+// prepareErrorKey is the context key of the prepareError of a request.
+type prepareErrorKey struct{}
+
+// prepareError holds the first error of the hooks run by the strategy before validation, which
+// cannot return errors through the strategy interfaces of the generic registry.
+type prepareError struct {
+	mu  sync.Mutex
+	err error
+}
+
+// withPrepareError returns a context collecting the errors of the prepare hooks.
+func withPrepareError(ctx context.Context) (context.Context, *prepareError) {
+	p := &prepareError{}
+
+	return context.WithValue(ctx, prepareErrorKey{}, p), p
+}
+
+// RejectPrepare rejects the request of ctx with err from within the PrepareForCreate or
+// PrepareForUpdate hook of a strategy, which cannot return errors. It allows custom strategies
+// to fail like PrepareForCreaterWithError and PrepareForUpdaterWithError. The first error is
+// returned like an admission failure and validation of DefaultStrategy is skipped.
+//
+// It returns false if errors are not collected for the request, i.e. if the store has not been
+// created by NewStore or neither the object nor the strategy has hooks which may fail, see NewStore
+// and Compose.
+func RejectPrepare(ctx context.Context, err error) bool {
+	p, ok := ctx.Value(prepareErrorKey{}).(*prepareError)
+	if !ok {
+		return false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err == nil {
+		p.err = err
+	}
+
+	return true
+}
+
+// prepareErrorFrom returns the error recorded for the request of ctx.
+func prepareErrorFrom(ctx context.Context) error {
+	p, ok := ctx.Value(prepareErrorKey{}).(*prepareError)
+	if !ok {
+		return nil
+	}
+
+	return p.get()
+}
+
+func (p *prepareError) get() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.err
+}
+
+// prepareForCreate calls the create hooks of obj and records a returned error for the request.
+func prepareForCreate(ctx context.Context, obj runtime.Object) {
+	switch v := obj.(type) {
+	case PrepareForCreaterWithError:
+		rejectPrepare(ctx, "PrepareForCreate", v.PrepareForCreate(ctx))
+	case PrepareForCreater:
+		v.PrepareForCreate(ctx)
+	}
+	mutate(ctx, obj)
+}
+
+// prepareForUpdate calls the update hooks of obj and records a returned error for the request.
+func prepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	switch v := obj.(type) {
+	case PrepareForUpdaterWithError:
+		rejectPrepare(ctx, "PrepareForUpdate", v.PrepareForUpdate(ctx, old))
+	case PrepareForUpdater:
+		v.PrepareForUpdate(ctx, old)
+	}
+	mutate(ctx, obj)
+}
+
+// mutate calls Mutate if obj implements Mutator and records a returned error for the request.
+// Mutate is skipped if a previous hook failed already.
+func mutate(ctx context.Context, obj runtime.Object) {
+	m, ok := obj.(Mutator)
+	if !ok || prepareErrorFrom(ctx) != nil {
+		return
+	}
+	rejectPrepare(ctx, "Mutate", m.Mutate(ctx))
+}
+
+// rejectPrepare records a non-nil err of the named hook for the request.
+func rejectPrepare(ctx context.Context, hook string, err error) {
+	if err != nil && !RejectPrepare(ctx, err) {
+		utilruntime.HandleErrorWithContext(ctx, err, "Hook failed for a store not created by NewStore", "hook", hook)
+	}
+}
+
+// preparesMayFail returns true if the prepare hooks of obj may fail, in which case the store
+// needs to collect their errors.
+func preparesMayFail(obj runtime.Object) bool {
+	switch obj.(type) {
+	case PrepareForCreaterWithError, PrepareForUpdaterWithError, Mutator:
+		return true
+	default:
+		return false
+	}
+}
+
+// admissionError returns err like an admission failure of the named object, i.e. as a
+// Forbidden status unless it is an API status error already.
+func admissionError(gr schema.GroupResource, name string, err error) error {
+	var status apierrors.APIStatus
+	if errors.As(err, &status) {
+		return err
+	}
+
+	return apierrors.NewForbidden(gr, name, err)
+}
@@ -0,0 +1,158 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"errors"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// mutatorObj implements Mutator by filling a computed label.
+type mutatorObj struct {
+	testObj
+	err error
+}
+
+func (m *mutatorObj) DeepCopyObject() runtime.Object {
+	clone := *m
+
+	return &clone
+}
+
+func (m *mutatorObj) Mutate(ctx context.Context) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.Labels = map[string]string{"hash": m.Name}
+
+	return nil
+}
+
+// fallibleObj implements PrepareForCreaterWithError and PrepareForUpdaterWithError.
+type fallibleObj struct {
+	mutatorObj
+	prepareErr error
+}
+
+func (f *fallibleObj) DeepCopyObject() runtime.Object {
+	clone := *f
+
+	return &clone
+}
+
+func (f *fallibleObj) PrepareForCreate(ctx context.Context) error {
+	f.Flag = true
+
+	return f.prepareErr
+}
+
+func (f *fallibleObj) PrepareForUpdate(ctx context.Context, old runtime.Object) error {
+	f.Flag = true
+
+	return f.prepareErr
+}
+
+var _ = Describe("PrepareForCreaterWithError and PrepareForUpdaterWithError", func() {
+	ds := DefaultStrategy{}
+
+	It("should be preferred over the hooks which cannot fail", func() {
+		obj := &fallibleObj{}
+		obj.Name = "foo"
+		ctx, p := withPrepareError(context.Background())
+		ds.PrepareForCreate(ctx, obj)
+		Expect(obj.Flag).To(BeTrue())
+		Expect(obj.Labels).To(HaveKeyWithValue("hash", "foo"))
+		Expect(p.get()).ToNot(HaveOccurred())
+		Expect(preparesMayFail(obj)).To(BeTrue())
+	})
+
+	It("should reject the request and skip Mutate on create", func() {
+		ctx, p := withPrepareError(context.Background())
+		obj := &fallibleObj{prepareErr: errors.New("lookup failed")}
+		ds.PrepareForCreate(ctx, obj)
+		Expect(p.get()).To(MatchError("lookup failed"))
+		Expect(obj.Labels).To(BeEmpty())
+		Expect(ds.Validate(ctx, obj)).To(HaveLen(1))
+	})
+
+	It("should reject the request and skip Mutate on update", func() {
+		ctx, p := withPrepareError(context.Background())
+		obj := &fallibleObj{prepareErr: errors.New("lookup failed")}
+		ds.PrepareForUpdate(ctx, obj, &fallibleObj{})
+		Expect(p.get()).To(MatchError("lookup failed"))
+		Expect(obj.Labels).To(BeEmpty())
+		Expect(ds.ValidateUpdate(ctx, obj, &fallibleObj{})).To(HaveLen(1))
+	})
+
+	It("should validate as usual if the store does not collect errors", func() {
+		obj := &fallibleObj{prepareErr: errors.New("lookup failed")}
+		Expect(func() { ds.PrepareForCreate(context.Background(), obj) }).ToNot(Panic())
+		// testObj always reports an invalid spec.
+		Expect(ds.Validate(context.Background(), obj)).To(ConsistOf(HaveField("Field", "spec")))
+	})
+})
+
+var _ = Describe("Mutator", func() {
+	var (
+		ds = DefaultStrategy{}
+		gr = schema.GroupResource{Group: "arc", Resource: "testobjs"}
+	)
+
+	It("should be called after PrepareForCreate and PrepareForUpdate", func() {
+		obj := &mutatorObj{}
+		obj.Name = "foo"
+		ds.PrepareForCreate(context.Background(), obj)
+		Expect(obj.Flag).To(BeTrue())
+		Expect(obj.Labels).To(HaveKeyWithValue("hash", "foo"))
+
+		obj.Labels = nil
+		ds.PrepareForUpdate(context.Background(), obj, &mutatorObj{})
+		Expect(obj.Labels).To(HaveKeyWithValue("hash", "foo"))
+	})
+
+	It("should record the error and skip validation", func() {
+		ctx, p := withPrepareError(context.Background())
+		obj := &mutatorObj{err: errors.New("cannot compute hash")}
+		ds.PrepareForCreate(ctx, obj)
+		Expect(p.get()).To(MatchError("cannot compute hash"))
+
+		errs := ds.Validate(ctx, obj)
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Detail).To(ContainSubstring("cannot compute hash"))
+		Expect(ds.ValidateUpdate(ctx, obj, &mutatorObj{})).To(HaveLen(1))
+	})
+
+	It("should keep the first error", func() {
+		ctx, p := withPrepareError(context.Background())
+		Expect(RejectPrepare(ctx, errors.New("first"))).To(BeTrue())
+		Expect(RejectPrepare(ctx, errors.New("second"))).To(BeTrue())
+		Expect(p.get()).To(MatchError("first"))
+	})
+
+	It("should not record errors for stores which do not collect them", func() {
+		Expect(RejectPrepare(context.Background(), errors.New("lost"))).To(BeFalse())
+		Expect(prepareErrorFrom(context.Background())).ToNot(HaveOccurred())
+	})
+
+	It("should only require collecting errors for objects implementing Mutator", func() {
+		Expect(preparesMayFail(&mutatorObj{})).To(BeTrue())
+		Expect(preparesMayFail(&testObj{})).To(BeFalse())
+	})
+
+	It("should return errors like admission failures", func() {
+		err := admissionError(gr, "foo", errors.New("cannot compute hash"))
+		Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		Expect(err).To(MatchError(ContainSubstring("cannot compute hash")))
+
+		conflict := apierrors.NewConflict(gr, "foo", errors.New("taken"))
+		Expect(admissionError(gr, "foo", conflict)).To(BeIdenticalTo(conflict))
+	})
+})
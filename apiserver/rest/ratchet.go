@@ -0,0 +1,33 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"go.opendefense.cloud/kit/apiserver/diff"
+)
+
+// RatchetErrors drops the errors of fields which have not been changed by the update from old to
+// obj, so objects which became invalid by tightened validation can still be updated as long as
+// their invalid fields are kept. Errors without field and internal errors are always kept, as
+// are all errors if the objects cannot be compared.
+func RatchetErrors(errs field.ErrorList, obj, old runtime.Object) field.ErrorList {
+	if len(errs) == 0 {
+		return errs
+	}
+	d, err := diff.Objects(old, obj)
+	if err != nil {
+		return errs
+	}
+	kept := field.ErrorList{}
+	for _, e := range errs {
+		if e.Field == "" || e.Field == "<nil>" || e.Type == field.ErrorTypeInternal || d.Touches(e.Field) {
+			kept = append(kept, e)
+		}
+	}
+
+	return kept
+}
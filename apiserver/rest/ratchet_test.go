@@ -0,0 +1,56 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RatchetErrors", func() {
+	var old, obj *testObj
+
+	BeforeEach(func() {
+		old = &testObj{ObjectMeta: metav1.ObjectMeta{Name: "test", Labels: map[string]string{"a": "b"}}, Status: "invalid"}
+		obj = old.DeepCopyObject().(*testObj)
+		obj.Flag = true
+	})
+
+	It("should drop the errors of unchanged fields", func() {
+		errs := field.ErrorList{
+			field.Invalid(field.NewPath("Status"), "invalid", "must be valid"),
+			field.Invalid(field.NewPath("Flag"), true, "must not be set"),
+			field.Invalid(field.NewPath("metadata", "labels").Key("a"), "b", "must be valid"),
+		}
+		Expect(RatchetErrors(errs, obj, old)).To(Equal(errs[1:2]))
+	})
+
+	It("should keep the errors of changed fields", func() {
+		obj.Status = "still invalid"
+		errs := field.ErrorList{field.Invalid(field.NewPath("Status"), "still invalid", "must be valid")}
+		Expect(RatchetErrors(errs, obj, old)).To(Equal(errs))
+	})
+
+	It("should keep errors without field and internal errors", func() {
+		errs := field.ErrorList{
+			field.Invalid(nil, nil, "invalid"),
+			field.InternalError(field.NewPath("Status"), errors.New("failed")),
+		}
+		Expect(RatchetErrors(errs, obj, old)).To(Equal(errs))
+	})
+
+	It("should ratchet update validation of the strategy if enabled", func() {
+		strategy := DefaultStrategy{}
+		Expect(strategy.ValidateUpdate(context.Background(), obj, old)).To(HaveLen(1))
+
+		strategy.ValidationRatcheting = true
+		Expect(strategy.ValidateUpdate(context.Background(), obj, old)).To(BeEmpty())
+	})
+})
@@ -5,15 +5,19 @@ package rest
 
 import (
 	"fmt"
+	"slices"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/registry/generic"
 	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
 	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
 
 	"go.opendefense.cloud/kit/apiserver/resource"
 )
@@ -22,16 +26,41 @@ import (
 // It represents a generic storage backend for Kubernetes resources.
 type Storage = rest.Storage
 
-// GetAttrs extracts the labels and fields from a runtime.Object for use in storage predicates.
-// Returns an error if the object does not implement resource.Object (i.e., lacks metadata).
+// LabelsProvider can be implemented by objects without metadata accessors, e.g. of virtual
+// resources, to make their labels selectable by label selectors.
+type LabelsProvider interface {
+	GetLabels() map[string]string
+}
+
+// GetAttrs extracts the labels and fields from a runtime.Object for use in storage predicates,
+// including the fields of a SelectableFieldsProvider. Objects which don't implement
+// resource.Object, e.g. unstructured objects or partial metadata of virtual resources, are read
+// with their metadata accessors, or only provide their labels with LabelsProvider.
+// Returns an error if the object has neither metadata nor labels.
 func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
-	provider, ok := obj.(resource.Object)
-	if !ok {
-		return nil, nil, fmt.Errorf("given object of type %T does not have metadata", obj)
+	var ls labels.Set
+	var fs fields.Set
+	if provider, ok := obj.(resource.Object); ok {
+		om := provider.GetObjectMeta()
+		ls, fs = om.GetLabels(), SelectableFields(om)
+	} else if accessor, err := meta.Accessor(obj); err == nil {
+		ls = accessor.GetLabels()
+		fs = SelectableFields(&metav1.ObjectMeta{Name: accessor.GetName(), Namespace: accessor.GetNamespace()})
+	} else if provider, ok := obj.(LabelsProvider); ok {
+		ls, fs = provider.GetLabels(), fields.Set{}
+	} else {
+		return nil, nil, fmt.Errorf("given object of type %T: %w", obj, ErrNotResourceObject)
+	}
+	if p, ok := obj.(SelectableFieldsProvider); ok {
+		// The metadata fields cannot be overridden.
+		for key, value := range p.SelectableFields() {
+			if _, ok := fs[key]; !ok {
+				fs[key] = value
+			}
+		}
 	}
-	om := provider.GetObjectMeta()
 
-	return om.GetLabels(), SelectableFields(om), nil
+	return ls, fs, nil
 }
 
 // SelectableFields returns a set of fields (name, namespace, etc.) for the given ObjectMeta.
@@ -50,19 +79,37 @@ func SelectableFields(obj *metav1.ObjectMeta) fields.Set {
 //   - gr: GroupResource describing the resource
 //   - strategy: Strategy implementation for create/update/delete/table
 //   - optsGetter: RESTOptionsGetter for storage backend configuration
+//   - opts: optional StoreOptions, e.g. WithVerbs, WithExternalValidators, WithMaxPageSize, WithInflightLimit or WithCategories
 //
 // Returns:
-//   - rest.Storage: configured store for the resource (may be wrapped for ShortNamesProvider)
-//   - error: if store setup fails
+//   - rest.Storage: configured store for the resource (may be wrapped for ShortNamesProvider, CategoriesProvider, WithCategories, WithVerbs, WithMaxPageSize, WithInflightLimit or prepare hooks which may fail)
+//   - error: if store setup fails, wrapping ErrStoreInit and the cause
 func NewStore(
 	scheme *runtime.Scheme,
 	single, list func() runtime.Object,
 	gr schema.GroupResource,
-	strategy Strategy, optsGetter generic.RESTOptionsGetter) (rest.Storage, error) {
+	strategy Strategy, optsGetter generic.RESTOptionsGetter, opts ...StoreOption) (rest.Storage, error) {
+	cfg := &storeConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	verbs, err := cfg.verbSet()
+	if err != nil {
+		return nil, storeInitError(gr, err)
+	}
+
+	// The selectable fields of the objects are indexed by the watch cache.
+	indexFields := selectableFieldKeys(single)
+	options := &generic.StoreOptions{RESTOptions: optsGetter, AttrFunc: GetAttrs, Indexers: fieldIndexers(indexFields)}
 	store := &genericregistry.Store{
-		NewFunc:                   single,
-		NewListFunc:               list,
-		PredicateFunc:             strategy.Match,
+		NewFunc:     single,
+		NewListFunc: list,
+		PredicateFunc: func(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+			p := strategy.Match(label, field)
+			p.IndexFields = indexFields
+
+			return p
+		},
 		DefaultQualifiedResource:  gr,
 		SingularQualifiedResource: gr,
 		TableConvertor:            strategy,
@@ -71,6 +118,25 @@ func NewStore(
 		DeleteStrategy:            strategy,
 	}
 
+	// Objects in a legacy form are migrated when they are read.
+	if _, ok := single().(Migrator); ok {
+		store.Decorator = decorateMigrated(gr)
+	}
+
+	// External validators run after the validation of the strategy.
+	if len(cfg.externalValidators) > 0 {
+		validators := make([]*ExternalValidator, 0, len(cfg.externalValidators))
+		for _, v := range cfg.externalValidators {
+			completed, err := v.complete()
+			if err != nil {
+				return nil, storeInitError(gr, err)
+			}
+			validators = append(validators, completed)
+		}
+		store.CreateStrategy = &externalValidationCreateStrategy{RESTCreateStrategy: strategy, validators: validators}
+		store.UpdateStrategy = &externalValidationUpdateStrategy{RESTUpdateStrategy: strategy, validators: validators}
+	}
+
 	// If the strategy implements SingularNameProvider, use the custom singular name.
 	if sn, ok := strategy.(SingularNameProvider); ok {
 		singularName := sn.GetSingularName()
@@ -82,43 +148,84 @@ func NewStore(
 		}
 	}
 
-	// If the strategy implements ShortNamesProvider, wrap the store to expose short names.
-	if sn, ok := strategy.(ShortNamesProvider); ok && len(sn.ShortNames()) > 0 {
-		wrapped := &storeWithShortNames{Store: store, shortNames: sn.ShortNames()}
-		options := &generic.StoreOptions{RESTOptions: optsGetter, AttrFunc: GetAttrs}
+	// If the strategy implements ShortNamesProvider or CategoriesProvider, verbs are restricted,
+	// pages are limited or the prepare hooks of the object or the strategy may fail, wrap the store.
+	var shortNames, categories []string
+	if sn, ok := strategy.(ShortNamesProvider); ok {
+		shortNames = sn.ShortNames()
+	}
+	if c, ok := strategy.(CategoriesProvider); ok {
+		categories = slices.Clone(c.Categories())
+	}
+	for _, c := range cfg.categories {
+		if !slices.Contains(categories, c) {
+			categories = append(categories, c)
+		}
+	}
+	mayFail := preparesMayFail(single()) || strategyPreparesMayFail(strategy)
+	inflight := newInflightLimiter(gr, cfg.inflightLimit)
+	if len(shortNames) > 0 || len(categories) > 0 || verbs != nil || cfg.maxPageSize > 0 || inflight != nil || mayFail {
+		wrapped := &wrappedStore{
+			Store: store, shortNames: shortNames, categories: categories, verbs: verbs, maxPageSize: cfg.maxPageSize,
+			inflight: inflight, preparesMayFail: mayFail,
+		}
 		if err := wrapped.CompleteWithOptions(options); err != nil {
-			return nil, err
+			return nil, storeInitError(gr, err)
+		}
+		// Only expose the interfaces of the enabled verbs, so they are the only ones installed.
+		if verbs != nil {
+			return newRestrictedStore(wrapped, interfaceMask(verbs)), nil
 		}
 
 		return wrapped, nil
 	}
 
-	// StoreOptions wires up REST options and attribute extraction for filtering.
-	options := &generic.StoreOptions{RESTOptions: optsGetter, AttrFunc: GetAttrs}
+	// StoreOptions wires up REST options, attribute extraction for filtering and indexes.
 	if err := store.CompleteWithOptions(options); err != nil {
-		return nil, err
+		return nil, storeInitError(gr, err)
 	}
 
 	return store, nil
 }
 
-// storeWithShortNames wraps a genericregistry.Store to provide short names for a resource.
-// This implements the ShortNamesProvider interface, allowing kubectl to use short aliases.
-type storeWithShortNames struct {
+// storeInitError wraps the error err of setting up the store of gr with ErrStoreInit.
+func storeInitError(gr schema.GroupResource, err error) error {
+	return fmt.Errorf("%w %s: %w", ErrStoreInit, gr, err)
+}
+
+// wrappedStore wraps a genericregistry.Store to provide short names and categories for a
+// resource, to reject verbs which are not enabled, to limit the size of pages and the number of
+// concurrent expensive lists and to reject requests failed by prepare hooks.
+// It implements the ShortNamesProvider and CategoriesProvider interfaces, allowing kubectl to
+// use short aliases and categories.
+type wrappedStore struct {
 	*genericregistry.Store
-	shortNames []string
+	shortNames      []string
+	categories      []string
+	verbs           sets.Set[string]
+	maxPageSize     int64
+	inflight        *inflightLimiter
+	preparesMayFail bool
 }
 
 // ShortNames returns the list of short names for the resource.
-func (s *storeWithShortNames) ShortNames() []string {
+func (s *wrappedStore) ShortNames() []string {
 	return s.shortNames
 }
 
+// Categories returns the categories of the resource.
+func (s *wrappedStore) Categories() []string {
+	return s.categories
+}
+
 // Unwrap returns the underlying *genericregistry.Store.
 // This is useful when you need to access the store directly, e.g., for setting
 // the status subresource update strategy.
 func Unwrap(s rest.Storage) *genericregistry.Store {
-	if wrapped, ok := s.(*storeWithShortNames); ok {
+	if restricted, ok := s.(interface{ unwrap() *wrappedStore }); ok {
+		return restricted.unwrap().Store
+	}
+	if wrapped, ok := s.(*wrappedStore); ok {
 		return wrapped.Store
 	}
 
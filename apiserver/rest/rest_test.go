@@ -5,11 +5,25 @@ package rest
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
 
+// labelsObj only provides its labels.
+type labelsObj struct {
+	runtime.TypeMeta
+	labels map[string]string
+}
+
+func (o *labelsObj) DeepCopyObject() runtime.Object {
+	return &labelsObj{TypeMeta: o.TypeMeta, labels: o.labels}
+}
+
+func (o *labelsObj) GetLabels() map[string]string { return o.labels }
+
 var _ = Describe("GetAttrs and SelectableFields", func() {
 	It("should extract labels and fields from a resource.Object", func() {
 		obj := &testObj{}
@@ -23,6 +37,39 @@ var _ = Describe("GetAttrs and SelectableFields", func() {
 		Expect(fieldsSet).To(HaveKeyWithValue("metadata.namespace", "ns"))
 	})
 
+	It("should extract labels and fields from partial metadata", func() {
+		obj := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: "myname", Namespace: "ns", Labels: map[string]string{"foo": "bar"}}}
+		labelsSet, fieldsSet, err := GetAttrs(obj)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(labelsSet).To(HaveKeyWithValue("foo", "bar"))
+		Expect(fieldsSet).To(HaveKeyWithValue("metadata.name", "myname"))
+		Expect(fieldsSet).To(HaveKeyWithValue("metadata.namespace", "ns"))
+	})
+
+	It("should extract labels and fields from an unstructured object", func() {
+		obj := &unstructured.Unstructured{}
+		obj.SetName("myname")
+		obj.SetNamespace("ns")
+		obj.SetLabels(map[string]string{"foo": "bar"})
+		labelsSet, fieldsSet, err := GetAttrs(obj)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(labelsSet).To(HaveKeyWithValue("foo", "bar"))
+		Expect(fieldsSet).To(HaveKeyWithValue("metadata.name", "myname"))
+		Expect(fieldsSet).To(HaveKeyWithValue("metadata.namespace", "ns"))
+	})
+
+	It("should extract only the labels of a LabelsProvider", func() {
+		labelsSet, fieldsSet, err := GetAttrs(&labelsObj{labels: map[string]string{"foo": "bar"}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(labelsSet).To(HaveKeyWithValue("foo", "bar"))
+		Expect(fieldsSet).To(BeEmpty())
+	})
+
+	It("should reject objects without metadata", func() {
+		_, _, err := GetAttrs(&metav1.Status{})
+		Expect(err).To(MatchError(ErrNotResourceObject))
+	})
+
 	It("SelectableFields should return correct fields from ObjectMeta", func() {
 		meta := &metav1.ObjectMeta{Name: "n", Namespace: "ns", Labels: map[string]string{"x": "y"}}
 		fieldsSet := SelectableFields(meta)
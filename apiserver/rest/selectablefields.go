@@ -0,0 +1,97 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/client-go/tools/cache"
+)
+
+// SelectableFieldsProvider can be implemented by objects to make fields besides metadata.name and
+// metadata.namespace selectable by field selectors, e.g. computed from the status:
+//
+//	func (b *Bar) SelectableFields() fields.Set {
+//	    return fields.Set{"status.phase": string(b.Status.Phase)}
+//	}
+//
+// Clients then list and watch with --field-selector status.phase=Ready instead of filtering
+// themselves. The fields are indexed by the watch cache. SelectableFields must return the same
+// keys for every object, including empty ones, as the keys of a new object are the supported
+// field labels of the resource.
+type SelectableFieldsProvider interface {
+	SelectableFields() fields.Set
+}
+
+// selectableFieldKeys returns the sorted keys of the fields selectable in addition to the metadata
+// fields of the objects returned by newObj.
+func selectableFieldKeys(newObj func() runtime.Object) []string {
+	p, ok := newObj().(SelectableFieldsProvider)
+	if !ok {
+		return nil
+	}
+
+	return slices.Sorted(maps.Keys(p.SelectableFields()))
+}
+
+// fieldIndexers returns the indexers of the watch cache for the given selectable fields, or nil
+// if there are none.
+func fieldIndexers(keys []string) *cache.Indexers {
+	if len(keys) == 0 {
+		return nil
+	}
+	indexers := cache.Indexers{}
+	for _, key := range keys {
+		indexers[storage.FieldIndex(key)] = func(obj any) ([]string, error) {
+			o, ok := obj.(runtime.Object)
+			if !ok {
+				return nil, fmt.Errorf("%w %T", ErrUnexpectedType, obj)
+			}
+			_, fs, err := GetAttrs(o)
+			if err != nil {
+				return nil, err
+			}
+
+			return []string{fs[key]}, nil
+		}
+	}
+
+	return &indexers
+}
+
+// AddFieldLabelConversions accepts the selectable fields of obj, see SelectableFieldsProvider, in
+// the field selectors of its kind in every group version of gvs. Field selectors of requests
+// are converted by the scheme, which rejects all fields but metadata.name and metadata.namespace
+// by default. It does nothing for objects without further selectable fields.
+func AddFieldLabelConversions(scheme *runtime.Scheme, obj runtime.Object, gvs ...schema.GroupVersion) error {
+	keys := selectableFieldKeys(func() runtime.Object { return obj })
+	if len(keys) == 0 {
+		return nil
+	}
+	kinds, _, err := scheme.ObjectKinds(obj)
+	if err != nil {
+		return err
+	}
+	for _, gv := range gvs {
+		gvk := gv.WithKind(kinds[0].Kind)
+		err := scheme.AddFieldLabelConversionFunc(gvk, func(label, value string) (string, string, error) {
+			if label == "metadata.name" || label == "metadata.namespace" || slices.Contains(keys, label) {
+				return label, value, nil
+			}
+
+			return "", "", fmt.Errorf("%w for %s: %s", ErrFieldLabelNotSupported, gvk, label)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
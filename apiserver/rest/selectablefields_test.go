@@ -0,0 +1,73 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/storage"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// phaseObj makes its phase selectable.
+type phaseObj struct {
+	statusTestObj
+}
+
+func (o *phaseObj) DeepCopyObject() runtime.Object {
+	clone := *o
+	o.ObjectMeta.DeepCopyInto(&clone.ObjectMeta)
+
+	return &clone
+}
+
+func (o *phaseObj) SelectableFields() fields.Set {
+	return fields.Set{"status.phase": o.Status.Phase, "metadata.name": "overridden"}
+}
+
+var _ = Describe("Selectable fields", func() {
+	var obj *phaseObj
+
+	BeforeEach(func() {
+		obj = &phaseObj{}
+		obj.Name = "a"
+		obj.Status.Phase = "Ready"
+	})
+
+	It("should add the selectable fields to the attributes", func() {
+		_, fs, err := GetAttrs(obj)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fs).To(HaveKeyWithValue("status.phase", "Ready"))
+		Expect(fs).To(HaveKeyWithValue("metadata.name", "a"))
+	})
+
+	It("should index the selectable fields", func() {
+		keys := selectableFieldKeys(func() runtime.Object { return &phaseObj{} })
+		Expect(keys).To(Equal([]string{"metadata.name", "status.phase"}))
+		indexers := fieldIndexers(keys)
+		Expect(indexers).NotTo(BeNil())
+		values, err := (*indexers)[storage.FieldIndex("status.phase")](obj)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(values).To(Equal([]string{"Ready"}))
+
+		Expect(fieldIndexers(selectableFieldKeys(func() runtime.Object { return &testObj{} }))).To(BeNil())
+	})
+
+	It("should accept the selectable fields in field selectors", func() {
+		gv := schema.GroupVersion{Group: "test.opendefense.cloud", Version: "v1"}
+		scheme := runtime.NewScheme()
+		scheme.AddKnownTypeWithName(gv.WithKind("Phase"), &phaseObj{})
+		Expect(AddFieldLabelConversions(scheme, &phaseObj{}, gv)).To(Succeed())
+
+		for _, label := range []string{"status.phase", "metadata.namespace"} {
+			_, _, err := scheme.ConvertFieldLabel(gv.WithKind("Phase"), label, "x")
+			Expect(err).NotTo(HaveOccurred())
+		}
+		_, _, err := scheme.ConvertFieldLabel(gv.WithKind("Phase"), "spec.message", "x")
+		Expect(err).To(MatchError(ErrFieldLabelNotSupported))
+	})
+})
@@ -0,0 +1,182 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+	storeerr "k8s.io/apiserver/pkg/storage/errors"
+	"k8s.io/utils/ptr"
+
+	"go.opendefense.cloud/kit/apiserver/resource"
+)
+
+// DefaultReapInterval is the interval in which soft-deleted objects are checked for expiry.
+const DefaultReapInterval = time.Minute
+
+// CheckGracefulDelete delegates to the object's resource.GracefulDeleter interface if present.
+// Otherwise, it keeps deleted objects for SoftDeleteRetention if it is set: they get a
+// deletionTimestamp at the end of the retention and are removed by RunReaper once it passed.
+// Clients may shorten the retention with the grace period of the request, a grace period of 0,
+// e.g. kubectl delete --grace-period=0 --force, deletes the object immediately.
+func (d DefaultStrategy) CheckGracefulDelete(ctx context.Context, obj runtime.Object, options *metav1.DeleteOptions) bool {
+	if g, ok := obj.(resource.GracefulDeleter); ok {
+		return g.CheckGracefulDelete(ctx, options)
+	}
+	retention := int64(d.SoftDeleteRetention / time.Second)
+	if retention <= 0 {
+		return false
+	}
+	if options.GracePeriodSeconds == nil || *options.GracePeriodSeconds > retention {
+		options.GracePeriodSeconds = ptr.To(retention)
+	}
+
+	return *options.GracePeriodSeconds > 0
+}
+
+// IsSoftDeleted returns true if the object has been deleted, but is kept until its
+// deletionTimestamp, see DefaultStrategy.SoftDeleteRetention.
+func IsSoftDeleted(obj metav1.Object) bool {
+	grace := obj.GetDeletionGracePeriodSeconds()
+
+	return obj.GetDeletionTimestamp() != nil && grace != nil && *grace > 0
+}
+
+// NewUndeleteStore returns the storage of the undelete subresource of store, which restores
+// soft-deleted objects on POST. Restoring objects which are not soft-deleted fails with a
+// Conflict status.
+func NewUndeleteStore(store rest.Storage) rest.Storage {
+	return &undeleteStore{store: Unwrap(store)}
+}
+
+// undeleteStore serves the undelete subresource.
+type undeleteStore struct {
+	store *genericregistry.Store
+}
+
+var (
+	_ rest.Connecter       = &undeleteStore{}
+	_ rest.StorageMetadata = &undeleteStore{}
+)
+
+func (s *undeleteStore) New() runtime.Object { return s.store.New() }
+
+func (s *undeleteStore) Destroy() {}
+
+func (s *undeleteStore) NamespaceScoped() bool { return s.store.NamespaceScoped() }
+
+// ConnectMethods returns the methods served by the subresource.
+func (s *undeleteStore) ConnectMethods() []string { return []string{http.MethodPost} }
+
+// ProducesMIMETypes returns nil, the subresource responds with the media types of the resource.
+func (s *undeleteStore) ProducesMIMETypes(string) []string { return nil }
+
+// ProducesObject returns the restored object, which is documented as response in OpenAPI.
+func (s *undeleteStore) ProducesObject(string) any { return s.store.New() }
+
+// NewConnectOptions returns nil, the subresource has no options.
+func (s *undeleteStore) NewConnectOptions() (runtime.Object, bool, string) { return nil, false, "" }
+
+// Connect returns a handler restoring the named object and responding with it.
+func (s *undeleteStore) Connect(ctx context.Context, name string, _ runtime.Object, responder rest.Responder) (http.Handler, error) {
+	return http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		obj, err := s.undelete(ctx, name)
+		if err != nil {
+			responder.Error(err)
+			return
+		}
+		responder.Object(http.StatusOK, obj)
+	}), nil
+}
+
+// undelete clears the deletionTimestamp of a soft-deleted object. It writes to storage
+// directly, since updates through the store keep the deletionTimestamp.
+func (s *undeleteStore) undelete(ctx context.Context, name string) (runtime.Object, error) {
+	key, err := s.store.KeyFunc(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	out := s.store.NewFunc()
+	err = s.store.Storage.GuaranteedUpdate(ctx, key, out, false, nil, storage.SimpleUpdate(func(existing runtime.Object) (runtime.Object, error) {
+		m, err := meta.Accessor(existing)
+		if err != nil {
+			return nil, err
+		}
+		if !IsSoftDeleted(m) {
+			return nil, apierrors.NewConflict(s.store.DefaultQualifiedResource, name, errors.New("the object is not soft-deleted"))
+		}
+		m.SetDeletionTimestamp(nil)
+		m.SetDeletionGracePeriodSeconds(nil)
+
+		return existing, nil
+	}), false, nil)
+	if err != nil {
+		return nil, storeerr.InterpretUpdateError(err, s.store.DefaultQualifiedResource, name)
+	}
+
+	return out, nil
+}
+
+// RunReaper deletes the soft-deleted objects of store whose retention expired in the given
+// interval until ctx is done.
+func RunReaper(ctx context.Context, store rest.Storage, interval time.Duration) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := Reap(ctx, store); err != nil {
+			utilruntime.HandleErrorWithContext(ctx, err, "Failed to delete expired objects", "resource", Unwrap(store).DefaultQualifiedResource)
+		}
+	}, interval)
+}
+
+// Reap deletes the soft-deleted objects of store whose retention expired. Objects with
+// finalizers are deleted once their finalizers have been removed.
+func Reap(ctx context.Context, store rest.Storage) error {
+	s := Unwrap(store)
+	// Reading from the watch cache is sufficient, the deletions are conditional.
+	list, err := s.List(genericapirequest.WithNamespace(ctx, ""), &metainternalversion.ListOptions{ResourceVersion: "0"})
+	if err != nil {
+		return err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	errs := []error{}
+	for _, item := range items {
+		m, err := meta.Accessor(item)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !IsSoftDeleted(m) || m.GetDeletionTimestamp().After(now) {
+			continue
+		}
+		// The object may have been deleted or restored since it has been read, in which case the
+		// preconditions fail.
+		_, _, err = s.Delete(genericapirequest.WithNamespace(ctx, m.GetNamespace()), m.GetName(), rest.ValidateAllObjectFunc, &metav1.DeleteOptions{
+			GracePeriodSeconds: ptr.To[int64](0),
+			Preconditions:      &metav1.Preconditions{UID: ptr.To(m.GetUID()), ResourceVersion: ptr.To(m.GetResourceVersion())},
+		})
+		if err != nil && !apierrors.IsNotFound(err) && !apierrors.IsConflict(err) {
+			errs = append(errs, err)
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
@@ -0,0 +1,253 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/utils/ptr"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// memoryStorage is a minimal storage.Interface keeping testObjs in a map.
+type memoryStorage struct {
+	storage.Interface
+	objs map[string]*testObj
+	rv   uint64
+}
+
+func (m *memoryStorage) Get(ctx context.Context, key string, opts storage.GetOptions, out runtime.Object) error {
+	obj, ok := m.objs[key]
+	if !ok {
+		return storage.NewKeyNotFoundError(key, 0)
+	}
+	*out.(*testObj) = *obj
+
+	return nil
+}
+
+func (m *memoryStorage) GetList(ctx context.Context, key string, opts storage.ListOptions, list runtime.Object) error {
+	l := list.(*testObjList)
+	for k, obj := range m.objs {
+		if strings.HasPrefix(k, key) {
+			l.Items = append(l.Items, *obj)
+		}
+	}
+
+	return nil
+}
+
+func (m *memoryStorage) GuaranteedUpdate(
+	ctx context.Context, key string, destination runtime.Object, ignoreNotFound bool,
+	preconditions *storage.Preconditions, tryUpdate storage.UpdateFunc, cachedExistingObject runtime.Object) error {
+	obj, ok := m.objs[key]
+	if !ok {
+		return storage.NewKeyNotFoundError(key, 0)
+	}
+	existing := obj.DeepCopyObject()
+	if preconditions != nil {
+		if err := preconditions.Check(key, existing); err != nil {
+			return err
+		}
+	}
+	out, _, err := tryUpdate(existing, storage.ResponseMeta{})
+	if err != nil {
+		return err
+	}
+	m.rv++
+	updated := out.(*testObj)
+	updated.ResourceVersion = strconv.FormatUint(m.rv, 10)
+	m.objs[key] = updated
+	*destination.(*testObj) = *updated
+
+	return nil
+}
+
+func (m *memoryStorage) Delete(
+	ctx context.Context, key string, out runtime.Object, preconditions *storage.Preconditions,
+	validateDeletion storage.ValidateObjectFunc, cachedExistingObject runtime.Object, opts storage.DeleteOptions) error {
+	obj, ok := m.objs[key]
+	if !ok {
+		return storage.NewKeyNotFoundError(key, 0)
+	}
+	if preconditions != nil {
+		if err := preconditions.Check(key, obj); err != nil {
+			return err
+		}
+	}
+	delete(m.objs, key)
+	*out.(*testObj) = *obj
+
+	return nil
+}
+
+// gracefulObj is deleted with a grace period of 30s.
+type gracefulObj struct {
+	testObj
+}
+
+// CheckGracefulDelete implements resource.GracefulDeleter
+func (g *gracefulObj) CheckGracefulDelete(ctx context.Context, options *metav1.DeleteOptions) bool {
+	if options.GracePeriodSeconds == nil {
+		options.GracePeriodSeconds = ptr.To[int64](30)
+	}
+
+	return *options.GracePeriodSeconds > 0
+}
+
+// fakeResponder records the response of a connect handler.
+type fakeResponder struct {
+	obj runtime.Object
+	err error
+}
+
+func (r *fakeResponder) Object(statusCode int, obj runtime.Object) { r.obj = obj }
+func (r *fakeResponder) Error(err error)                           { r.err = err }
+
+var _ = Describe("soft delete", func() {
+	var (
+		ctx      = genericapirequest.WithNamespace(context.Background(), "ns")
+		mem      *memoryStorage
+		strategy *DefaultStrategy
+		store    *genericregistry.Store
+	)
+
+	BeforeEach(func() {
+		gv := schema.GroupVersion{Group: "arc", Version: "v1"}
+		scheme := runtime.NewScheme()
+		scheme.AddKnownTypes(gv, &testObj{}, &testObjList{})
+		gr := schema.GroupResource{Group: gv.Group, Resource: "testobjs"}
+		strategy = NewDefaultStrategy(&testObj{}, scheme, gr)
+		strategy.SoftDeleteRetention = time.Hour
+		mem = &memoryStorage{objs: map[string]*testObj{
+			"/testobjs/ns/test": {ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns", UID: "uid", ResourceVersion: "1"}},
+		}, rv: 1}
+		store = &genericregistry.Store{
+			NewFunc:                  func() runtime.Object { return &testObj{} },
+			NewListFunc:              func() runtime.Object { return &testObjList{} },
+			DefaultQualifiedResource: gr,
+			KeyRootFunc:              func(ctx context.Context) string { return "/testobjs" },
+			KeyFunc: func(ctx context.Context, name string) (string, error) {
+				return genericregistry.NamespaceKeyFunc(ctx, "/testobjs", name)
+			},
+			PredicateFunc:  strategy.Match,
+			CreateStrategy: strategy,
+			UpdateStrategy: strategy,
+			DeleteStrategy: strategy,
+			Storage:        genericregistry.DryRunnableStorage{Storage: mem},
+		}
+	})
+
+	deleteObj := func(options *metav1.DeleteOptions) (runtime.Object, bool, error) {
+		return store.Delete(ctx, "test", rest.ValidateAllObjectFunc, options)
+	}
+
+	undelete := func() (runtime.Object, error) {
+		responder := &fakeResponder{}
+		handler, err := NewUndeleteStore(store).(rest.Connecter).Connect(ctx, "test", nil, responder)
+		Expect(err).ToNot(HaveOccurred())
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", nil))
+
+		return responder.obj, responder.err
+	}
+
+	It("should keep deleted objects for the retention", func() {
+		_, deleted, err := deleteObj(&metav1.DeleteOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(BeFalse())
+
+		obj := mem.objs["/testobjs/ns/test"]
+		Expect(IsSoftDeleted(obj)).To(BeTrue())
+		Expect(obj.DeletionTimestamp.Time).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+	})
+
+	It("should shorten the retention to the grace period of the request", func() {
+		options := &metav1.DeleteOptions{GracePeriodSeconds: ptr.To[int64](60)}
+		Expect(strategy.CheckGracefulDelete(ctx, &testObj{}, options)).To(BeTrue())
+		Expect(*options.GracePeriodSeconds).To(Equal(int64(60)))
+
+		options = &metav1.DeleteOptions{GracePeriodSeconds: ptr.To[int64](7200)}
+		Expect(strategy.CheckGracefulDelete(ctx, &testObj{}, options)).To(BeTrue())
+		Expect(*options.GracePeriodSeconds).To(Equal(int64(3600)))
+	})
+
+	It("should delete objects immediately with a grace period of 0", func() {
+		_, deleted, err := deleteObj(&metav1.DeleteOptions{GracePeriodSeconds: ptr.To[int64](0)})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(BeTrue())
+		Expect(mem.objs).To(BeEmpty())
+	})
+
+	It("should delete objects immediately without retention", func() {
+		strategy.SoftDeleteRetention = 0
+		_, deleted, err := deleteObj(&metav1.DeleteOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(BeTrue())
+	})
+
+	It("should delegate to objects deleted gracefully", func() {
+		options := &metav1.DeleteOptions{}
+		Expect(strategy.CheckGracefulDelete(ctx, &gracefulObj{}, options)).To(BeTrue())
+		Expect(*options.GracePeriodSeconds).To(Equal(int64(30)))
+
+		options = &metav1.DeleteOptions{GracePeriodSeconds: ptr.To[int64](0)}
+		Expect(strategy.CheckGracefulDelete(ctx, &gracefulObj{}, options)).To(BeFalse())
+	})
+
+	It("should restore soft-deleted objects", func() {
+		_, _, err := deleteObj(&metav1.DeleteOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		obj, err := undelete()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(obj.(*testObj).DeletionTimestamp).To(BeNil())
+		Expect(mem.objs["/testobjs/ns/test"].DeletionTimestamp).To(BeNil())
+		Expect(mem.objs["/testobjs/ns/test"].DeletionGracePeriodSeconds).To(BeNil())
+	})
+
+	It("should document the restored object as response", func() {
+		Expect(NewUndeleteStore(store).(rest.StorageMetadata).ProducesObject(http.MethodPost)).To(BeAssignableToTypeOf(&testObj{}))
+	})
+
+	It("should not restore objects which are not soft-deleted", func() {
+		_, err := undelete()
+		Expect(apierrors.IsConflict(err)).To(BeTrue())
+
+		delete(mem.objs, "/testobjs/ns/test")
+		_, err = undelete()
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should reap objects once their retention expired", func() {
+		_, _, err := deleteObj(&metav1.DeleteOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(Reap(ctx, store)).To(Succeed())
+		Expect(mem.objs).To(HaveLen(1))
+
+		mem.objs["/testobjs/ns/test"].DeletionTimestamp = &metav1.Time{Time: time.Now().Add(-time.Second)}
+		Expect(Reap(ctx, store)).To(Succeed())
+		Expect(mem.objs).To(BeEmpty())
+	})
+
+	It("should not reap objects which are not soft-deleted", func() {
+		Expect(Reap(ctx, store)).To(Succeed())
+		Expect(mem.objs).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,91 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"go.opendefense.cloud/kit/apiserver/diff"
+	"go.opendefense.cloud/kit/apiserver/resource"
+)
+
+// NewStrictStatusStore wraps the store of a status subresource to reject updates which change any
+// field except metadata and status, instead of silently resetting them. This surfaces controller
+// bugs, which would otherwise be masked.
+func NewStrictStatusStore(store *genericregistry.Store) rest.Storage {
+	return &strictStatusStore{Store: store}
+}
+
+// strictStatusStore rejects spec mutations through the status subresource.
+type strictStatusStore struct {
+	*genericregistry.Store
+}
+
+// Update checks the updated object before passing it to the underlying store.
+func (s *strictStatusStore) Update(
+	ctx context.Context, name string, objInfo rest.UpdatedObjectInfo,
+	createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc,
+	forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	objInfo = &strictStatusObjectInfo{UpdatedObjectInfo: objInfo, store: s.Store, name: name}
+
+	return s.Store.Update(ctx, name, objInfo, createValidation, updateValidation, forceAllowCreate, options)
+}
+
+// strictStatusObjectInfo returns an Invalid error if the updated object changes more than its status.
+type strictStatusObjectInfo struct {
+	rest.UpdatedObjectInfo
+	store *genericregistry.Store
+	name  string
+}
+
+// UpdatedObject returns the updated object if only metadata and status have been changed.
+func (i *strictStatusObjectInfo) UpdatedObject(ctx context.Context, oldObj runtime.Object) (runtime.Object, error) {
+	obj, err := i.UpdatedObjectInfo.UpdatedObject(ctx, oldObj)
+	if err != nil || oldObj == nil {
+		return obj, err
+	}
+	if errs := ValidateStatusUpdate(obj, oldObj); len(errs) > 0 {
+		qualifiedKind := schema.GroupKind{Group: i.store.DefaultQualifiedResource.Group}
+		if kinds, _, err := i.store.UpdateStrategy.ObjectKinds(obj); err == nil && len(kinds) > 0 {
+			qualifiedKind.Kind = kinds[0].Kind
+		}
+
+		return nil, apierrors.NewInvalid(qualifiedKind, i.name, errs)
+	}
+
+	return obj, nil
+}
+
+// ValidateStatusUpdate returns a Forbidden error for every field changed between old and obj,
+// except for metadata and status.
+func ValidateStatusUpdate(obj, old runtime.Object) field.ErrorList {
+	d, err := specDiff(obj, old)
+	if err != nil {
+		return field.ErrorList{field.InternalError(nil, err)}
+	}
+
+	return d.Forbidden("may not be changed through the status subresource")
+}
+
+// PrepareStatusForUpdate sets the observed generation of objects implementing
+// resource.ObjectWithObservedGeneration to their generation. It is called on updates
+// of the status subresource.
+func PrepareStatusForUpdate(obj runtime.Object) {
+	if v, ok := obj.(resource.ObjectWithObservedGeneration); ok {
+		v.SetObservedGeneration(v.GetObjectMeta().Generation)
+	}
+}
+
+// specDiff returns the changes between old and obj outside of metadata and status.
+func specDiff(obj, old runtime.Object) (diff.Diff, error) {
+	return diff.Objects(old, obj, diff.WithIgnoredFields("apiVersion", "kind", "metadata", "status"))
+}
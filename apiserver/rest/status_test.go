@@ -0,0 +1,179 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type statusTestSpec struct {
+	Message string `json:"message"`
+}
+
+type statusTestStatus struct {
+	Phase              string `json:"phase"`
+	ObservedGeneration int64  `json:"observedGeneration"`
+}
+
+// statusTestObj has a spec and status like most resources and reports its observed generation.
+type statusTestObj struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   statusTestSpec   `json:"spec"`
+	Status statusTestStatus `json:"status"`
+}
+
+func (o *statusTestObj) DeepCopyObject() runtime.Object {
+	clone := *o
+	o.ObjectMeta.DeepCopyInto(&clone.ObjectMeta)
+
+	return &clone
+}
+
+func (o *statusTestObj) GetObjectMeta() *metav1.ObjectMeta { return &o.ObjectMeta }
+func (o *statusTestObj) NamespaceScoped() bool             { return true }
+func (o *statusTestObj) New() runtime.Object               { return &statusTestObj{} }
+func (o *statusTestObj) NewList() runtime.Object           { return nil }
+
+func (o *statusTestObj) GetGroupResource() schema.GroupResource {
+	return schema.GroupResource{Group: "test.opendefense.cloud", Resource: "statustestobjs"}
+}
+
+// SetObservedGeneration implements resource.ObjectWithObservedGeneration
+func (o *statusTestObj) SetObservedGeneration(generation int64) {
+	o.Status.ObservedGeneration = generation
+}
+
+// mutatingStatusTestObj defaults its message in Mutate.
+type mutatingStatusTestObj struct {
+	statusTestObj
+}
+
+func (o *mutatingStatusTestObj) DeepCopyObject() runtime.Object {
+	clone := *o
+	o.ObjectMeta.DeepCopyInto(&clone.ObjectMeta)
+
+	return &clone
+}
+
+// Mutate implements Mutator
+func (o *mutatingStatusTestObj) Mutate(context.Context) error {
+	o.Spec.Message = "defaulted"
+	return nil
+}
+
+var _ = Describe("ValidateStatusUpdate", func() {
+	old := &statusTestObj{Spec: statusTestSpec{Message: "hello"}}
+
+	It("should allow status and metadata changes", func() {
+		obj := old.DeepCopyObject().(*statusTestObj)
+		obj.Status.Phase = "Ready"
+		obj.Labels = map[string]string{"foo": "bar"}
+		Expect(ValidateStatusUpdate(obj, old)).To(BeEmpty())
+	})
+
+	It("should forbid spec changes", func() {
+		obj := old.DeepCopyObject().(*statusTestObj)
+		obj.Spec.Message = "changed"
+		errs := ValidateStatusUpdate(obj, old)
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Field).To(Equal("spec.message"))
+	})
+})
+
+var _ = Describe("strict status store", func() {
+	var (
+		old   *statusTestObj
+		store *genericregistry.Store
+	)
+
+	BeforeEach(func() {
+		gv := schema.GroupVersion{Group: "test.opendefense.cloud", Version: "v1"}
+		scheme := runtime.NewScheme()
+		scheme.AddKnownTypes(gv, &statusTestObj{})
+		gr := schema.GroupResource{Group: gv.Group, Resource: "statustestobjs"}
+		store = &genericregistry.Store{
+			DefaultQualifiedResource: gr,
+			UpdateStrategy:           NewDefaultStrategy(&statusTestObj{}, scheme, gr),
+		}
+		old = &statusTestObj{ObjectMeta: metav1.ObjectMeta{Name: "test"}, Spec: statusTestSpec{Message: "hello"}}
+	})
+
+	updated := func(obj runtime.Object) (runtime.Object, error) {
+		info := &strictStatusObjectInfo{UpdatedObjectInfo: rest.DefaultUpdatedObjectInfo(obj), store: store, name: "test"}
+		return info.UpdatedObject(context.Background(), old)
+	}
+
+	It("should pass status updates", func() {
+		obj := old.DeepCopyObject().(*statusTestObj)
+		obj.Status.Phase = "Ready"
+		Expect(updated(obj)).To(Equal(obj))
+	})
+
+	It("should reject spec updates as invalid", func() {
+		obj := old.DeepCopyObject().(*statusTestObj)
+		obj.Spec.Message = "changed"
+		_, err := updated(obj)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		Expect(err).To(MatchError(ContainSubstring("spec.message")))
+		Expect(err).To(MatchError(ContainSubstring("statusTestObj.test.opendefense.cloud")))
+	})
+})
+
+var _ = Describe("observed generation", func() {
+	var (
+		strategy *DefaultStrategy
+		old      *statusTestObj
+	)
+
+	BeforeEach(func() {
+		strategy = NewDefaultStrategy(&statusTestObj{}, runtime.NewScheme(), schema.GroupResource{})
+		old = &statusTestObj{ObjectMeta: metav1.ObjectMeta{Generation: 3}, Spec: statusTestSpec{Message: "hello"}}
+	})
+
+	It("should start with generation 1", func() {
+		obj := &statusTestObj{}
+		strategy.PrepareForCreate(context.Background(), obj)
+		Expect(obj.Generation).To(Equal(int64(1)))
+	})
+
+	It("should increment the generation on spec changes", func() {
+		obj := old.DeepCopyObject().(*statusTestObj)
+		obj.Spec.Message = "changed"
+		strategy.PrepareForUpdate(context.Background(), obj, old)
+		Expect(obj.Generation).To(Equal(int64(4)))
+	})
+
+	It("should increment the generation on spec changes of the hooks", func() {
+		old := &mutatingStatusTestObj{statusTestObj: *old}
+		obj := old.DeepCopyObject().(*mutatingStatusTestObj)
+		strategy.PrepareForUpdate(context.Background(), obj, old)
+		Expect(obj.Spec.Message).To(Equal("defaulted"))
+		Expect(obj.Generation).To(Equal(int64(4)))
+	})
+
+	It("should keep the generation on metadata changes", func() {
+		obj := old.DeepCopyObject().(*statusTestObj)
+		obj.Labels = map[string]string{"foo": "bar"}
+		strategy.PrepareForUpdate(context.Background(), obj, old)
+		Expect(obj.Generation).To(Equal(int64(3)))
+	})
+
+	It("should set the observed generation on status updates", func() {
+		obj := old.DeepCopyObject().(*statusTestObj)
+		PrepareStatusForUpdate(obj)
+		Expect(obj.Status.ObservedGeneration).To(Equal(int64(3)))
+	})
+})
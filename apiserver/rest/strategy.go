@@ -5,6 +5,8 @@ package rest
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -43,6 +45,15 @@ type DefaultStrategy struct {
 	runtime.ObjectTyper
 	// TableConvertor is used for table output if the object does not implement TableConverter.
 	TableConvertor rest.TableConvertor
+	// StatusSubResource enables the status subresource for objects not implementing
+	// resource.ObjectWithStatusSubResource, copying their field named Status.
+	StatusSubResource bool
+	// SoftDeleteRetention keeps deleted objects for the given duration, during which they can be
+	// restored through the undelete subresource, see CheckGracefulDelete and NewUndeleteStore.
+	SoftDeleteRetention time.Duration
+	// ValidationRatcheting drops the errors of ValidateUpdate for fields which have not been
+	// changed by the update, see RatchetErrors.
+	ValidationRatcheting bool
 }
 
 // NewDefaultStrategy constructs a DefaultStrategy for a given resource type.
@@ -58,10 +69,14 @@ func NewDefaultStrategy(obj runtime.Object, objTyper runtime.ObjectTyper, gr sch
 }
 
 // GenerateName returns a generated name for a resource, using the object's NameGenerator if present.
+// Singleton resources always get their fixed name.
 func (d DefaultStrategy) GenerateName(base string) string {
 	if d.Object == nil {
 		return names.SimpleNameGenerator.GenerateName(base)
 	}
+	if s, ok := d.Object.(Singleton); ok {
+		return s.SingletonName()
+	}
 	if n, ok := d.Object.(NameGenerator); ok {
 		return n.GenerateName(base)
 	}
@@ -81,33 +96,71 @@ func (d DefaultStrategy) NamespaceScoped() bool {
 	return true
 }
 
-// PrepareForCreate normalizes the object before creation, delegating to PrepareForCreater if implemented.
+// PrepareForCreate normalizes the object before creation, delegating to PrepareForCreaterWithError
+// or PrepareForCreater if implemented. Objects reporting their observed generation start with generation 1.
+// Objects in a legacy form are migrated first, see Migrator, and Mutator is called last. Errors of the
+// hooks reject the request.
 func (DefaultStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
-	if v, ok := obj.(PrepareForCreater); ok {
-		v.PrepareForCreate(ctx)
+	migrateWrite(obj)
+	if v, ok := obj.(resource.ObjectWithObservedGeneration); ok {
+		v.GetObjectMeta().Generation = 1
 	}
+	prepareForCreate(ctx, obj)
 }
 
 // PrepareForUpdate normalizes the object before update.
-// If the object has a status subresource, status is copied from old to new.
-// If PrepareForUpdater is implemented, it is called to further normalize.
-func (DefaultStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
-	if v, ok := obj.(resource.ObjectWithStatusSubResource); ok {
-		// Copy status from old to new to avoid spec-only updates modifying status.
-		old.(resource.ObjectWithStatusSubResource).CopyStatusTo(v)
+// If the object has a status subresource, i.e. it implements resource.ObjectWithStatusSubResource or
+// StatusSubResource is set, status is copied from old to new (see resource.CopyStatus).
+// Objects in a legacy form are migrated, see Migrator. If PrepareForUpdaterWithError or PrepareForUpdater
+// is implemented, it is called to further normalize, followed by Mutator. Errors of the hooks reject the
+// request.
+// Objects reporting their observed generation get their generation incremented if the object changed
+// outside of metadata and status, including changes made by the hooks.
+func (d DefaultStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	// Copy status from old to new to avoid spec-only updates modifying status.
+	if d.StatusSubResource || resource.HasStatus(obj) {
+		resource.CopyStatus(old, obj)
 	}
-	if v, ok := obj.(PrepareForUpdater); ok {
-		v.PrepareForUpdate(ctx, old)
+	migrateWrite(obj)
+	prepareForUpdate(ctx, obj, old)
+	if v, ok := obj.(resource.ObjectWithObservedGeneration); ok {
+		if d, err := specDiff(obj, old); err != nil || !d.Empty() {
+			v.GetObjectMeta().Generation = old.(resource.ObjectWithObservedGeneration).GetObjectMeta().Generation + 1
+		}
 	}
 }
 
 // Validate delegates to the object's Validater interface if present, otherwise returns no errors.
+// Singleton resources are additionally validated to use their fixed name.
+// Rejections are counted in the kit_validation_rejections_total metric.
+// Validation is skipped if a prepare hook failed, as the request is rejected anyway.
 func (DefaultStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	if err := prepareErrorFrom(ctx); err != nil {
+		return field.ErrorList{field.InternalError(nil, err)}
+	}
+	errs := field.ErrorList{}
+	if s, ok := obj.(Singleton); ok {
+		errs = append(errs, validateSingletonName(obj, s.SingletonName())...)
+	}
 	if v, ok := obj.(Validater); ok {
-		return v.Validate(ctx)
+		errs = append(errs, v.Validate(ctx)...)
 	}
+	recordValidationRejections(ctx, errs)
 
-	return field.ErrorList{}
+	return errs
+}
+
+// validateSingletonName returns an error if obj is not named name.
+func validateSingletonName(obj runtime.Object, name string) field.ErrorList {
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return field.ErrorList{field.InternalError(field.NewPath("metadata"), err)}
+	}
+	if m.GetName() != name {
+		return field.ErrorList{field.Invalid(field.NewPath("metadata", "name"), m.GetName(), fmt.Sprintf("must be %q, only a single instance is allowed", name))}
+	}
+
+	return nil
 }
 
 // AllowCreateOnUpdate returns true if the object allows creation via update (PUT), using AllowCreateOnUpdater if present.
@@ -142,9 +195,21 @@ func (DefaultStrategy) Canonicalize(obj runtime.Object) {
 }
 
 // ValidateUpdate delegates to the object's ValidateUpdater interface if present, otherwise returns no errors.
-func (DefaultStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+// Errors of unchanged fields are dropped if ValidationRatcheting is set.
+// Rejections are counted in the kit_validation_rejections_total metric.
+// Validation is skipped if a prepare hook failed, as the request is rejected anyway.
+func (d DefaultStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	if err := prepareErrorFrom(ctx); err != nil {
+		return field.ErrorList{field.InternalError(nil, err)}
+	}
 	if v, ok := obj.(ValidateUpdater); ok {
-		return v.ValidateUpdate(ctx, old)
+		errs := v.ValidateUpdate(ctx, old)
+		if d.ValidationRatcheting {
+			errs = RatchetErrors(errs, obj, old)
+		}
+		recordValidationRejections(ctx, errs)
+
+		return errs
 	}
 
 	return field.ErrorList{}
@@ -159,7 +224,8 @@ func (DefaultStrategy) Match(label labels.Selector, field fields.Selector) stora
 	}
 }
 
-// ConvertToTable returns a Table representation of the object, using TableConverter if implemented.
+// ConvertToTable returns a Table representation of the object, using TableConverter or
+// TableRowConverter if implemented.
 func (d DefaultStrategy) ConvertToTable(
 	ctx context.Context, obj runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
 
@@ -175,6 +241,10 @@ func (d DefaultStrategy) ConvertToTable(
 		return table, nil
 	}
 
+	if table, ok, err := convertToTableRows(ctx, obj, tableOptions); ok {
+		return table, err
+	}
+
 	if meta.IsListType(obj) && meta.LenList(obj) > 0 { // If it's a list type, let's check if individual objects implement TableConvertor
 		items, err := meta.ExtractList(obj)
 		if err == nil {
@@ -202,14 +272,15 @@ func (d DefaultStrategy) ConvertToTable(
 	return d.TableConvertor.ConvertToTable(ctx, obj, tableOptions)
 }
 
-// WarningsOnCreate returns any warnings for create operations (default: none).
+// WarningsOnCreate returns warnings for the deprecated fields set in obj, see FieldDeprecator.
 func (d DefaultStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
-	return nil
+	return deprecationWarnings(ctx, obj, nil)
 }
 
-// WarningsOnUpdate returns any warnings for update operations (default: none).
+// WarningsOnUpdate returns warnings for the deprecated fields set in obj but not in old, see
+// FieldDeprecator.
 func (d DefaultStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
-	return nil
+	return deprecationWarnings(ctx, obj, old)
 }
 
 // PrepareForUpdaterStrategy is a wrapper for RESTUpdateStrategy that allows custom update normalization via OverrideFn.
@@ -238,6 +309,18 @@ func (d DefaultStrategy) ShortNames() []string {
 	return nil
 }
 
+// Categories returns the categories of the resource if the object implements CategoriesProvider.
+func (d DefaultStrategy) Categories() []string {
+	if d.Object == nil {
+		return nil
+	}
+	if c, ok := d.Object.(CategoriesProvider); ok {
+		return c.Categories()
+	}
+
+	return nil
+}
+
 // GetSingularName returns the singular name of the resource if the object implements SingularNameProvider.
 func (d DefaultStrategy) GetSingularName() string {
 	if d.Object == nil {
@@ -18,10 +18,10 @@ import (
 // testObj is a small helper type used to implement several of the
 // optional interfaces that DefaultStrategy looks for.
 type testObj struct {
-	metav1.TypeMeta
-	metav1.ObjectMeta
-	Status string
-	Flag   bool
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Status            string
+	Flag              bool
 }
 
 func (t *testObj) DeepCopyObject() runtime.Object {
@@ -49,6 +49,19 @@ func (t *testObj) CopyStatusTo(obj runtime.Object) {
 	}
 }
 
+// plainStatusObj has a status field, but does not implement resource.ObjectWithStatusSubResource.
+type plainStatusObj struct {
+	metav1.TypeMeta
+	Spec   string
+	Status string
+}
+
+func (p *plainStatusObj) DeepCopyObject() runtime.Object {
+	clone := *p
+
+	return &clone
+}
+
 // PrepareForCreate implements PrepareForCreater
 func (t *testObj) PrepareForCreate(ctx context.Context) { t.Flag = true }
 
@@ -175,6 +188,17 @@ var _ = Describe("DefaultStrategy", func() {
 		Expect(obj.Flag).To(BeTrue())
 	})
 
+	It("should only copy the status field if the status subresource is enabled", func() {
+		old := &plainStatusObj{Spec: "old-spec", Status: "old-status"}
+		obj := &plainStatusObj{Spec: "new-spec", Status: "new-status"}
+		DefaultStrategy{}.PrepareForUpdate(context.Background(), obj, old)
+		Expect(obj.Status).To(Equal("new-status"))
+
+		DefaultStrategy{StatusSubResource: true}.PrepareForUpdate(context.Background(), obj, old)
+		Expect(obj.Status).To(Equal("old-status"))
+		Expect(obj.Spec).To(Equal("new-spec"))
+	})
+
 	It("should delegate Validate and ValidateUpdate to object", func() {
 		obj := &testObj{}
 		ds := DefaultStrategy{}
@@ -262,8 +286,20 @@ var _ = Describe("DefaultStrategy", func() {
 		// Verify row data shows count and resource type
 		Expect(tbl.Rows[0].Cells).To(Equal([]any{3, "testobjs"}))
 	})
+
+	It("should delegate Categories to object", func() {
+		Expect(DefaultStrategy{Object: &categorized{}}.Categories()).To(Equal([]string{"all"}))
+		Expect(DefaultStrategy{Object: &testObj{}}.Categories()).To(BeNil())
+	})
 })
 
+// categorized implements CategoriesProvider
+type categorized struct {
+	testObj
+}
+
+func (c *categorized) Categories() []string { return []string{"all"} }
+
 var _ = Describe("PrepareForUpdaterStrategy", func() {
 	It("should call OverrideFn on PrepareForUpdate", func() {
 		called := false
@@ -297,3 +333,36 @@ var _ = Describe("PrepareForUpdaterStrategy", func() {
 		Expect(func() { s.PrepareForUpdate(context.Background(), obj, old) }).ToNot(Panic())
 	})
 })
+
+// singleton implements Singleton
+type singleton struct {
+	testObj
+}
+
+func (s *singleton) SingletonName() string { return "cluster" }
+
+var _ = Describe("DefaultStrategy with Singleton", func() {
+	It("should always generate the singleton name", func() {
+		ds := DefaultStrategy{Object: &singleton{}}
+		Expect(ds.GenerateName("base-")).To(Equal("cluster"))
+	})
+
+	It("should accept the singleton name", func() {
+		obj := &singleton{}
+		obj.Name = "cluster"
+		ds := DefaultStrategy{}
+		// testObj itself always reports an invalid spec, so only the name is checked here.
+		Expect(ds.Validate(context.Background(), obj)).ToNot(ContainElement(HaveField("Field", "metadata.name")))
+	})
+
+	It("should reject other names", func() {
+		obj := &singleton{}
+		obj.Name = "other"
+		ds := DefaultStrategy{}
+		errs := ds.Validate(context.Background(), obj)
+		Expect(errs).To(ContainElement(SatisfyAll(
+			HaveField("Field", "metadata.name"),
+			HaveField("Detail", ContainSubstring(`must be "cluster"`)),
+		)))
+	})
+})
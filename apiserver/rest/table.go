@@ -0,0 +1,83 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"errors"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// convertToTableRows converts obj, an object or a list of objects implementing
+// TableRowConverter, to a table. The rows of a list are allocated at once and share the backing
+// array of their cells, so large lists, e.g. for kubectl get, take a constant number of
+// allocations besides the cells themselves.
+func convertToTableRows(ctx context.Context, obj runtime.Object, tableOptions runtime.Object) (*metav1.Table, bool, error) {
+	if c, ok := obj.(TableRowConverter); ok {
+		cells, err := c.AppendTableCells(ctx, tableOptions, nil)
+		if err != nil {
+			return nil, true, err
+		}
+
+		table := &metav1.Table{
+			ColumnDefinitions: c.TableColumns(),
+			Rows:              []metav1.TableRow{{Cells: cells, Object: runtime.RawExtension{Object: obj}}},
+		}
+		if m, err := meta.Accessor(obj); err == nil {
+			table.ResourceVersion = m.GetResourceVersion()
+		}
+
+		return table, true, nil
+	}
+	if !meta.IsListType(obj) {
+		return nil, false, nil
+	}
+	n := meta.LenList(obj)
+	if n == 0 {
+		return nil, false, nil
+	}
+	table := &metav1.Table{Rows: make([]metav1.TableRow, 0, n)}
+	var cells []any
+	err := meta.EachListItem(obj, func(item runtime.Object) error {
+		c, ok := item.(TableRowConverter)
+		if !ok {
+			return errNotTableRowConverter
+		}
+		if table.ColumnDefinitions == nil {
+			table.ColumnDefinitions = c.TableColumns()
+			cells = make([]any, 0, n*len(table.ColumnDefinitions))
+		}
+		start := len(cells)
+		var err error
+		if cells, err = c.AppendTableCells(ctx, tableOptions, cells); err != nil {
+			return err
+		}
+		// Limit the capacity so the cells of the next row don't overwrite the cells of this one.
+		table.Rows = append(table.Rows, metav1.TableRow{
+			Cells:  cells[start:len(cells):len(cells)],
+			Object: runtime.RawExtension{Object: item},
+		})
+
+		return nil
+	})
+	if errors.Is(err, errNotTableRowConverter) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, true, err
+	}
+	if m, err := meta.ListAccessor(obj); err == nil {
+		table.ResourceVersion = m.GetResourceVersion()
+		table.Continue = m.GetContinue()
+		table.RemainingItemCount = m.GetRemainingItemCount()
+	}
+
+	return table, true, nil
+}
+
+// errNotTableRowConverter stops the conversion of lists whose items don't implement TableRowConverter.
+var errNotTableRowConverter = errors.New("list item does not implement TableRowConverter")
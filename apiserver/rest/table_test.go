@@ -0,0 +1,139 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// rowObj implements TableRowConverter.
+type rowObj struct {
+	statusTestObj
+	err error
+}
+
+func (o *rowObj) DeepCopyObject() runtime.Object {
+	clone := *o
+	o.ObjectMeta.DeepCopyInto(&clone.ObjectMeta)
+
+	return &clone
+}
+
+func (o *rowObj) TableColumns() []metav1.TableColumnDefinition {
+	return []metav1.TableColumnDefinition{{Name: "Name", Type: "string"}, {Name: "Phase", Type: "string"}}
+}
+
+func (o *rowObj) AppendTableCells(_ context.Context, _ runtime.Object, cells []any) ([]any, error) {
+	return append(cells, o.Name, o.Status.Phase), o.err
+}
+
+type rowObjList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+	Items []rowObj
+}
+
+func (l *rowObjList) DeepCopyObject() runtime.Object {
+	clone := *l
+
+	return &clone
+}
+
+// newRowObjList returns a list of n rowObjs.
+func newRowObjList(n int) *rowObjList {
+	list := &rowObjList{ListMeta: metav1.ListMeta{ResourceVersion: "7", Continue: "next"}, Items: make([]rowObj, n)}
+	for i := range list.Items {
+		list.Items[i].Name = fmt.Sprintf("obj%d", i)
+		list.Items[i].Status.Phase = "Ready"
+	}
+
+	return list
+}
+
+// newTestObjList returns a list of n testObjs, which implement TableConverter.
+func newTestObjList(n int) *testObjList {
+	list := &testObjList{Items: make([]testObj, n)}
+	for i := range list.Items {
+		list.Items[i].Name = fmt.Sprintf("obj%d", i)
+		list.Items[i].Status = "ready"
+	}
+
+	return list
+}
+
+var _ = Describe("TableRowConverter", func() {
+	var strategy *DefaultStrategy
+
+	BeforeEach(func() {
+		strategy = NewDefaultStrategy(&rowObj{}, nil, schema.GroupResource{Group: "arc", Resource: "rowobjs"})
+	})
+
+	It("should convert objects to tables", func() {
+		obj := &rowObj{}
+		obj.Name = "a"
+		obj.ResourceVersion = "3"
+		obj.Status.Phase = "Ready"
+		table, err := strategy.ConvertToTable(context.Background(), obj, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(table.ColumnDefinitions).To(HaveLen(2))
+		Expect(table.ResourceVersion).To(Equal("3"))
+		Expect(table.Rows).To(HaveLen(1))
+		Expect(table.Rows[0].Cells).To(Equal([]any{"a", "Ready"}))
+		Expect(table.Rows[0].Object.Object).To(BeIdenticalTo(obj))
+	})
+
+	It("should convert lists to tables with a row per item", func() {
+		table, err := strategy.ConvertToTable(context.Background(), newRowObjList(3), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(table.ColumnDefinitions).To(HaveLen(2))
+		Expect(table.ResourceVersion).To(Equal("7"))
+		Expect(table.Continue).To(Equal("next"))
+		Expect(table.Rows).To(HaveLen(3))
+		for i, row := range table.Rows {
+			Expect(row.Cells).To(Equal([]any{fmt.Sprintf("obj%d", i), "Ready"}))
+			Expect(row.Object.Object.(*rowObj).Name).To(Equal(fmt.Sprintf("obj%d", i)))
+		}
+	})
+
+	It("should fail if an item fails", func() {
+		list := newRowObjList(2)
+		list.Items[1].err = errors.New("broken")
+		_, err := strategy.ConvertToTable(context.Background(), list, nil)
+		Expect(err).To(MatchError("broken"))
+	})
+})
+
+func BenchmarkConvertToTable(b *testing.B) {
+	strategy := NewDefaultStrategy(&rowObj{}, nil, schema.GroupResource{Group: "arc", Resource: "rowobjs"})
+	for _, n := range []int{100, 5000} {
+		b.Run(fmt.Sprintf("TableConverter/%d", n), func(b *testing.B) {
+			list := newTestObjList(n)
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := strategy.ConvertToTable(context.Background(), list, nil); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("TableRowConverter/%d", n), func(b *testing.B) {
+			list := newRowObjList(n)
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := strategy.ConvertToTable(context.Background(), list, nil); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,340 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/watch"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+)
+
+//go:generate go run verbs_generate.go
+
+// Verbs which can be enabled on a store with WithVerbs.
+const (
+	VerbGet              = "get"
+	VerbList             = "list"
+	VerbWatch            = "watch"
+	VerbCreate           = "create"
+	VerbUpdate           = "update"
+	VerbPatch            = "patch"
+	VerbDelete           = "delete"
+	VerbDeleteCollection = "deletecollection"
+)
+
+// AllVerbs contains all verbs served by a store.
+var AllVerbs = []string{VerbGet, VerbList, VerbWatch, VerbCreate, VerbUpdate, VerbPatch, VerbDelete, VerbDeleteCollection}
+
+// StoreOption configures optional behaviour of a store created by NewStore.
+type StoreOption func(*storeConfig)
+
+// storeConfig holds the optional configuration applied by StoreOptions.
+type storeConfig struct {
+	verbs              []string
+	externalValidators []*ExternalValidator
+	maxPageSize        int64
+	inflightLimit      InflightLimit
+	categories         []string
+}
+
+// WithVerbs restricts the store to the given verbs. Requests using any other verb
+// are rejected with a MethodNotAllowed status. All verbs are served if no verbs are given.
+func WithVerbs(verbs ...string) StoreOption {
+	return func(c *storeConfig) {
+		c.verbs = verbs
+	}
+}
+
+// WithExternalValidators runs the given validators on create and update after the validation
+// of the strategy succeeded, see ExternalValidator.
+func WithExternalValidators(validators ...*ExternalValidator) StoreOption {
+	return func(c *storeConfig) {
+		c.externalValidators = append(c.externalValidators, validators...)
+	}
+}
+
+// WithCategories adds categories to those of the strategy, see CategoriesProvider, e.g. the
+// stability level of the resource.
+func WithCategories(categories ...string) StoreOption {
+	return func(c *storeConfig) {
+		c.categories = append(c.categories, categories...)
+	}
+}
+
+// verbSet returns the enabled verbs or nil if all verbs are enabled.
+func (c *storeConfig) verbSet() (sets.Set[string], error) {
+	if len(c.verbs) == 0 {
+		return nil, nil
+	}
+	all := sets.New(AllVerbs...)
+	for _, v := range c.verbs {
+		if !all.Has(v) {
+			return nil, fmt.Errorf("%w %q, expected one of %v", ErrUnknownVerb, v, AllVerbs)
+		}
+	}
+
+	return sets.New(c.verbs...), nil
+}
+
+// checkVerb returns a MethodNotAllowed error if the verb is not enabled.
+func (s *wrappedStore) checkVerb(verb string) error {
+	if s.verbs == nil || s.verbs.Has(verb) {
+		return nil
+	}
+
+	return apierrors.NewMethodNotSupported(s.DefaultQualifiedResource, verb)
+}
+
+// Get retrieves the item from storage if the get verb is enabled.
+func (s *wrappedStore) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	if err := s.checkVerb(VerbGet); err != nil {
+		return nil, err
+	}
+
+	return s.Store.Get(ctx, name, options)
+}
+
+// List returns a list of items matching labels and field if the list verb is enabled. The
+// limit of the list is reduced to the maximum page size of the store, and expensive lists are
+// rejected if the inflight limit of the store has been reached.
+func (s *wrappedStore) List(ctx context.Context, options *metainternalversion.ListOptions) (runtime.Object, error) {
+	if err := s.checkVerb(VerbList); err != nil {
+		return nil, err
+	}
+	release, err := s.inflight.admit(options)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return s.Store.List(ctx, s.limitPage(options))
+}
+
+// Watch makes a matcher for the given label and field if the watch verb is enabled.
+func (s *wrappedStore) Watch(ctx context.Context, options *metainternalversion.ListOptions) (watch.Interface, error) {
+	if err := s.checkVerb(VerbWatch); err != nil {
+		return nil, err
+	}
+
+	return s.Store.Watch(ctx, options)
+}
+
+// Create inserts a new item if the create verb is enabled.
+func (s *wrappedStore) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	if err := s.checkVerb(VerbCreate); err != nil {
+		return nil, err
+	}
+	if !s.preparesMayFail {
+		return s.Store.Create(ctx, obj, createValidation, options)
+	}
+	ctx, p := withPrepareError(ctx)
+	out, err := s.Store.Create(ctx, obj, createValidation, options)
+	if perr := p.get(); perr != nil {
+		name := ""
+		if m, merr := meta.Accessor(obj); merr == nil {
+			name = m.GetName()
+		}
+
+		return nil, admissionError(s.DefaultQualifiedResource, name, perr)
+	}
+
+	return out, err
+}
+
+// Update performs an atomic update and set of the object if the update verb is enabled.
+// Patch requests, including server-side apply, require the patch verb instead.
+func (s *wrappedStore) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	verb := VerbUpdate
+	if info, ok := genericapirequest.RequestInfoFrom(ctx); ok && info.Verb == VerbPatch {
+		verb = VerbPatch
+	}
+	if err := s.checkVerb(verb); err != nil {
+		return nil, false, err
+	}
+
+	if !s.preparesMayFail {
+		return s.Store.Update(ctx, name, objInfo, createValidation, updateValidation, forceAllowCreate, options)
+	}
+	ctx, p := withPrepareError(ctx)
+	out, created, err := s.Store.Update(ctx, name, objInfo, createValidation, updateValidation, forceAllowCreate, options)
+	if perr := p.get(); perr != nil {
+		return nil, false, admissionError(s.DefaultQualifiedResource, name, perr)
+	}
+
+	return out, created, err
+}
+
+// Delete removes the item from storage if the delete verb is enabled.
+func (s *wrappedStore) Delete(ctx context.Context, name string, deleteValidation rest.ValidateObjectFunc, options *metav1.DeleteOptions) (runtime.Object, bool, error) {
+	if err := s.checkVerb(VerbDelete); err != nil {
+		return nil, false, err
+	}
+
+	return s.Store.Delete(ctx, name, deleteValidation, options)
+}
+
+// DeleteCollection removes all items returned by List if the deletecollection verb is enabled.
+func (s *wrappedStore) DeleteCollection(ctx context.Context, deleteValidation rest.ValidateObjectFunc, options *metav1.DeleteOptions, listOptions *metainternalversion.ListOptions) (runtime.Object, error) {
+	if err := s.checkVerb(VerbDeleteCollection); err != nil {
+		return nil, err
+	}
+
+	return s.Store.DeleteCollection(ctx, deleteValidation, options, listOptions)
+}
+
+// verbMask is a bit set of the verb interfaces implemented by a restricted store.
+type verbMask uint8
+
+const (
+	maskGet verbMask = 1 << iota
+	maskList
+	maskWatch
+	maskCreate
+	maskUpdate
+	maskDelete
+	maskDeleteCollection
+)
+
+// interfaceMask returns the verb interfaces required to serve verbs. Patch requests are
+// served through the Getter and Updater interfaces, so enabling patch exposes both; the
+// verbs which are not enabled are still rejected by the wrappedStore.
+func interfaceMask(verbs sets.Set[string]) verbMask {
+	mask := verbMask(0)
+	if verbs.HasAny(VerbGet, VerbPatch) {
+		mask |= maskGet
+	}
+	if verbs.Has(VerbList) {
+		mask |= maskList
+	}
+	if verbs.Has(VerbWatch) {
+		mask |= maskWatch
+	}
+	if verbs.Has(VerbCreate) {
+		mask |= maskCreate
+	}
+	if verbs.HasAny(VerbUpdate, VerbPatch) {
+		mask |= maskUpdate
+	}
+	if verbs.Has(VerbDelete) {
+		mask |= maskDelete
+	}
+	if verbs.Has(VerbDeleteCollection) {
+		mask |= maskDeleteCollection
+	}
+
+	return mask
+}
+
+// restrictedStore exposes the methods of a wrappedStore which do not depend on the enabled verbs.
+// The generated restricted types combine it with the verb types below, so that a store with
+// restricted verbs only implements the rest interfaces of its verbs. The API installer only
+// installs handlers for these interfaces, so discovery does not advertise disabled verbs.
+type restrictedStore struct {
+	s *wrappedStore
+}
+
+func (r restrictedStore) unwrap() *wrappedStore { return r.s }
+
+func (r restrictedStore) New() runtime.Object { return r.s.New() }
+
+func (r restrictedStore) Destroy() { r.s.Destroy() }
+
+func (r restrictedStore) NamespaceScoped() bool { return r.s.NamespaceScoped() }
+
+func (r restrictedStore) ShortNames() []string { return r.s.ShortNames() }
+
+func (r restrictedStore) Categories() []string { return r.s.Categories() }
+
+func (r restrictedStore) GetSingularName() string { return r.s.GetSingularName() }
+
+func (r restrictedStore) StorageVersion() runtime.GroupVersioner { return r.s.StorageVersion() }
+
+func (r restrictedStore) ReadinessCheck() error { return r.s.ReadinessCheck() }
+
+func (r restrictedStore) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
+	return r.s.GetResetFields()
+}
+
+func (r restrictedStore) ConvertToTable(ctx context.Context, obj runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
+	return r.s.ConvertToTable(ctx, obj, tableOptions)
+}
+
+// getVerb implements rest.Getter.
+type getVerb struct {
+	s *wrappedStore
+}
+
+func (v getVerb) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return v.s.Get(ctx, name, options)
+}
+
+// listVerb implements rest.Lister together with restrictedStore.
+type listVerb struct {
+	s *wrappedStore
+}
+
+func (v listVerb) NewList() runtime.Object { return v.s.NewList() }
+
+func (v listVerb) List(ctx context.Context, options *metainternalversion.ListOptions) (runtime.Object, error) {
+	return v.s.List(ctx, options)
+}
+
+// watchVerb implements rest.Watcher.
+type watchVerb struct {
+	s *wrappedStore
+}
+
+func (v watchVerb) Watch(ctx context.Context, options *metainternalversion.ListOptions) (watch.Interface, error) {
+	return v.s.Watch(ctx, options)
+}
+
+// createVerb implements rest.Creater together with restrictedStore.
+type createVerb struct {
+	s *wrappedStore
+}
+
+func (v createVerb) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	return v.s.Create(ctx, obj, createValidation, options)
+}
+
+// updateVerb implements rest.Updater together with restrictedStore.
+type updateVerb struct {
+	s *wrappedStore
+}
+
+func (v updateVerb) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	return v.s.Update(ctx, name, objInfo, createValidation, updateValidation, forceAllowCreate, options)
+}
+
+// deleteVerb implements rest.GracefulDeleter.
+type deleteVerb struct {
+	s *wrappedStore
+}
+
+func (v deleteVerb) Delete(ctx context.Context, name string, deleteValidation rest.ValidateObjectFunc, options *metav1.DeleteOptions) (runtime.Object, bool, error) {
+	return v.s.Delete(ctx, name, deleteValidation, options)
+}
+
+func (v deleteVerb) DeleteReturnsDeletedObject() bool { return v.s.DeleteReturnsDeletedObject() }
+
+func (v deleteVerb) GetCorruptObjDeleter() rest.GracefulDeleter { return v.s.GetCorruptObjDeleter() }
+
+// deleteCollectionVerb implements rest.CollectionDeleter.
+type deleteCollectionVerb struct {
+	s *wrappedStore
+}
+
+func (v deleteCollectionVerb) DeleteCollection(ctx context.Context, deleteValidation rest.ValidateObjectFunc, options *metav1.DeleteOptions, listOptions *metainternalversion.ListOptions) (runtime.Object, error) {
+	return v.s.DeleteCollection(ctx, deleteValidation, options, listOptions)
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build ignore
+
+// verbs_generate.go generates the restricted store types of every combination of verbs.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+)
+
+// verbs are the verb types in the order of their bits in verbMask.
+var verbs = []string{"Get", "List", "Watch", "Create", "Update", "Delete", "DeleteCollection"}
+
+func typeName(mask int) string {
+	name := "restricted"
+	for i, v := range verbs {
+		if mask&(1<<i) != 0 {
+			name += v
+		}
+	}
+
+	return name
+}
+
+func main() {
+	buf := &bytes.Buffer{}
+	fmt.Fprint(buf, `// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by verbs_generate.go. DO NOT EDIT.
+
+package rest
+
+import (
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+`)
+	for mask := 1; mask < 1<<len(verbs); mask++ {
+		fmt.Fprintf(buf, "\ntype %s struct {\n\trestrictedStore\n", typeName(mask))
+		for i, v := range verbs {
+			if mask&(1<<i) != 0 {
+				fmt.Fprintf(buf, "\t%sVerb\n", lowerFirst(v))
+			}
+		}
+		fmt.Fprint(buf, "}\n")
+	}
+
+	fmt.Fprint(buf, `
+// newRestrictedStore returns a store which only implements the rest interfaces of the verbs in mask.
+func newRestrictedStore(s *wrappedStore, mask verbMask) rest.Storage {
+	base := restrictedStore{s: s}
+	switch mask {
+`)
+	for mask := 1; mask < 1<<len(verbs); mask++ {
+		fields := []string{"base"}
+		for i, v := range verbs {
+			if mask&(1<<i) != 0 {
+				fields = append(fields, fmt.Sprintf("%sVerb{s: s}", lowerFirst(v)))
+			}
+		}
+		fmt.Fprintf(buf, "\tcase %d:\n\t\treturn %s{%s}\n", mask, typeName(mask), strings.Join(fields, ", "))
+	}
+	fmt.Fprint(buf, "\tdefault:\n\t\treturn base\n\t}\n}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("zz_generated.verbs.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func lowerFirst(s string) string {
+	return strings.ToLower(s[:1]) + s[1:]
+}
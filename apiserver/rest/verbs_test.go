@@ -0,0 +1,124 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WithVerbs", func() {
+	It("should enable all verbs by default", func() {
+		verbs, err := (&storeConfig{}).verbSet()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(verbs).To(BeNil())
+	})
+
+	It("should reject unknown verbs", func() {
+		cfg := &storeConfig{}
+		WithVerbs(VerbGet, "approve")(cfg)
+		_, err := cfg.verbSet()
+		Expect(err).To(MatchError(ErrUnknownVerb))
+		Expect(err).To(MatchError(ContainSubstring(`unknown verb "approve"`)))
+	})
+
+	It("should fail to set up stores with unknown verbs", func() {
+		_, err := NewStore(runtime.NewScheme(), nil, nil, schema.GroupResource{Resource: "testobjs"}, nil, nil, WithVerbs("approve"))
+		Expect(err).To(MatchError(ErrStoreInit))
+		Expect(err).To(MatchError(ErrUnknownVerb))
+	})
+
+	Describe("wrappedStore", func() {
+		var (
+			ctx   = context.Background()
+			store *wrappedStore
+		)
+
+		BeforeEach(func() {
+			store = &wrappedStore{
+				Store: &genericregistry.Store{
+					DefaultQualifiedResource: schema.GroupResource{Group: "arc", Resource: "testobjs"},
+				},
+				verbs: sets.New(VerbGet, VerbList, VerbWatch),
+			}
+		})
+
+		It("should reject disabled verbs with MethodNotAllowed", func() {
+			_, err := store.Create(ctx, &testObj{}, nil, &metav1.CreateOptions{})
+			Expect(apierrors.IsMethodNotSupported(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("create is not supported")))
+
+			_, _, err = store.Delete(ctx, "name", nil, &metav1.DeleteOptions{})
+			Expect(apierrors.IsMethodNotSupported(err)).To(BeTrue())
+
+			_, err = store.DeleteCollection(ctx, nil, &metav1.DeleteOptions{}, &metainternalversion.ListOptions{})
+			Expect(apierrors.IsMethodNotSupported(err)).To(BeTrue())
+		})
+
+		It("should distinguish patch from update requests", func() {
+			store.verbs = sets.New(VerbUpdate)
+			patchCtx := genericapirequest.WithRequestInfo(ctx, &genericapirequest.RequestInfo{Verb: VerbPatch})
+			_, _, err := store.Update(patchCtx, "name", nil, nil, nil, false, &metav1.UpdateOptions{})
+			Expect(err).To(MatchError(ContainSubstring("patch is not supported")))
+		})
+
+		It("should only implement the interfaces of the enabled verbs", func() {
+			s := newRestrictedStore(store, interfaceMask(store.verbs))
+			Expect(s).To(BeAssignableToTypeOf(restrictedGetListWatch{}))
+			_, isGetter := s.(rest.Getter)
+			_, isLister := s.(rest.Lister)
+			_, isWatcher := s.(rest.Watcher)
+			Expect([]bool{isGetter, isLister, isWatcher}).To(HaveEach(BeTrue()))
+
+			_, isCreater := s.(rest.Creater)
+			_, isUpdater := s.(rest.Updater)
+			_, isDeleter := s.(rest.GracefulDeleter)
+			_, isCollectionDeleter := s.(rest.CollectionDeleter)
+			Expect([]bool{isCreater, isUpdater, isDeleter, isCollectionDeleter}).To(HaveEach(BeFalse()))
+
+			_, isShortNamesProvider := s.(rest.ShortNamesProvider)
+			_, isCategoriesProvider := s.(rest.CategoriesProvider)
+			_, isTableConvertor := s.(rest.TableConvertor)
+			Expect(isShortNamesProvider).To(BeTrue())
+			Expect(isCategoriesProvider).To(BeTrue())
+			Expect(isTableConvertor).To(BeTrue())
+			Expect(Unwrap(s)).To(BeIdenticalTo(store.Store))
+		})
+
+		It("should implement the getter and updater interfaces for patch", func() {
+			store.verbs = sets.New(VerbPatch)
+			s := newRestrictedStore(store, interfaceMask(store.verbs))
+			_, isPatcher := s.(rest.Patcher)
+			Expect(isPatcher).To(BeTrue())
+			_, isLister := s.(rest.Lister)
+			Expect(isLister).To(BeFalse())
+
+			// Get is exposed for patch, but get requests are still rejected.
+			_, err := s.(rest.Getter).Get(ctx, "name", &metav1.GetOptions{})
+			Expect(apierrors.IsMethodNotSupported(err)).To(BeTrue())
+		})
+
+		It("should reject reads when only writes are enabled", func() {
+			store.verbs = sets.New(VerbCreate)
+			_, err := store.Get(ctx, "name", &metav1.GetOptions{})
+			Expect(apierrors.IsMethodNotSupported(err)).To(BeTrue())
+			_, err = store.List(ctx, &metainternalversion.ListOptions{})
+			Expect(apierrors.IsMethodNotSupported(err)).To(BeTrue())
+			_, err = store.Watch(ctx, &metainternalversion.ListOptions{})
+			Expect(apierrors.IsMethodNotSupported(err)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,62 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	serverstorage "k8s.io/apiserver/pkg/server/storage"
+)
+
+// applyRuntimeConfig sets the resource config of serverConfig from the --runtime-config flag.
+// All group versions registered in the scheme are enabled by default. The effective version of
+// serverConfig must be set, as alpha and beta versions are enabled depending on it.
+func (c *completedConfig) applyRuntimeConfig(serverConfig *genericapiserver.RecommendedConfig) error {
+	if serverConfig.EffectiveVersion == nil {
+		return errors.New("effective version not set on the server config")
+	}
+	defaultResourceConfig := serverstorage.NewResourceConfig()
+	defaultResourceConfig.EnableVersions(c.scheme.PrioritizedVersionsAllGroups()...)
+
+	return c.apiEnablement.ApplyTo(&serverConfig.Config, defaultResourceConfig, c.scheme)
+}
+
+// removeDisabledResources removes all resources disabled by the resource config from apiGroupInfo,
+// as well as group versions without any enabled resources. Subresources are removed with their resource.
+func removeDisabledResources(apiGroupInfo *genericapiserver.APIGroupInfo, resourceConfig *serverstorage.ResourceConfig) {
+	if resourceConfig == nil {
+		return
+	}
+	removeResources(apiGroupInfo, func(gvr schema.GroupVersionResource) bool {
+		return !resourceConfig.ResourceEnabled(gvr)
+	})
+}
+
+// removeResources removes all resources for which remove returns true from apiGroupInfo, as well
+// as group versions without any other resources. Subresources are removed with their resource.
+func removeResources(apiGroupInfo *genericapiserver.APIGroupInfo, remove func(schema.GroupVersionResource) bool) {
+	apiGroupInfo.PrioritizedVersions = slices.DeleteFunc(slices.Clone(apiGroupInfo.PrioritizedVersions), func(gv schema.GroupVersion) bool {
+		// Versions may share their storage map, so only the copy of this version is modified.
+		storage := maps.Clone(apiGroupInfo.VersionedResourcesStorageMap[gv.Version])
+		for path := range storage {
+			resource, _, _ := strings.Cut(path, "/")
+			if remove(gv.WithResource(resource)) {
+				delete(storage, path)
+			}
+		}
+		if len(storage) > 0 {
+			apiGroupInfo.VersionedResourcesStorageMap[gv.Version] = storage
+
+			return false
+		}
+		delete(apiGroupInfo.VersionedResourcesStorageMap, gv.Version)
+
+		return true
+	})
+}
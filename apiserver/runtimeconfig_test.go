@@ -0,0 +1,119 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"maps"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	genericoptions "k8s.io/apiserver/pkg/server/options"
+	basecompatibility "k8s.io/component-base/compatibility"
+
+	"go.opendefense.cloud/kit/apiserver/rest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("runtime config", func() {
+	var (
+		v1alpha1 = schema.GroupVersion{Group: "test.opendefense.cloud", Version: "v1alpha1"}
+		v1       = schema.GroupVersion{Group: "test.opendefense.cloud", Version: "v1"}
+		c        *completedConfig
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		scheme.AddKnownTypeWithName(v1.WithKind("MockResourceList"), &mockResourceList{})
+		scheme.AddKnownTypeWithName(v1alpha1.WithKind("MockResourceList"), &mockResourceList{})
+		Expect(scheme.SetVersionPriority(v1, v1alpha1)).To(Succeed())
+		c = &completedConfig{
+			builderConfig: builderConfig{scheme: scheme, codecs: serializer.NewCodecFactory(scheme)},
+			apiEnablement: genericoptions.NewAPIEnablementOptions(),
+		}
+	})
+
+	servedFrom := func(runtimeConfig map[string]string, storageMap map[string]map[string]rest.Storage) map[string][]string {
+		GinkgoHelper()
+		for k, v := range runtimeConfig {
+			c.apiEnablement.RuntimeConfig[k] = v
+		}
+		Expect(c.apiEnablement.Validate(c.scheme)).To(BeEmpty())
+		serverConfig := genericapiserver.NewRecommendedConfig(c.codecs)
+		serverConfig.EffectiveVersion = basecompatibility.NewEffectiveVersionFromString("1.2", "", "")
+		Expect(c.applyRuntimeConfig(serverConfig)).To(Succeed())
+
+		apiGroupInfo := &genericapiserver.APIGroupInfo{
+			PrioritizedVersions:          []schema.GroupVersion{v1, v1alpha1},
+			VersionedResourcesStorageMap: storageMap,
+		}
+		removeDisabledResources(apiGroupInfo, serverConfig.MergedResourceConfig)
+		resources := map[string][]string{}
+		for _, gv := range apiGroupInfo.PrioritizedVersions {
+			resources[gv.Version] = slices.Sorted(maps.Keys(apiGroupInfo.VersionedResourcesStorageMap[gv.Version]))
+		}
+
+		return resources
+	}
+
+	served := func(runtimeConfig map[string]string) map[string][]string {
+		GinkgoHelper()
+
+		return servedFrom(runtimeConfig, map[string]map[string]rest.Storage{
+			v1.Version:       {"bars": nil, "bars/status": nil, "foos": nil},
+			v1alpha1.Version: {"bars": nil},
+		})
+	}
+
+	It("should serve all resources by default", func() {
+		Expect(served(nil)).To(Equal(map[string][]string{
+			"v1":       {"bars", "bars/status", "foos"},
+			"v1alpha1": {"bars"},
+		}))
+	})
+
+	It("should disable group versions", func() {
+		Expect(served(map[string]string{"test.opendefense.cloud/v1alpha1": "false"})).To(Equal(map[string][]string{
+			"v1": {"bars", "bars/status", "foos"},
+		}))
+	})
+
+	It("should disable resources with their subresources", func() {
+		Expect(served(map[string]string{"test.opendefense.cloud/v1/bars": "false"})).To(Equal(map[string][]string{
+			"v1":       {"foos"},
+			"v1alpha1": {"bars"},
+		}))
+	})
+
+	It("should disable alpha versions", func() {
+		Expect(served(map[string]string{"api/alpha": "false"})).To(Equal(map[string][]string{
+			"v1": {"bars", "bars/status", "foos"},
+		}))
+	})
+
+	It("should disable resources of versions sharing their storage map", func() {
+		// Resource registers the same storage map for all of its versions.
+		shared := map[string]rest.Storage{"bars": nil, "bars/status": nil}
+		Expect(servedFrom(map[string]string{"test.opendefense.cloud/v1/bars": "false"}, map[string]map[string]rest.Storage{
+			v1.Version:       shared,
+			v1alpha1.Version: shared,
+		})).To(Equal(map[string][]string{
+			"v1alpha1": {"bars", "bars/status"},
+		}))
+		Expect(shared).To(HaveLen(2))
+	})
+
+	It("should require the effective version", func() {
+		Expect(c.applyRuntimeConfig(genericapiserver.NewRecommendedConfig(c.codecs))).To(MatchError(ContainSubstring("effective version not set")))
+	})
+
+	It("should reject unknown groups", func() {
+		c.apiEnablement.RuntimeConfig["unknown.opendefense.cloud/v1"] = "false"
+		Expect(c.apiEnablement.Validate(c.scheme)).NotTo(BeEmpty())
+	})
+})
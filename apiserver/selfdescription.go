@@ -0,0 +1,166 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"cmp"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	basecompatibility "k8s.io/component-base/compatibility"
+	"k8s.io/component-base/featuregate"
+
+	"go.opendefense.cloud/kit/apiserver/rest"
+)
+
+// SelfDescriptionPath is the path serving the SelfDescription of the server.
+const SelfDescriptionPath = "/selfdescription"
+
+// SelfDescription is a machine-readable description of the API surface of a running server, e.g.
+// for fleet inventory tooling.
+type SelfDescription struct {
+	Component        string                   `json:"component"`
+	BinaryVersion    string                   `json:"binaryVersion"`
+	EmulationVersion string                   `json:"emulationVersion"`
+	Groups           []GroupDescription       `json:"groups"`
+	FeatureGates     []FeatureGateDescription `json:"featureGates"`
+}
+
+// GroupDescription describes a served API group.
+type GroupDescription struct {
+	Name string `json:"name"`
+	// Versions are the served versions of the group, the preferred version first.
+	Versions []VersionDescription `json:"versions"`
+}
+
+// VersionDescription describes a served version of an API group.
+type VersionDescription struct {
+	Version   string                `json:"version"`
+	Resources []ResourceDescription `json:"resources"`
+}
+
+// ResourceDescription describes a resource served in a group version.
+type ResourceDescription struct {
+	Name         string   `json:"name"`
+	Kind         string   `json:"kind,omitempty"`
+	Namespaced   bool     `json:"namespaced"`
+	Subresources []string `json:"subresources,omitempty"`
+	// Stability is the stability level of resources marked as alpha or beta, see
+	// ResourceHandler.WithStability.
+	Stability Stability `json:"stability,omitempty"`
+}
+
+// FeatureGateDescription describes a feature gate of the server.
+type FeatureGateDescription struct {
+	Name       string `json:"name"`
+	Enabled    bool   `json:"enabled"`
+	PreRelease string `json:"preRelease"`
+}
+
+// WithSelfDescription serves the SelfDescription of the server at SelfDescriptionPath. It lists
+// the resources which are actually served, i.e. without the group versions and resources
+// disabled by the emulation version or --runtime-config, and the feature gates of the server.
+func (b *Builder) WithSelfDescription() *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.selfDescription = true
+
+	return b
+}
+
+// installSelfDescription installs SelfDescriptionPath into the server if requested.
+func (c *completedConfig) installSelfDescription(server *genericapiserver.GenericAPIServer, groups []*genericapiserver.APIGroupInfo,
+	gate featuregate.FeatureGate, effectiveVersion basecompatibility.EffectiveVersion) error {
+	if !c.selfDescription {
+		return nil
+	}
+	body, err := json.Marshal(c.describe(groups, gate, effectiveVersion))
+	if err != nil {
+		return err
+	}
+	server.Handler.NonGoRestfulMux.HandleFunc(SelfDescriptionPath, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	})
+
+	return nil
+}
+
+// describe returns the SelfDescription of the installed groups, sorted by name.
+func (c *completedConfig) describe(groups []*genericapiserver.APIGroupInfo, gate featuregate.FeatureGate,
+	effectiveVersion basecompatibility.EffectiveVersion) SelfDescription {
+	d := SelfDescription{
+		Component:        c.componentName,
+		BinaryVersion:    effectiveVersion.BinaryVersion().String(),
+		EmulationVersion: effectiveVersion.EmulationVersion().String(),
+		Groups:           []GroupDescription{},
+		FeatureGates:     []FeatureGateDescription{},
+	}
+	stabilities := c.resourceStabilities()
+	for _, group := range groups {
+		g := GroupDescription{Versions: []VersionDescription{}}
+		for _, gv := range group.PrioritizedVersions {
+			g.Name = gv.Group
+			g.Versions = append(g.Versions, VersionDescription{
+				Version:   gv.Version,
+				Resources: c.describeResources(gv, group.VersionedResourcesStorageMap[gv.Version], stabilities),
+			})
+		}
+		d.Groups = append(d.Groups, g)
+	}
+	slices.SortFunc(d.Groups, func(a, b GroupDescription) int { return cmp.Compare(a.Name, b.Name) })
+
+	if mutable, ok := gate.(featuregate.MutableFeatureGate); ok {
+		specs := mutable.GetAll()
+		for _, name := range slices.Sorted(maps.Keys(specs)) {
+			// The gates enabling all alpha or beta features are not features themselves.
+			if name == "AllAlpha" || name == "AllBeta" {
+				continue
+			}
+			d.FeatureGates = append(d.FeatureGates, FeatureGateDescription{
+				Name:       string(name),
+				Enabled:    gate.Enabled(name),
+				PreRelease: string(specs[name].PreRelease),
+			})
+		}
+	}
+
+	return d
+}
+
+// describeResources returns the descriptions of the resources in the storage map of gv, with
+// their subresources and stability levels.
+func (c *completedConfig) describeResources(gv schema.GroupVersion, storage map[string]rest.Storage,
+	stabilities map[schema.GroupVersionResource]Stability) []ResourceDescription {
+	resources := []ResourceDescription{}
+	subresources := map[string][]string{}
+	for _, path := range slices.Sorted(maps.Keys(storage)) {
+		name, sub, ok := strings.Cut(path, "/")
+		if ok {
+			subresources[name] = append(subresources[name], sub)
+			continue
+		}
+		r := ResourceDescription{Name: name, Stability: stabilities[gv.WithResource(name)]}
+		// The type of the resource may be registered in other group versions as well.
+		if kinds, _, err := c.scheme.ObjectKinds(storage[path].New()); err == nil {
+			if i := slices.IndexFunc(kinds, func(gvk schema.GroupVersionKind) bool { return gvk.GroupVersion() == gv }); i >= 0 {
+				r.Kind = kinds[i].Kind
+			}
+		}
+		if scoper, ok := storage[path].(rest.Scoper); ok {
+			r.Namespaced = scoper.NamespaceScoped()
+		}
+		resources = append(resources, r)
+	}
+	for i := range resources {
+		resources[i].Subresources = subresources[resources[i].Name]
+	}
+
+	return resources
+}
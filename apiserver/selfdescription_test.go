@@ -0,0 +1,78 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	basecompatibility "k8s.io/component-base/compatibility"
+	"k8s.io/component-base/featuregate"
+
+	"go.opendefense.cloud/kit/apiserver/rest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// namespacedStorage is a mockStorage of a namespaced resource.
+type namespacedStorage struct {
+	*mockStorage
+}
+
+func (namespacedStorage) NamespaceScoped() bool { return true }
+
+var _ = Describe("self description", func() {
+	It("should describe the installed groups and feature gates", func() {
+		v1 := schema.GroupVersion{Group: "test.opendefense.cloud", Version: "v1"}
+		v1alpha1 := schema.GroupVersion{Group: "test.opendefense.cloud", Version: "v1alpha1"}
+		scheme := runtime.NewScheme()
+		scheme.AddKnownTypeWithName(v1.WithKind("Bar"), &mockStorage{})
+		scheme.AddKnownTypeWithName(v1alpha1.WithKind("Bar"), &mockStorage{})
+		bars := namespacedStorage{&mockStorage{name: "bars"}}
+		groups := []*genericapiserver.APIGroupInfo{
+			{
+				PrioritizedVersions: []schema.GroupVersion{{Group: "other.opendefense.cloud", Version: "v1"}},
+				VersionedResourcesStorageMap: map[string]map[string]rest.Storage{
+					"v1": {"foos": &mockStorage{name: "foos"}},
+				},
+			},
+			{
+				PrioritizedVersions: []schema.GroupVersion{v1, v1alpha1},
+				VersionedResourcesStorageMap: map[string]map[string]rest.Storage{
+					"v1":       {"bars": bars, "bars/status": bars, "bars/scale": bars},
+					"v1alpha1": {"bars": bars},
+				},
+			},
+		}
+		gate := featuregate.NewFeatureGate()
+		Expect(gate.Add(map[featuregate.Feature]featuregate.FeatureSpec{
+			"Frobnicate": {Default: true, PreRelease: featuregate.Beta},
+		})).To(Succeed())
+
+		c := &completedConfig{builderConfig: builderConfig{componentName: "test", scheme: scheme}}
+		Expect(c.describe(groups, gate, basecompatibility.NewEffectiveVersionFromString("1.3", "", ""))).To(Equal(SelfDescription{
+			Component:        "test",
+			BinaryVersion:    "1.3",
+			EmulationVersion: "1.3",
+			Groups: []GroupDescription{
+				{Name: "other.opendefense.cloud", Versions: []VersionDescription{
+					{Version: "v1", Resources: []ResourceDescription{{Name: "foos"}}},
+				}},
+				{Name: "test.opendefense.cloud", Versions: []VersionDescription{
+					{Version: "v1", Resources: []ResourceDescription{
+						{Name: "bars", Kind: "Bar", Namespaced: true, Subresources: []string{"scale", "status"}},
+					}},
+					{Version: "v1alpha1", Resources: []ResourceDescription{{Name: "bars", Kind: "Bar", Namespaced: true}}},
+				}},
+			},
+			FeatureGates: []FeatureGateDescription{{Name: "Frobnicate", Enabled: true, PreRelease: "BETA"}},
+		}))
+	})
+
+	It("should only be served if requested", func() {
+		Expect(NewBuilder(runtime.NewScheme()).selfDescription).To(BeFalse())
+		Expect(NewBuilder(runtime.NewScheme()).WithSelfDescription().selfDescription).To(BeTrue())
+	})
+})
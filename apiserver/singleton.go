@@ -0,0 +1,95 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+
+	"go.opendefense.cloud/kit/apiserver/resource"
+	"go.opendefense.cloud/kit/apiserver/rest"
+)
+
+// singletonBootstrapInterval is the interval in which creating the default instance is retried.
+const singletonBootstrapInterval = time.Second
+
+// Singleton registers a cluster-scoped resource of which only a single instance named
+// obj.SingletonName() may exist, like ClusterVersion.
+//
+// The given obj is used as the default instance, which is created when the server starts
+// unless it already exists. Clients may get, list, watch, update and patch the instance,
+// but not create or delete it.
+//
+//	func (c *ClusterConfig) SingletonName() string {
+//	    return "cluster"
+//	}
+//
+//	builder.With(apiserver.Singleton(&ClusterConfig{Spec: defaultSpec}, v1alpha1.SchemeGroupVersion))
+func Singleton[E resource.Object, T interface {
+	resource.ObjectWithDeepCopy[E]
+	rest.Singleton
+}](obj T, gvs ...schema.GroupVersion) ResourceHandler {
+	if obj.NamespaceScoped() {
+		panic("singleton resources must be cluster-scoped")
+	}
+	rh := Resource[E](obj, gvs...).
+		WithVerbs(rest.VerbGet, rest.VerbList, rest.VerbWatch, rest.VerbUpdate, rest.VerbPatch)
+	newAPIGroup := rh.newAPIGroup
+	rh.newAPIGroup = func(opts *resourceOptions) (APIGroupFn, []postStartHook) {
+		fn, hooks := newAPIGroup(opts)
+
+		return fn, append(hooks, postStartHook{
+			name: fmt.Sprintf("bootstrap-%s", obj.GetGroupResource()),
+			fn:   bootstrapSingleton(opts, obj, obj.SingletonName()),
+		})
+	}
+
+	return rh
+}
+
+// bootstrapSingleton returns a post-start hook creating the default instance if it does not exist.
+func bootstrapSingleton(opts *resourceOptions, obj runtime.Object, name string) genericapiserver.PostStartHookFunc {
+	return func(hookCtx genericapiserver.PostStartHookContext) error {
+		// Bypass the verb restrictions, the server itself is allowed to create the instance.
+		store := rest.Unwrap(opts.store)
+
+		return wait.PollUntilContextCancel(hookCtx, singletonBootstrapInterval, true, func(ctx context.Context) (bool, error) {
+			_, err := store.Get(ctx, name, &metav1.GetOptions{})
+			if err == nil {
+				return true, nil
+			}
+			if !apierrors.IsNotFound(err) {
+				// Storage may not be ready yet, retry.
+				return false, nil
+			}
+
+			instance := obj.DeepCopyObject()
+			m, err := meta.Accessor(instance)
+			if err != nil {
+				return false, err
+			}
+			m.SetName(name)
+			m.SetResourceVersion("")
+
+			_, err = store.Create(ctx, instance, nil, &metav1.CreateOptions{})
+			switch {
+			case err == nil, apierrors.IsAlreadyExists(err):
+				return true, nil
+			case apierrors.IsInvalid(err):
+				return false, fmt.Errorf("default %s instance is invalid: %w", name, err)
+			default:
+				return false, nil
+			}
+		})
+	}
+}
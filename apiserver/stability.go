@@ -0,0 +1,133 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"fmt"
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/apiserver/pkg/warning"
+)
+
+// Stability is the stability level of a resource, independent of the versions it is served in.
+type Stability string
+
+const (
+	// StabilityStable resources don't change incompatibly. It is the default.
+	StabilityStable Stability = "stable"
+	// StabilityBeta resources may still change incompatibly, but are not removed without notice.
+	StabilityBeta Stability = "beta"
+	// StabilityAlpha resources may change incompatibly or be removed at any time.
+	StabilityAlpha Stability = "alpha"
+)
+
+// enableAlphaResourcesFlag is the flag opting in to serve alpha resources, see
+// Builder.WithAlphaResourcesOptIn.
+const enableAlphaResourcesFlag = "enable-alpha-resources"
+
+// WithStability marks the resource as alpha or beta. Requests to it get a warning, which kubectl
+// prints, and discovery lists it in the category of its stability level, so clients find all
+// alpha resources with kubectl get alpha. See Builder.WithAlphaResourcesOptIn to serve alpha
+// resources only if requested by the operator.
+func (rh ResourceHandler) WithStability(s Stability) ResourceHandler {
+	rh.options = rh.options.clone()
+	rh.options.stability = s
+	return rh
+}
+
+// WithAlphaResourcesOptIn only serves resources marked as alpha with ResourceHandler.WithStability
+// if the operator opts in with the --enable-alpha-resources flag.
+func (b *Builder) WithAlphaResourcesOptIn() *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.alphaResourcesOptIn = true
+
+	return b
+}
+
+// validate returns an error if s is not a known stability level. The empty level is stable.
+func (s Stability) validate() error {
+	switch s {
+	case "", StabilityStable, StabilityBeta, StabilityAlpha:
+		return nil
+	default:
+		return fmt.Errorf("unknown stability %q, expected one of %s, %s or %s", s, StabilityStable, StabilityBeta, StabilityAlpha)
+	}
+}
+
+// orStable returns s, or StabilityStable if s is empty.
+func (s Stability) orStable() Stability {
+	if s == "" {
+		return StabilityStable
+	}
+
+	return s
+}
+
+// resourceStabilities returns the stability levels of all resources registered with With which
+// are not stable.
+func (c *completedConfig) resourceStabilities() map[schema.GroupVersionResource]Stability {
+	stabilities := map[schema.GroupVersionResource]Stability{}
+	for _, fn := range c.resourceInfoFns {
+		for _, info := range fn(c.scheme) {
+			if info.Stability != StabilityStable {
+				stabilities[schema.GroupVersionResource{Group: info.Group, Version: info.Version, Resource: info.Resource}] = info.Stability
+			}
+		}
+	}
+
+	return stabilities
+}
+
+// servesAlphaResources returns true if resources marked as alpha are served.
+func (c *completedConfig) servesAlphaResources() bool {
+	return !c.alphaResourcesOptIn || c.enableAlphaResources
+}
+
+// removeAlphaResources removes all alpha resources from apiGroupInfo, as well as group versions
+// without any other resources.
+func removeAlphaResources(apiGroupInfo *genericapiserver.APIGroupInfo, stabilities map[schema.GroupVersionResource]Stability) {
+	removeResources(apiGroupInfo, func(gvr schema.GroupVersionResource) bool {
+		return stabilities[gvr] == StabilityAlpha
+	})
+}
+
+// stabilityWarnings returns the warnings of all resources which are not stable.
+func stabilityWarnings(stabilities map[schema.GroupVersionResource]Stability) map[schema.GroupVersionResource]string {
+	warnings := map[schema.GroupVersionResource]string{}
+	for gvr, s := range stabilities {
+		msg := fmt.Sprintf("%s %s is %s and may change incompatibly", gvr.GroupVersion(), gvr.Resource, s)
+		if s == StabilityAlpha {
+			msg += " or be removed without notice"
+		}
+		warnings[gvr] = msg
+	}
+
+	return warnings
+}
+
+// withStabilityWarnings wraps the API handler passed to delegate to add a warning to all
+// requests of resources which are not stable.
+func withStabilityWarnings(delegate func(http.Handler, *genericapiserver.Config) http.Handler, warnings map[schema.GroupVersionResource]string) func(http.Handler, *genericapiserver.Config) http.Handler {
+	if len(warnings) == 0 {
+		return delegate
+	}
+
+	return func(apiHandler http.Handler, c *genericapiserver.Config) http.Handler {
+		handler := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if info, ok := request.RequestInfoFrom(req.Context()); ok && info.IsResourceRequest {
+				gvr := schema.GroupVersionResource{Group: info.APIGroup, Version: info.APIVersion, Resource: info.Resource}
+				if msg, ok := warnings[gvr]; ok {
+					warning.AddWarning(req.Context(), "", msg)
+				}
+			}
+			apiHandler.ServeHTTP(w, req)
+		})
+
+		return delegate(handler, c)
+	}
+}
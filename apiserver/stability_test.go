@@ -0,0 +1,98 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/apiserver/pkg/warning"
+	basecompatibility "k8s.io/component-base/compatibility"
+
+	"go.opendefense.cloud/kit/apiserver/rest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Stability", func() {
+	var (
+		v1       = schema.GroupVersion{Group: "test.opendefense.cloud", Version: "v1"}
+		v1alpha1 = schema.GroupVersion{Group: "test.opendefense.cloud", Version: "v1alpha1"}
+		b        *Builder
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		scheme.AddKnownTypeWithName(v1.WithKind("MockResourceList"), &mockResourceList{})
+		scheme.AddKnownTypeWithName(v1alpha1.WithKind("MockResourceList"), &mockResourceList{})
+		b = NewBuilder(scheme).
+			With(Resource(&mockResourceObject{gr: schema.GroupResource{Group: v1.Group, Resource: "bars"}}, v1)).
+			With(Resource(&mockResourceObject{gr: schema.GroupResource{Group: v1.Group, Resource: "foos"}}, v1).WithStability(StabilityBeta)).
+			With(Resource(&mockResourceObject{gr: schema.GroupResource{Group: v1.Group, Resource: "bazs"}}, v1, v1alpha1).WithStability(StabilityAlpha))
+		b.componentGlobalsRegistry = basecompatibility.NewComponentGlobalsRegistry()
+	})
+
+	It("should collect the stability levels of the resources", func() {
+		c, err := b.complete()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.resourceStabilities()).To(Equal(map[schema.GroupVersionResource]Stability{
+			v1.WithResource("foos"):       StabilityBeta,
+			v1.WithResource("bazs"):       StabilityAlpha,
+			v1alpha1.WithResource("bazs"): StabilityAlpha,
+		}))
+		Expect(stabilityWarnings(c.resourceStabilities())).To(HaveKeyWithValue(v1alpha1.WithResource("bazs"),
+			"test.opendefense.cloud/v1alpha1 bazs is alpha and may change incompatibly or be removed without notice"))
+	})
+
+	It("should only remove alpha resources without opt-in", func() {
+		c, err := b.complete()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.servesAlphaResources()).To(BeTrue())
+		c, err = b.WithAlphaResourcesOptIn().complete()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.servesAlphaResources()).To(BeFalse())
+
+		apiGroupInfo := &genericapiserver.APIGroupInfo{
+			PrioritizedVersions: []schema.GroupVersion{v1, v1alpha1},
+			VersionedResourcesStorageMap: map[string]map[string]rest.Storage{
+				v1.Version:       {"bars": nil, "foos": nil, "bazs": nil, "bazs/status": nil},
+				v1alpha1.Version: {"bazs": nil},
+			},
+		}
+		removeAlphaResources(apiGroupInfo, c.resourceStabilities())
+		Expect(apiGroupInfo.PrioritizedVersions).To(ConsistOf(v1))
+		Expect(slices.Sorted(maps.Keys(apiGroupInfo.VersionedResourcesStorageMap[v1.Version]))).To(Equal([]string{"bars", "foos"}))
+	})
+
+	It("should add stability warnings to requests", func() {
+		chain := func(apiHandler http.Handler, _ *genericapiserver.Config) http.Handler { return apiHandler }
+		handler := withStabilityWarnings(chain, map[schema.GroupVersionResource]string{v1.WithResource("foos"): "beta"})(
+			http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}), nil)
+
+		serve := func(resource string) recordingWarnings {
+			warnings := recordingWarnings{}
+			ctx := warning.WithWarningRecorder(request.WithRequestInfo(GinkgoT().Context(), &request.RequestInfo{
+				IsResourceRequest: true, APIGroup: v1.Group, APIVersion: v1.Version, Resource: resource,
+			}), &warnings)
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx))
+
+			return warnings
+		}
+		Expect(serve("foos")).To(ConsistOf("beta"))
+		Expect(serve("bars")).To(BeEmpty())
+	})
+
+	It("should reject unknown stability levels", func() {
+		_, err := b.With(Resource(&mockResourceObject{gr: schema.GroupResource{Group: v1.Group, Resource: "quxs"}}, v1).
+			WithStability("experimental")).complete()
+		Expect(err).To(MatchError(ContainSubstring(`unknown stability "experimental"`)))
+	})
+})
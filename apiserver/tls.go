@@ -0,0 +1,127 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"slices"
+
+	cliflag "k8s.io/component-base/cli/flag"
+)
+
+// TLSPolicy restricts the TLS configuration of the secure port. Its fields are the defaults of
+// the --tls-min-version, --tls-cipher-suites and --tls-curve-preferences flags.
+type TLSPolicy struct {
+	// MinVersion is the minimum TLS version, e.g. "VersionTLS12". It must be at least TLS 1.2.
+	MinVersion string
+	// CipherSuites are the allowed cipher suites of TLS 1.2, e.g.
+	// "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256". The cipher suites of TLS 1.3 are not configurable,
+	// so they must be empty if MinVersion is TLS 1.3.
+	CipherSuites []string
+	// CurvePreferences are the allowed key exchange mechanisms. Empty allows the defaults of Go.
+	CurvePreferences []tls.CurveID
+}
+
+// Names of the TLS policy presets.
+const (
+	// TLSPresetModern only allows TLS 1.3 with post-quantum hybrid and elliptic curve key exchange.
+	TLSPresetModern = "modern"
+	// TLSPresetFIPS only allows the protocol versions, cipher suites and curves approved by
+	// NIST SP 800-52r2. The server binary has to be built with a FIPS 140 validated crypto module,
+	// e.g. with GOFIPS140, for FIPS compliance.
+	TLSPresetFIPS = "fips"
+)
+
+// TLSPolicyPreset returns a copy of the named preset, e.g. TLSPresetFIPS.
+func TLSPolicyPreset(name string) (TLSPolicy, error) {
+	switch name {
+	case TLSPresetModern:
+		return TLSPolicy{
+			MinVersion:       "VersionTLS13",
+			CurvePreferences: []tls.CurveID{tls.X25519MLKEM768, tls.X25519, tls.CurveP256, tls.CurveP384},
+		}, nil
+	case TLSPresetFIPS:
+		return TLSPolicy{
+			MinVersion: "VersionTLS12",
+			CipherSuites: []string{
+				"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
+				"TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384",
+				"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+				"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+			},
+			CurvePreferences: []tls.CurveID{tls.CurveP256, tls.CurveP384},
+		}, nil
+	}
+
+	return TLSPolicy{}, fmt.Errorf("unknown TLS policy preset %q, must be %q or %q", name, TLSPresetModern, TLSPresetFIPS)
+}
+
+// Validate returns an error if the policy allows insecure or unknown protocol versions, cipher
+// suites or curves.
+func (p TLSPolicy) Validate() error {
+	errs := []error{}
+	minVersion, err := cliflag.TLSVersion(p.MinVersion)
+	if err != nil {
+		errs = append(errs, err)
+	} else if minVersion < tls.VersionTLS12 {
+		errs = append(errs, fmt.Errorf("minimum TLS version %s is insecure, at least VersionTLS12 is required", p.MinVersion))
+	}
+	if _, err := cliflag.TLSCipherSuites(p.CipherSuites); err != nil {
+		errs = append(errs, err)
+	}
+	insecure := cliflag.InsecureTLSCiphers()
+	for _, name := range p.CipherSuites {
+		if _, ok := insecure[name]; ok {
+			errs = append(errs, fmt.Errorf("cipher suite %s is insecure", name))
+		}
+	}
+	if minVersion == tls.VersionTLS13 && len(p.CipherSuites) > 0 {
+		errs = append(errs, errors.New("cipher suites cannot be configured for TLS 1.3"))
+	}
+	if _, err := cliflag.TLSCurvePreferences(p.curveIDs()); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// curveIDs returns the curve preferences as values of the --tls-curve-preferences flag.
+func (p TLSPolicy) curveIDs() []int32 {
+	ids := make([]int32, 0, len(p.CurvePreferences))
+	for _, curve := range p.CurvePreferences {
+		ids = append(ids, int32(curve))
+	}
+
+	return ids
+}
+
+// WithTLSPolicy restricts the TLS configuration of the secure port, e.g. to a preset:
+//
+//	policy, err := apiserver.TLSPolicyPreset(apiserver.TLSPresetFIPS)
+//	builder.WithTLSPolicy(policy)
+//
+// The policy sets the defaults of the --tls-min-version, --tls-cipher-suites and
+// --tls-curve-preferences flags, which take precedence.
+func (b *Builder) WithTLSPolicy(p TLSPolicy) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	p.CipherSuites = slices.Clone(p.CipherSuites)
+	p.CurvePreferences = slices.Clone(p.CurvePreferences)
+	b.tlsPolicy = &p
+
+	return b
+}
+
+// applyTLSPolicy sets the TLS policy as defaults of the secure serving options.
+func (c *completedConfig) applyTLSPolicy() {
+	if c.tlsPolicy == nil || c.recommendedOptions.SecureServing == nil {
+		return
+	}
+	s := c.recommendedOptions.SecureServing
+	s.MinTLSVersion = c.tlsPolicy.MinVersion
+	s.CipherSuites = slices.Clone(c.tlsPolicy.CipherSuites)
+	s.CurvePreferences = c.tlsPolicy.curveIDs()
+}
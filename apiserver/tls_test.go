@@ -0,0 +1,62 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package apiserver
+
+import (
+	"crypto/tls"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	basecompatibility "k8s.io/component-base/compatibility"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TLS policy", func() {
+	It("should provide valid presets", func() {
+		for _, name := range []string{TLSPresetModern, TLSPresetFIPS} {
+			policy, err := TLSPolicyPreset(name)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policy.Validate()).To(Succeed())
+		}
+		_, err := TLSPolicyPreset("legacy")
+		Expect(err).To(MatchError(ContainSubstring(`unknown TLS policy preset "legacy"`)))
+	})
+
+	It("should reject insecure and unknown settings", func() {
+		Expect(TLSPolicy{MinVersion: "VersionTLS11"}.Validate()).To(MatchError(ContainSubstring("at least VersionTLS12 is required")))
+		Expect(TLSPolicy{MinVersion: "VersionTLS14"}.Validate()).To(MatchError(ContainSubstring(`unknown tls version "VersionTLS14"`)))
+		Expect(TLSPolicy{CipherSuites: []string{"TLS_RSA_WITH_RC4_128_SHA"}}.Validate()).To(MatchError(ContainSubstring("TLS_RSA_WITH_RC4_128_SHA is insecure")))
+		Expect(TLSPolicy{MinVersion: "VersionTLS13", CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"}}.Validate()).
+			To(MatchError(ContainSubstring("cannot be configured for TLS 1.3")))
+		Expect(TLSPolicy{CurvePreferences: []tls.CurveID{0xffff}}.Validate()).To(MatchError(ContainSubstring("not supported")))
+	})
+
+	It("should set the defaults of the TLS flags", func() {
+		policy, err := TLSPolicyPreset(TLSPresetFIPS)
+		Expect(err).NotTo(HaveOccurred())
+		b := NewBuilder(runtime.NewScheme()).
+			WithComponentName("test").
+			WithGroupVersions(schema.GroupVersion{Group: "test.opendefense.cloud", Version: "v1"}).
+			WithTLSPolicy(policy)
+		b.componentGlobalsRegistry = basecompatibility.NewComponentGlobalsRegistry()
+		policy.CipherSuites[0] = "changed"
+		c, err := b.complete()
+		Expect(err).NotTo(HaveOccurred())
+
+		s := c.recommendedOptions.SecureServing
+		Expect(s.MinTLSVersion).To(Equal("VersionTLS12"))
+		Expect(s.CipherSuites).To(HaveLen(4))
+		Expect(s.CipherSuites).NotTo(ContainElement("changed"))
+		Expect(s.CurvePreferences).To(Equal([]int32{int32(tls.CurveP256), int32(tls.CurveP384)}))
+	})
+
+	It("should reject invalid policies", func() {
+		b := NewBuilder(runtime.NewScheme()).WithTLSPolicy(TLSPolicy{MinVersion: "VersionTLS10"})
+		b.componentGlobalsRegistry = basecompatibility.NewComponentGlobalsRegistry()
+		_, err := b.complete()
+		Expect(err).To(MatchError(ContainSubstring("invalid TLS policy")))
+	})
+})
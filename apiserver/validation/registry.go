@@ -0,0 +1,115 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Names of the rules registered in DefaultRegistry.
+const (
+	RuleDNS1123Subdomain         = "dns1123-subdomain"
+	RuleDNS1123Label             = "dns1123-label"
+	RuleFullyQualifiedDomainName = "fqdn"
+	RuleHostname                 = "hostname"
+	RuleURL                      = "url"
+	RuleHTTPURL                  = "http-url"
+	RuleLabelSelector            = "label-selector"
+	RuleDuration                 = "duration"
+	RuleQuantity                 = "quantity"
+)
+
+// DefaultRegistry contains the built-in rules. Additional rules can be registered by API packages.
+var DefaultRegistry = NewDefaultRegistry()
+
+// Registry holds named string validation rules, so rules can be referenced by name,
+// e.g. from configuration. It is safe for concurrent use.
+type Registry struct {
+	mu    sync.RWMutex
+	rules map[string]Func[string]
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{rules: map[string]Func[string]{}}
+}
+
+// NewDefaultRegistry returns a Registry containing the built-in rules.
+func NewDefaultRegistry() *Registry {
+	r := NewRegistry()
+	r.MustRegister(RuleDNS1123Subdomain, DNS1123Subdomain)
+	r.MustRegister(RuleDNS1123Label, DNS1123Label)
+	r.MustRegister(RuleFullyQualifiedDomainName, FullyQualifiedDomainName)
+	r.MustRegister(RuleHostname, Hostname)
+	r.MustRegister(RuleURL, URL())
+	r.MustRegister(RuleHTTPURL, URL("http", "https"))
+	r.MustRegister(RuleLabelSelector, LabelSelectorString)
+	r.MustRegister(RuleDuration, Duration(0, 0))
+	r.MustRegister(RuleQuantity, Quantity(nil, nil))
+
+	return r
+}
+
+// Register adds a rule. It returns an error if a rule with the same name exists.
+func (r *Registry) Register(name string, fn Func[string]) error {
+	if fn == nil {
+		return fmt.Errorf("validation rule %q must not be nil", name)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.rules[name]; ok {
+		return fmt.Errorf("validation rule %q is already registered", name)
+	}
+	r.rules[name] = fn
+
+	return nil
+}
+
+// MustRegister is like Register but panics on error.
+func (r *Registry) MustRegister(name string, fn Func[string]) {
+	if err := r.Register(name, fn); err != nil {
+		panic(err)
+	}
+}
+
+// Get returns the rule registered under name.
+func (r *Registry) Get(name string) (Func[string], bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	fn, ok := r.rules[name]
+
+	return fn, ok
+}
+
+// Names returns the sorted names of all registered rules.
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.rules))
+	for name := range r.rules {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// Validate runs the named rules against value. Unknown rules are reported as internal errors.
+func (r *Registry) Validate(value string, fldPath *field.Path, names ...string) field.ErrorList {
+	errs := field.ErrorList{}
+	for _, name := range names {
+		fn, ok := r.Get(name)
+		if !ok {
+			errs = append(errs, field.InternalError(fldPath, fmt.Errorf("unknown validation rule %q", name)))
+			continue
+		}
+		errs = append(errs, fn(value, fldPath)...)
+	}
+
+	return errs
+}
@@ -0,0 +1,214 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package validation provides reusable, composable validators for API types.
+// All validators return a field.ErrorList, so they can be used directly from
+// Validate and ValidateUpdate implementations.
+package validation
+
+import (
+	"cmp"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/labels"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Func validates a value and returns errors located at fldPath.
+type Func[T any] func(value T, fldPath *field.Path) field.ErrorList
+
+// All returns a validator running all given validators and collecting their errors.
+func All[T any](fns ...Func[T]) Func[T] {
+	return func(value T, fldPath *field.Path) field.ErrorList {
+		errs := field.ErrorList{}
+		for _, fn := range fns {
+			errs = append(errs, fn(value, fldPath)...)
+		}
+
+		return errs
+	}
+}
+
+// Optional returns a validator skipping fn for zero values.
+func Optional[T comparable](fn Func[T]) Func[T] {
+	return func(value T, fldPath *field.Path) field.ErrorList {
+		var zero T
+		if value == zero {
+			return nil
+		}
+
+		return fn(value, fldPath)
+	}
+}
+
+// Required returns a validator reporting zero values as missing and running fn otherwise.
+// fn may be nil if only presence is checked.
+func Required[T comparable](fn Func[T]) Func[T] {
+	return func(value T, fldPath *field.Path) field.ErrorList {
+		var zero T
+		if value == zero {
+			return field.ErrorList{field.Required(fldPath, "")}
+		}
+		if fn == nil {
+			return nil
+		}
+
+		return fn(value, fldPath)
+	}
+}
+
+// fromMessages converts the messages returned by the utilvalidation Is* functions.
+func fromMessages(value any, fldPath *field.Path, msgs []string) field.ErrorList {
+	errs := field.ErrorList{}
+	for _, msg := range msgs {
+		errs = append(errs, field.Invalid(fldPath, value, msg))
+	}
+
+	return errs
+}
+
+// DNS1123Subdomain validates that value is a DNS-1123 subdomain, as used for most object names.
+func DNS1123Subdomain(value string, fldPath *field.Path) field.ErrorList {
+	return fromMessages(value, fldPath, utilvalidation.IsDNS1123Subdomain(value))
+}
+
+// DNS1123Label validates that value is a DNS-1123 label, as used for namespace names.
+func DNS1123Label(value string, fldPath *field.Path) field.ErrorList {
+	return fromMessages(value, fldPath, utilvalidation.IsDNS1123Label(value))
+}
+
+// FullyQualifiedDomainName validates that value is a fully qualified domain name with at least two segments.
+func FullyQualifiedDomainName(value string, fldPath *field.Path) field.ErrorList {
+	return utilvalidation.IsFullyQualifiedDomainName(fldPath, value)
+}
+
+// Hostname validates that value is a RFC 1123 hostname.
+func Hostname(value string, fldPath *field.Path) field.ErrorList {
+	return DNS1123Subdomain(value, fldPath)
+}
+
+// URL returns a validator checking that value is an absolute URL with a host.
+// If schemes are given, the URL scheme must be one of them.
+func URL(schemes ...string) Func[string] {
+	return func(value string, fldPath *field.Path) field.ErrorList {
+		u, err := url.Parse(value)
+		if err != nil {
+			return field.ErrorList{field.Invalid(fldPath, value, err.Error())}
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return field.ErrorList{field.Invalid(fldPath, value, "must be an absolute URL with scheme and host")}
+		}
+		if len(schemes) > 0 && !slices.Contains(schemes, u.Scheme) {
+			return field.ErrorList{field.NotSupported(fldPath.Child("scheme"), u.Scheme, schemes)}
+		}
+
+		return nil
+	}
+}
+
+// LabelSelector validates a structured label selector.
+func LabelSelector(selector *metav1.LabelSelector, fldPath *field.Path) field.ErrorList {
+	return metav1validation.ValidateLabelSelector(selector, metav1validation.LabelSelectorValidationOptions{}, fldPath)
+}
+
+// LabelSelectorString validates a label selector in its string form, e.g. "app=foo,tier!=db".
+func LabelSelectorString(value string, fldPath *field.Path) field.ErrorList {
+	if _, err := labels.Parse(value); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, value, err.Error())}
+	}
+
+	return nil
+}
+
+// InRange returns a validator checking that value is within [minValue, maxValue].
+func InRange[T cmp.Ordered](minValue, maxValue T) Func[T] {
+	return func(value T, fldPath *field.Path) field.ErrorList {
+		if value < minValue || value > maxValue {
+			return field.ErrorList{field.Invalid(fldPath, value, fmt.Sprintf("must be between %v and %v, inclusive", minValue, maxValue))}
+		}
+
+		return nil
+	}
+}
+
+// OneOf returns a validator checking that value is one of the allowed values.
+func OneOf[T comparable](allowed ...T) Func[T] {
+	return func(value T, fldPath *field.Path) field.ErrorList {
+		if slices.Contains(allowed, value) {
+			return nil
+		}
+		allowedStr := make([]string, 0, len(allowed))
+		for _, a := range allowed {
+			allowedStr = append(allowedStr, fmt.Sprint(a))
+		}
+
+		return field.ErrorList{field.NotSupported(fldPath, value, allowedStr)}
+	}
+}
+
+// DurationRange returns a validator checking that a duration is within [minValue, maxValue].
+// A zero bound is not enforced.
+func DurationRange(minValue, maxValue time.Duration) Func[time.Duration] {
+	return func(value time.Duration, fldPath *field.Path) field.ErrorList {
+		if minValue != 0 && value < minValue {
+			return field.ErrorList{field.Invalid(fldPath, value.String(), fmt.Sprintf("must be at least %s", minValue))}
+		}
+		if maxValue != 0 && value > maxValue {
+			return field.ErrorList{field.Invalid(fldPath, value.String(), fmt.Sprintf("must be at most %s", maxValue))}
+		}
+
+		return nil
+	}
+}
+
+// Duration returns a validator checking that value is a Go duration string within [minValue, maxValue].
+// A zero bound is not enforced.
+func Duration(minValue, maxValue time.Duration) Func[string] {
+	inRange := DurationRange(minValue, maxValue)
+
+	return func(value string, fldPath *field.Path) field.ErrorList {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return field.ErrorList{field.Invalid(fldPath, value, err.Error())}
+		}
+
+		return inRange(d, fldPath)
+	}
+}
+
+// QuantityRange returns a validator checking that a quantity is within [minValue, maxValue].
+// A nil bound is not enforced.
+func QuantityRange(minValue, maxValue *resource.Quantity) Func[resource.Quantity] {
+	return func(value resource.Quantity, fldPath *field.Path) field.ErrorList {
+		if minValue != nil && value.Cmp(*minValue) < 0 {
+			return field.ErrorList{field.Invalid(fldPath, value.String(), fmt.Sprintf("must be greater than or equal to %s", minValue))}
+		}
+		if maxValue != nil && value.Cmp(*maxValue) > 0 {
+			return field.ErrorList{field.Invalid(fldPath, value.String(), fmt.Sprintf("must be less than or equal to %s", maxValue))}
+		}
+
+		return nil
+	}
+}
+
+// Quantity returns a validator checking that value is a quantity string within [minValue, maxValue].
+// A nil bound is not enforced.
+func Quantity(minValue, maxValue *resource.Quantity) Func[string] {
+	inRange := QuantityRange(minValue, maxValue)
+
+	return func(value string, fldPath *field.Path) field.ErrorList {
+		q, err := resource.ParseQuantity(value)
+		if err != nil {
+			return field.ErrorList{field.Invalid(fldPath, value, err.Error())}
+		}
+
+		return inRange(q, fldPath)
+	}
+}
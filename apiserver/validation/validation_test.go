@@ -0,0 +1,116 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Validators", func() {
+	fldPath := field.NewPath("spec", "value")
+
+	DescribeTable("string validators",
+		func(fn Func[string], value string, valid bool) {
+			errs := fn(value, fldPath)
+			if valid {
+				Expect(errs).To(BeEmpty())
+			} else {
+				Expect(errs).NotTo(BeEmpty())
+				Expect(errs[0].Field).To(HavePrefix(fldPath.String()))
+			}
+		},
+		Entry("subdomain", Func[string](DNS1123Subdomain), "foo.bar", true),
+		Entry("invalid subdomain", Func[string](DNS1123Subdomain), "Foo_bar", false),
+		Entry("label", Func[string](DNS1123Label), "foo-1", true),
+		Entry("label with dot", Func[string](DNS1123Label), "foo.bar", false),
+		Entry("fqdn", Func[string](FullyQualifiedDomainName), "example.com", true),
+		Entry("fqdn single segment", Func[string](FullyQualifiedDomainName), "localhost", false),
+		Entry("hostname", Func[string](Hostname), "api.example.com", true),
+		Entry("url", URL(), "oci://registry.example.com/repo", true),
+		Entry("url without host", URL(), "/relative/path", false),
+		Entry("url with allowed scheme", URL("https"), "https://example.com", true),
+		Entry("url with other scheme", URL("https"), "http://example.com", false),
+		Entry("selector", Func[string](LabelSelectorString), "app=foo,tier!=db", true),
+		Entry("invalid selector", Func[string](LabelSelectorString), "app in (foo", false),
+		Entry("duration", Duration(time.Second, time.Hour), "5m", true),
+		Entry("duration too short", Duration(time.Second, time.Hour), "10ms", false),
+		Entry("duration too long", Duration(time.Second, time.Hour), "2h", false),
+		Entry("unparsable duration", Duration(0, 0), "five", false),
+		Entry("quantity", Quantity(new(resource.MustParse("1Mi")), nil), "1Gi", true),
+		Entry("quantity too small", Quantity(new(resource.MustParse("1Mi")), nil), "1Ki", false),
+		Entry("unparsable quantity", Quantity(nil, nil), "lots", false),
+		Entry("one of", OneOf("a", "b"), "b", true),
+		Entry("not one of", OneOf("a", "b"), "c", false),
+	)
+
+	It("should validate quantity ranges", func() {
+		fn := QuantityRange(new(resource.MustParse("100m")), new(resource.MustParse("2")))
+		Expect(fn(resource.MustParse("1"), fldPath)).To(BeEmpty())
+		Expect(fn(resource.MustParse("3"), fldPath)).To(HaveLen(1))
+	})
+
+	It("should validate ordered ranges", func() {
+		fn := InRange(1, 10)
+		Expect(fn(5, fldPath)).To(BeEmpty())
+		Expect(fn(11, fldPath)).To(HaveLen(1))
+	})
+
+	It("should validate structured label selectors", func() {
+		valid := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}
+		Expect(LabelSelector(valid, fldPath)).To(BeEmpty())
+
+		invalid := &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Bogus"}}}
+		Expect(LabelSelector(invalid, fldPath)).NotTo(BeEmpty())
+	})
+
+	It("should compose validators", func() {
+		fn := All(DNS1123Label, OneOf("foo", "bar"))
+		Expect(fn("foo", fldPath)).To(BeEmpty())
+		Expect(fn("Baz", fldPath)).To(HaveLen(2))
+	})
+
+	It("should skip zero values when optional", func() {
+		fn := Optional(DNS1123Label)
+		Expect(fn("", fldPath)).To(BeEmpty())
+		Expect(fn("Foo", fldPath)).NotTo(BeEmpty())
+	})
+
+	It("should report zero values when required", func() {
+		errs := Required[string](nil)("", fldPath)
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Type).To(Equal(field.ErrorTypeRequired))
+		Expect(Required(DNS1123Label)("foo", fldPath)).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Registry", func() {
+	fldPath := field.NewPath("spec", "host")
+
+	It("should contain the built-in rules", func() {
+		Expect(DefaultRegistry.Names()).To(ContainElements(RuleHostname, RuleURL, RuleDuration, RuleQuantity))
+		Expect(DefaultRegistry.Validate("example.com", fldPath, RuleHostname, RuleFullyQualifiedDomainName)).To(BeEmpty())
+		Expect(DefaultRegistry.Validate("ftp://example.com", fldPath, RuleHTTPURL)).To(HaveLen(1))
+	})
+
+	It("should register custom rules", func() {
+		r := NewRegistry()
+		Expect(r.Register("lower", OneOf("a", "b"))).To(Succeed())
+		Expect(r.Register("lower", OneOf("c"))).NotTo(Succeed())
+		Expect(r.Register("nil", nil)).NotTo(Succeed())
+		Expect(r.Validate("c", fldPath, "lower")).To(HaveLen(1))
+	})
+
+	It("should report unknown rules as internal errors", func() {
+		errs := NewRegistry().Validate("x", fldPath, "unknown")
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Type).To(Equal(field.ErrorTypeInternal))
+	})
+})
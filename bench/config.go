@@ -0,0 +1,194 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package bench
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Op is a single kind of request issued against a resource.
+type Op string
+
+const (
+	OpCreate Op = "create"
+	OpGet    Op = "get"
+	OpList   Op = "list"
+	OpUpdate Op = "update"
+	OpDelete Op = "delete"
+	OpWatch  Op = "watch"
+)
+
+// AllOps lists every supported operation in a stable order.
+var AllOps = []Op{OpCreate, OpGet, OpList, OpUpdate, OpDelete, OpWatch}
+
+// DefaultOpMix is used when no explicit operation mix is configured.
+var DefaultOpMix = map[Op]int{
+	OpCreate: 2,
+	OpGet:    4,
+	OpList:   2,
+	OpUpdate: 2,
+	OpDelete: 1,
+	OpWatch:  1,
+}
+
+// DefaultWatchDuration is how long a watch reads events unless configured otherwise.
+const DefaultWatchDuration = 10 * time.Second
+
+// Target describes a resource that load is generated against.
+type Target struct {
+	// Resource is the group version resource, e.g. bars.v1alpha1.foo.opendefense.cloud.
+	Resource schema.GroupVersionResource
+	// Weight is the relative share of requests sent to this resource.
+	Weight int
+}
+
+// Stage is a step of a concurrency ramp.
+type Stage struct {
+	// Concurrency is the number of workers issuing requests in parallel.
+	Concurrency int
+	// Duration is how long the stage lasts.
+	Duration time.Duration
+}
+
+// Config holds the parameters of a benchmark run.
+type Config struct {
+	// Targets lists the resources to generate load against.
+	Targets []Target
+	// OpMix is the relative weight of each operation.
+	OpMix map[Op]int
+	// Stages defines the concurrency ramp; stages are executed in order.
+	Stages []Stage
+	// Namespace is used for namespaced resources.
+	Namespace string
+	// PayloadField is the dot separated path of the string field filled with payload.
+	PayloadField string
+	// PayloadSize is the number of bytes written to PayloadField.
+	PayloadSize int
+	// WatchDuration is how long each watch reads events before it is closed.
+	// Defaults to DefaultWatchDuration.
+	WatchDuration time.Duration
+	// Cleanup deletes all objects created during the run when it finishes.
+	Cleanup bool
+}
+
+// Validate checks the configuration for consistency.
+func (c *Config) Validate() error {
+	if len(c.Targets) == 0 {
+		return fmt.Errorf("at least one target resource is required")
+	}
+	for _, t := range c.Targets {
+		if t.Weight <= 0 {
+			return fmt.Errorf("target %s: weight must be positive", t.Resource)
+		}
+	}
+	if len(c.Stages) == 0 {
+		return fmt.Errorf("at least one stage is required")
+	}
+	for i, s := range c.Stages {
+		if s.Concurrency <= 0 || s.Duration <= 0 {
+			return fmt.Errorf("stage %d: concurrency and duration must be positive", i)
+		}
+	}
+	total := 0
+	for op, w := range c.OpMix {
+		if !slices.Contains(AllOps, op) {
+			return fmt.Errorf("unknown operation %q", op)
+		}
+		if w < 0 {
+			return fmt.Errorf("operation %q: weight must not be negative", op)
+		}
+		total += w
+	}
+	if total == 0 {
+		return fmt.Errorf("operation mix must contain at least one positive weight")
+	}
+	if c.PayloadSize < 0 {
+		return fmt.Errorf("payload size must not be negative")
+	}
+	if c.WatchDuration < 0 {
+		return fmt.Errorf("watch duration must not be negative")
+	}
+	if c.PayloadSize > 0 && c.PayloadField == "" {
+		return fmt.Errorf("payload field is required when payload size is set")
+	}
+
+	return nil
+}
+
+// ParseTarget parses a target of the form "resource.version.group[=weight]".
+func ParseTarget(s string) (Target, error) {
+	arg, weightStr, hasWeight := strings.Cut(s, "=")
+	gvr, _ := schema.ParseResourceArg(arg)
+	if gvr == nil {
+		return Target{}, fmt.Errorf("invalid resource %q: expected resource.version.group", arg)
+	}
+	t := Target{Resource: *gvr, Weight: 1}
+	if hasWeight {
+		w, err := strconv.Atoi(weightStr)
+		if err != nil {
+			return Target{}, fmt.Errorf("invalid weight for resource %q: %w", arg, err)
+		}
+		t.Weight = w
+	}
+
+	return t, nil
+}
+
+// ParseOpMix parses an operation mix of the form "create=1,get=4,list=2".
+func ParseOpMix(s string) (map[Op]int, error) {
+	mix := map[Op]int{}
+	for part := range strings.SplitSeq(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, weightStr, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid operation weight %q: expected op=weight", part)
+		}
+		op := Op(name)
+		if !slices.Contains(AllOps, op) {
+			return nil, fmt.Errorf("unknown operation %q", name)
+		}
+		w, err := strconv.Atoi(weightStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight for operation %q: %w", name, err)
+		}
+		mix[op] = w
+	}
+
+	return mix, nil
+}
+
+// ParseStages parses a concurrency ramp of the form "10:30s,50:1m".
+func ParseStages(s string) ([]Stage, error) {
+	stages := []Stage{}
+	for part := range strings.SplitSeq(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		concStr, durStr, ok := strings.Cut(part, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid stage %q: expected concurrency:duration", part)
+		}
+		conc, err := strconv.Atoi(concStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid concurrency in stage %q: %w", part, err)
+		}
+		dur, err := time.ParseDuration(durStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration in stage %q: %w", part, err)
+		}
+		stages = append(stages, Stage{Concurrency: conc, Duration: dur})
+	}
+
+	return stages, nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package bench
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config", func() {
+	validConfig := func() Config {
+		return Config{
+			Targets: []Target{{Resource: schema.GroupVersionResource{Group: "foo.opendefense.cloud", Version: "v1alpha1", Resource: "bars"}, Weight: 1}},
+			OpMix:   DefaultOpMix,
+			Stages:  []Stage{{Concurrency: 1, Duration: time.Second}},
+		}
+	}
+
+	It("should accept a valid configuration", func() {
+		c := validConfig()
+		Expect(c.Validate()).To(Succeed())
+	})
+
+	It("should require targets and stages", func() {
+		c := validConfig()
+		c.Targets = nil
+		Expect(c.Validate()).To(MatchError(ContainSubstring("target")))
+
+		c = validConfig()
+		c.Stages = nil
+		Expect(c.Validate()).To(MatchError(ContainSubstring("stage")))
+	})
+
+	It("should reject an operation mix without positive weights", func() {
+		c := validConfig()
+		c.OpMix = map[Op]int{OpGet: 0}
+		Expect(c.Validate()).To(HaveOccurred())
+	})
+
+	It("should reject a negative watch duration", func() {
+		c := validConfig()
+		c.WatchDuration = -time.Second
+		Expect(c.Validate()).To(MatchError(ContainSubstring("watch duration")))
+	})
+
+	It("should require a payload field when a payload size is set", func() {
+		c := validConfig()
+		c.PayloadSize = 10
+		Expect(c.Validate()).To(MatchError(ContainSubstring("payload field")))
+	})
+})
+
+var _ = Describe("ParseTarget", func() {
+	It("should parse a resource with weight", func() {
+		t, err := ParseTarget("bars.v1alpha1.foo.opendefense.cloud=3")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(t.Resource).To(Equal(schema.GroupVersionResource{Group: "foo.opendefense.cloud", Version: "v1alpha1", Resource: "bars"}))
+		Expect(t.Weight).To(Equal(3))
+	})
+
+	It("should default the weight to one", func() {
+		t, err := ParseTarget("bars.v1alpha1.foo.opendefense.cloud")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(t.Weight).To(Equal(1))
+	})
+
+	It("should reject a resource without version and group", func() {
+		_, err := ParseTarget("bars")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("ParseOpMix", func() {
+	It("should parse operation weights", func() {
+		mix, err := ParseOpMix("create=1, get=4,watch=0")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(mix).To(Equal(map[Op]int{OpCreate: 1, OpGet: 4, OpWatch: 0}))
+	})
+
+	It("should reject unknown operations", func() {
+		_, err := ParseOpMix("patch=1")
+		Expect(err).To(MatchError(ContainSubstring("unknown operation")))
+	})
+})
+
+var _ = Describe("ParseStages", func() {
+	It("should parse a concurrency ramp", func() {
+		stages, err := ParseStages("10:30s,50:1m")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stages).To(Equal([]Stage{
+			{Concurrency: 10, Duration: 30 * time.Second},
+			{Concurrency: 50, Duration: time.Minute},
+		}))
+	})
+
+	It("should reject malformed stages", func() {
+		_, err := ParseStages("10")
+		Expect(err).To(HaveOccurred())
+		_, err = ParseStages("ten:1s")
+		Expect(err).To(HaveOccurred())
+	})
+})
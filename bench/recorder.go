@@ -0,0 +1,134 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package bench
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+type sampleKey struct {
+	resource schema.GroupResource
+	op       Op
+}
+
+type samples struct {
+	latencies []time.Duration
+	errors    int
+}
+
+// Recorder collects request latencies per resource and operation.
+// It is safe for concurrent use.
+type Recorder struct {
+	mu      sync.Mutex
+	samples map[sampleKey]*samples
+}
+
+// NewRecorder returns an empty Recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{samples: map[sampleKey]*samples{}}
+}
+
+// Observe records the outcome of a single request.
+func (r *Recorder) Observe(gr schema.GroupResource, op Op, latency time.Duration, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := sampleKey{resource: gr, op: op}
+	s, ok := r.samples[key]
+	if !ok {
+		s = &samples{}
+		r.samples[key] = s
+	}
+	if err != nil {
+		s.errors++
+		return
+	}
+	s.latencies = append(s.latencies, latency)
+}
+
+// Summary holds aggregated statistics for a resource and operation.
+type Summary struct {
+	Resource schema.GroupResource
+	Op       Op
+	Count    int
+	Errors   int
+	P50      time.Duration
+	P90      time.Duration
+	P99      time.Duration
+	Max      time.Duration
+}
+
+// Summaries returns the aggregated statistics sorted by resource and operation.
+func (r *Recorder) Summaries() []Summary {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	summaries := make([]Summary, 0, len(r.samples))
+	for key, s := range r.samples {
+		sorted := slices.Clone(s.latencies)
+		slices.Sort(sorted)
+		summary := Summary{
+			Resource: key.resource,
+			Op:       key.op,
+			Count:    len(sorted),
+			Errors:   s.errors,
+			P50:      Percentile(sorted, 50),
+			P90:      Percentile(sorted, 90),
+			P99:      Percentile(sorted, 99),
+		}
+		if len(sorted) > 0 {
+			summary.Max = sorted[len(sorted)-1]
+		}
+		summaries = append(summaries, summary)
+	}
+	slices.SortFunc(summaries, func(a, b Summary) int {
+		if a.Resource != b.Resource {
+			if a.Resource.String() < b.Resource.String() {
+				return -1
+			}
+			return 1
+		}
+		return slices.Index(AllOps, a.Op) - slices.Index(AllOps, b.Op)
+	})
+
+	return summaries
+}
+
+// Percentile returns the p-th percentile of the sorted latencies using the
+// nearest-rank method. It returns zero for an empty slice.
+func Percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p/100*float64(len(sorted)))) - 1
+	rank = max(0, min(rank, len(sorted)-1))
+
+	return sorted[rank]
+}
+
+// WriteReport prints the recorded statistics as a table.
+func (r *Recorder) WriteReport(w io.Writer, elapsed time.Duration) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "RESOURCE\tOP\tCOUNT\tERRORS\tRPS\tP50\tP90\tP99\tMAX")
+	for _, s := range r.Summaries() {
+		rps := 0.0
+		if elapsed > 0 {
+			rps = float64(s.Count+s.Errors) / elapsed.Seconds()
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%.1f\t%s\t%s\t%s\t%s\n",
+			s.Resource, s.Op, s.Count, s.Errors, rps,
+			s.P50.Round(time.Microsecond), s.P90.Round(time.Microsecond),
+			s.P99.Round(time.Microsecond), s.Max.Round(time.Microsecond))
+	}
+
+	return tw.Flush()
+}
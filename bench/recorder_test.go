@@ -0,0 +1,62 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package bench
+
+import (
+	"bytes"
+	"errors"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Percentile", func() {
+	It("should return zero for no samples", func() {
+		Expect(Percentile(nil, 50)).To(BeZero())
+	})
+
+	It("should use the nearest rank", func() {
+		sorted := []time.Duration{}
+		for i := 1; i <= 100; i++ {
+			sorted = append(sorted, time.Duration(i)*time.Millisecond)
+		}
+		Expect(Percentile(sorted, 50)).To(Equal(50 * time.Millisecond))
+		Expect(Percentile(sorted, 99)).To(Equal(99 * time.Millisecond))
+		Expect(Percentile(sorted, 100)).To(Equal(100 * time.Millisecond))
+		Expect(Percentile(sorted[:60], 99)).To(Equal(60 * time.Millisecond))
+		Expect(Percentile(sorted[:60], 50)).To(Equal(30 * time.Millisecond))
+	})
+})
+
+var _ = Describe("Recorder", func() {
+	gr := schema.GroupResource{Group: "foo.opendefense.cloud", Resource: "bars"}
+
+	It("should aggregate samples per resource and operation", func() {
+		r := NewRecorder()
+		r.Observe(gr, OpGet, 2*time.Millisecond, nil)
+		r.Observe(gr, OpGet, 4*time.Millisecond, nil)
+		r.Observe(gr, OpGet, 0, errors.New("boom"))
+		r.Observe(gr, OpCreate, time.Millisecond, nil)
+
+		summaries := r.Summaries()
+		Expect(summaries).To(HaveLen(2))
+		Expect(summaries[0].Op).To(Equal(OpCreate))
+		Expect(summaries[1].Op).To(Equal(OpGet))
+		Expect(summaries[1].Count).To(Equal(2))
+		Expect(summaries[1].Errors).To(Equal(1))
+		Expect(summaries[1].Max).To(Equal(4 * time.Millisecond))
+	})
+
+	It("should write a report table", func() {
+		r := NewRecorder()
+		r.Observe(gr, OpList, time.Millisecond, nil)
+		buf := &bytes.Buffer{}
+		Expect(r.WriteReport(buf, time.Second)).To(Succeed())
+		Expect(buf.String()).To(ContainSubstring("bars.foo.opendefense.cloud"))
+		Expect(buf.String()).To(ContainSubstring("list"))
+	})
+})
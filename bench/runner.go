@@ -0,0 +1,320 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package bench
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+)
+
+// RunLabel is set on every object created by a benchmark run.
+const RunLabel = "bench.opendefense.cloud/run"
+
+// Runner generates load against the configured targets.
+type Runner struct {
+	config    Config
+	dynamic   dynamic.Interface
+	discovery discovery.DiscoveryInterface
+	recorder  *Recorder
+	runID     string
+	targets   []*target
+}
+
+// target is a resolved Target with the objects created for it during the run.
+type target struct {
+	Target
+	kind       string
+	namespaced bool
+
+	mu    sync.Mutex
+	names []string
+}
+
+// NewRunner creates a Runner using the given client configuration.
+func NewRunner(restConfig *rest.Config, config Config) (*Runner, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	dyn, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	disc, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Runner{
+		config:    config,
+		dynamic:   dyn,
+		discovery: disc,
+		recorder:  NewRecorder(),
+		runID:     utilrand.String(8),
+	}, nil
+}
+
+// Run executes all configured stages and returns the recorded statistics.
+func (r *Runner) Run(ctx context.Context) (*Recorder, error) {
+	if err := r.resolveTargets(); err != nil {
+		return nil, err
+	}
+
+	if r.config.Cleanup {
+		defer func() {
+			// Use a fresh context so objects are removed even after cancellation.
+			cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Minute)
+			defer cancel()
+			r.cleanup(cleanupCtx)
+		}()
+	}
+
+	for _, stage := range r.config.Stages {
+		if err := r.runStage(ctx, stage); err != nil {
+			return r.recorder, err
+		}
+	}
+
+	return r.recorder, nil
+}
+
+// resolveTargets looks up kind and scope of every target via discovery.
+func (r *Runner) resolveTargets() error {
+	r.targets = make([]*target, 0, len(r.config.Targets))
+	for _, t := range r.config.Targets {
+		gv := t.Resource.GroupVersion()
+		list, err := r.discovery.ServerResourcesForGroupVersion(gv.String())
+		if err != nil {
+			return fmt.Errorf("discovering %s: %w", gv, err)
+		}
+		idx := slices.IndexFunc(list.APIResources, func(res metav1.APIResource) bool {
+			return res.Name == t.Resource.Resource
+		})
+		if idx < 0 {
+			return fmt.Errorf("resource %s is not served", t.Resource)
+		}
+		res := list.APIResources[idx]
+		r.targets = append(r.targets, &target{Target: t, kind: res.Kind, namespaced: res.Namespaced})
+	}
+
+	return nil
+}
+
+// runStage runs the given number of workers until the stage duration elapses.
+func (r *Runner) runStage(ctx context.Context, stage Stage) error {
+	stageCtx, cancel := context.WithTimeout(ctx, stage.Duration)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for range stage.Concurrency {
+		wg.Go(func() {
+			for stageCtx.Err() == nil {
+				t := pickWeighted(r.targets, func(t *target) int { return t.Weight })
+				op := pickWeighted(AllOps, func(op Op) int { return r.config.OpMix[op] })
+				latency, err := r.do(stageCtx, t, op)
+				if stageCtx.Err() != nil {
+					// Requests aborted by the end of the stage are not representative.
+					return
+				}
+				r.recorder.Observe(t.Resource.GroupResource(), op, latency, err)
+			}
+		})
+	}
+	wg.Wait()
+
+	return ctx.Err()
+}
+
+func (r *Runner) client(t *target) dynamic.ResourceInterface {
+	if t.namespaced {
+		return r.dynamic.Resource(t.Resource).Namespace(r.config.Namespace)
+	}
+
+	return r.dynamic.Resource(t.Resource)
+}
+
+// do issues a single request and returns its latency. Operations that require
+// an existing object fall back to create when no object is available yet.
+func (r *Runner) do(ctx context.Context, t *target, op Op) (time.Duration, error) {
+	if op == OpWatch {
+		return r.watch(ctx, t)
+	}
+	start := time.Now()
+	err := r.request(ctx, t, op)
+
+	return time.Since(start), err
+}
+
+func (r *Runner) request(ctx context.Context, t *target, op Op) error {
+	c := r.client(t)
+	switch op {
+	case OpGet:
+		name, ok := t.pick(false)
+		if !ok {
+			return r.create(ctx, t)
+		}
+		_, err := c.Get(ctx, name, metav1.GetOptions{})
+
+		return err
+	case OpList:
+		_, err := c.List(ctx, metav1.ListOptions{LabelSelector: r.selector()})
+		return err
+	case OpUpdate:
+		name, ok := t.pick(false)
+		if !ok {
+			return r.create(ctx, t)
+		}
+		obj, err := c.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if err := r.setPayload(obj); err != nil {
+			return err
+		}
+		_, err = c.Update(ctx, obj, metav1.UpdateOptions{})
+
+		return err
+	case OpDelete:
+		name, ok := t.pick(true)
+		if !ok {
+			return r.create(ctx, t)
+		}
+
+		return c.Delete(ctx, name, metav1.DeleteOptions{})
+	default:
+		return r.create(ctx, t)
+	}
+}
+
+// watch opens a watch on the objects of the run and reads its events for the
+// WatchDuration, so watches stay open while the other operations are running.
+// The returned latency is the time until the watch has been established.
+func (r *Runner) watch(ctx context.Context, t *target) (time.Duration, error) {
+	start := time.Now()
+	w, err := r.client(t).Watch(ctx, metav1.ListOptions{LabelSelector: r.selector()})
+	latency := time.Since(start)
+	if err != nil {
+		return latency, err
+	}
+	defer w.Stop()
+
+	duration := r.config.WatchDuration
+	if duration == 0 {
+		duration = DefaultWatchDuration
+	}
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	for {
+		select {
+		case _, ok := <-w.ResultChan():
+			if !ok {
+				// The server closed the watch early.
+				return latency, nil
+			}
+		case <-timer.C:
+			return latency, nil
+		case <-ctx.Done():
+			return latency, nil
+		}
+	}
+}
+
+func (r *Runner) create(ctx context.Context, t *target) error {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(t.Resource.GroupVersion().String())
+	obj.SetKind(t.kind)
+	obj.SetGenerateName("kit-bench-")
+	obj.SetLabels(map[string]string{RunLabel: r.runID})
+	if err := r.setPayload(obj); err != nil {
+		return err
+	}
+	created, err := r.client(t).Create(ctx, obj, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+	t.add(created.GetName())
+
+	return nil
+}
+
+func (r *Runner) setPayload(obj *unstructured.Unstructured) error {
+	if r.config.PayloadSize == 0 {
+		return nil
+	}
+	fields := strings.Split(r.config.PayloadField, ".")
+
+	return unstructured.SetNestedField(obj.Object, utilrand.String(r.config.PayloadSize), fields...)
+}
+
+func (r *Runner) selector() string {
+	return RunLabel + "=" + r.runID
+}
+
+// cleanup deletes all objects that are still tracked.
+func (r *Runner) cleanup(ctx context.Context) {
+	for _, t := range r.targets {
+		for {
+			name, ok := t.pick(true)
+			if !ok {
+				break
+			}
+			_ = r.client(t).Delete(ctx, name, metav1.DeleteOptions{})
+			if ctx.Err() != nil {
+				return
+			}
+		}
+	}
+}
+
+func (t *target) add(name string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.names = append(t.names, name)
+}
+
+// pick returns a random tracked object name, optionally removing it.
+func (t *target) pick(remove bool) (string, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.names) == 0 {
+		return "", false
+	}
+	i := rand.IntN(len(t.names)) //nolint:gosec // load distribution only
+	name := t.names[i]
+	if remove {
+		t.names[i] = t.names[len(t.names)-1]
+		t.names = t.names[:len(t.names)-1]
+	}
+
+	return name, true
+}
+
+// pickWeighted returns a random item, where each item is chosen with a
+// probability proportional to its weight.
+func pickWeighted[T any](items []T, weight func(T) int) T {
+	total := 0
+	for _, item := range items {
+		total += max(0, weight(item))
+	}
+	n := rand.IntN(max(total, 1)) //nolint:gosec // load distribution only
+	for _, item := range items {
+		n -= max(0, weight(item))
+		if n < 0 {
+			return item
+		}
+	}
+
+	return items[len(items)-1]
+}
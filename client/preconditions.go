@@ -0,0 +1,114 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package client provides helpers for controller-runtime clients of servers built with the kit.
+package client
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Preconditions must be fulfilled by the stored object for a write to be carried out, so
+// controllers do not act on stale objects. Unset fields are not checked.
+type Preconditions struct {
+	UID             *types.UID
+	ResourceVersion *string
+	// Generation is checked against the object read right before the write, which is then
+	// carried out with the resourceVersion read, so it fails if the object changed in between.
+	Generation *int64
+}
+
+// PreconditionsFor returns the preconditions matching the UID, resourceVersion and generation
+// of obj, i.e. writes with them fail if obj has been changed since it has been read.
+func PreconditionsFor(obj client.Object) Preconditions {
+	uid, resourceVersion, generation := obj.GetUID(), obj.GetResourceVersion(), obj.GetGeneration()
+
+	return Preconditions{UID: &uid, ResourceVersion: &resourceVersion, Generation: &generation}
+}
+
+// Delete deletes obj if the stored object fulfills p. It fails with a Conflict status otherwise.
+func Delete(ctx context.Context, c client.Client, obj client.Object, p Preconditions, opts ...client.DeleteOption) error {
+	p, err := resolveGeneration(ctx, c, obj, p)
+	if err != nil {
+		return err
+	}
+
+	return c.Delete(ctx, obj, append(opts, client.Preconditions{UID: p.UID, ResourceVersion: p.ResourceVersion})...)
+}
+
+// Update updates obj if the stored object fulfills p. It fails with a Conflict status otherwise.
+// The UID and resourceVersion of obj are replaced by the ones of p, if set.
+func Update(ctx context.Context, c client.Client, obj client.Object, p Preconditions, opts ...client.UpdateOption) error {
+	p, err := resolveGeneration(ctx, c, obj, p)
+	if err != nil {
+		return err
+	}
+	// The server checks the UID and resourceVersion of the object against the stored one.
+	if p.UID != nil {
+		obj.SetUID(*p.UID)
+	}
+	if p.ResourceVersion != nil {
+		obj.SetResourceVersion(*p.ResourceVersion)
+	}
+
+	return c.Update(ctx, obj, opts...)
+}
+
+// resolveGeneration checks p against the stored object if p has a generation, which the server
+// cannot check, and returns the preconditions on its UID and resourceVersion.
+func resolveGeneration(ctx context.Context, c client.Client, obj client.Object, p Preconditions) (Preconditions, error) {
+	if p.Generation == nil {
+		return p, nil
+	}
+	current, ok := obj.DeepCopyObject().(client.Object)
+	if !ok {
+		return p, fmt.Errorf("copy of %T is not a client.Object", obj)
+	}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(obj), current); err != nil {
+		return p, err
+	}
+	var reason string
+	switch {
+	case p.UID != nil && *p.UID != current.GetUID():
+		reason = fmt.Sprintf("UID in precondition: %v, UID in object meta: %v", *p.UID, current.GetUID())
+	case p.ResourceVersion != nil && *p.ResourceVersion != current.GetResourceVersion():
+		reason = fmt.Sprintf("ResourceVersion in precondition: %v, ResourceVersion in object meta: %v", *p.ResourceVersion, current.GetResourceVersion())
+	case *p.Generation != current.GetGeneration():
+		reason = fmt.Sprintf("Generation in precondition: %v, Generation in object meta: %v", *p.Generation, current.GetGeneration())
+	}
+	if reason != "" {
+		return p, conflict(c, obj, reason)
+	}
+	uid, resourceVersion := current.GetUID(), current.GetResourceVersion()
+
+	return Preconditions{UID: &uid, ResourceVersion: &resourceVersion, Generation: p.Generation}, nil
+}
+
+// conflict returns the Conflict status the server returns for failed preconditions. The resource
+// only shapes the message, so it is guessed from the kind if the RESTMapper doesn't know it.
+func conflict(c client.Client, obj client.Object, reason string) error {
+	return apierrors.NewConflict(groupResourceFor(c, obj), obj.GetName(), fmt.Errorf("Precondition failed: %s", reason))
+}
+
+// groupResourceFor returns the resource of obj, or an empty one if its kind is unknown.
+func groupResourceFor(c client.Client, obj client.Object) schema.GroupResource {
+	gvk, err := c.GroupVersionKindFor(obj)
+	if err != nil {
+		return schema.GroupResource{}
+	}
+	mapping, err := c.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		plural, _ := meta.UnsafeGuessKindToResource(gvk)
+
+		return plural.GroupResource()
+	}
+
+	return mapping.Resource.GroupResource()
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("preconditions", func() {
+	var (
+		ctx = context.Background()
+		c   client.Client
+		obj *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		obj = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns", Generation: 2}}
+		c = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(obj).Build()
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
+	})
+
+	exists := func() bool {
+		err := c.Get(ctx, client.ObjectKeyFromObject(obj), &corev1.ConfigMap{})
+		if apierrors.IsNotFound(err) {
+			return false
+		}
+		Expect(err).ToNot(HaveOccurred())
+
+		return true
+	}
+
+	It("should return the preconditions of an object", func() {
+		p := PreconditionsFor(obj)
+		Expect(*p.UID).To(Equal(obj.UID))
+		Expect(*p.ResourceVersion).To(Equal(obj.ResourceVersion))
+		Expect(*p.Generation).To(Equal(int64(2)))
+	})
+
+	It("should delete objects fulfilling the preconditions", func() {
+		Expect(Delete(ctx, c, obj, PreconditionsFor(obj))).To(Succeed())
+		Expect(exists()).To(BeFalse())
+	})
+
+	It("should not delete objects with another generation", func() {
+		err := Delete(ctx, c, obj, Preconditions{Generation: ptr.To[int64](1)})
+		Expect(apierrors.IsConflict(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("Generation in precondition: 1"))
+		Expect(err.Error()).To(ContainSubstring(`configmaps "test"`))
+		Expect(exists()).To(BeTrue())
+	})
+
+	It("should not delete objects with another resourceVersion", func() {
+		err := Delete(ctx, c, obj, Preconditions{ResourceVersion: ptr.To("1"), Generation: ptr.To[int64](2)})
+		Expect(apierrors.IsConflict(err)).To(BeTrue())
+		Expect(exists()).To(BeTrue())
+	})
+
+	It("should update objects fulfilling the preconditions", func() {
+		p := PreconditionsFor(obj)
+		obj.Data = map[string]string{"key": "value"}
+		Expect(Update(ctx, c, obj, p)).To(Succeed())
+	})
+
+	It("should not update stale objects", func() {
+		p := PreconditionsFor(obj)
+		obj.Data = map[string]string{"key": "value"}
+		Expect(c.Update(ctx, obj.DeepCopy())).To(Succeed())
+
+		Expect(apierrors.IsConflict(Update(ctx, c, obj, p))).To(BeTrue())
+		p.Generation = nil
+		Expect(apierrors.IsConflict(Update(ctx, c, obj, p))).To(BeTrue())
+	})
+})
@@ -0,0 +1,115 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/component-base/cli"
+
+	"go.opendefense.cloud/kit/bench"
+)
+
+func main() {
+	os.Exit(cli.Run(newCommand()))
+}
+
+func newCommand() *cobra.Command {
+	var (
+		kubeconfig    string
+		resources     []string
+		ops           string
+		stages        string
+		namespace     string
+		payloadPath   string
+		payloadSize   int
+		watchDuration time.Duration
+		cleanup       bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "kit-bench",
+		Short: "Generate CRUD and watch load against a kit API server",
+		Long: `kit-bench generates configurable CRUD and watch load against any API server
+built with the kit and reports request latency percentiles per resource and operation.`,
+		Example: `  kit-bench --resource bars.v1alpha1.foo.opendefense.cloud=3 \
+    --resource clusterbars.v1alpha1.foo.opendefense.cloud=1 \
+    --stages 10:30s,50:1m --payload-field spec.message --payload-size 1024`,
+		RunE: func(c *cobra.Command, _ []string) error {
+			config := bench.Config{
+				Namespace:     namespace,
+				PayloadField:  payloadPath,
+				PayloadSize:   payloadSize,
+				WatchDuration: watchDuration,
+				Cleanup:       cleanup,
+				OpMix:         bench.DefaultOpMix,
+			}
+			for _, r := range resources {
+				t, err := bench.ParseTarget(r)
+				if err != nil {
+					return err
+				}
+				config.Targets = append(config.Targets, t)
+			}
+			if ops != "" {
+				mix, err := bench.ParseOpMix(ops)
+				if err != nil {
+					return err
+				}
+				config.OpMix = mix
+			}
+			parsedStages, err := bench.ParseStages(stages)
+			if err != nil {
+				return err
+			}
+			config.Stages = parsedStages
+
+			loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+			loadingRules.ExplicitPath = kubeconfig
+			restConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{}).ClientConfig()
+			if err != nil {
+				return err
+			}
+			// The benchmark should be limited by the server, not by client side throttling.
+			restConfig.QPS = -1
+
+			runner, err := bench.NewRunner(restConfig, config)
+			if err != nil {
+				return err
+			}
+
+			start := time.Now()
+			recorder, runErr := runner.Run(c.Context())
+			if recorder != nil {
+				if err := recorder.WriteReport(c.OutOrStdout(), time.Since(start)); err != nil {
+					return err
+				}
+			}
+			if runErr != nil {
+				return fmt.Errorf("benchmark aborted: %w", runErr)
+			}
+
+			return nil
+		},
+	}
+	cmd.SetContext(genericapiserver.SetupSignalContext())
+
+	flags := cmd.Flags()
+	flags.StringVar(&kubeconfig, "kubeconfig", "", "Path to the kubeconfig file. Defaults to the standard loading rules.")
+	flags.StringArrayVar(&resources, "resource", nil, "Resource to generate load against as resource.version.group[=weight]. May be repeated.")
+	flags.StringVar(&ops, "ops", "", "Operation mix as op=weight pairs, e.g. create=1,get=4,list=2,update=2,delete=1,watch=1.")
+	flags.StringVar(&stages, "stages", "10:30s", "Concurrency ramp as concurrency:duration pairs, e.g. 10:30s,50:1m.")
+	flags.StringVar(&namespace, "namespace", "default", "Namespace used for namespaced resources.")
+	flags.StringVar(&payloadPath, "payload-field", "", "Dot separated path of a string field filled with payload, e.g. spec.message.")
+	flags.IntVar(&payloadSize, "payload-size", 0, "Size of the payload in bytes.")
+	flags.DurationVar(&watchDuration, "watch-duration", bench.DefaultWatchDuration, "Duration each watch reads events before it is closed.")
+	flags.BoolVar(&cleanup, "cleanup", true, "Delete objects created during the run when it finishes.")
+
+	return cmd
+}
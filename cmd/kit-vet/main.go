@@ -0,0 +1,48 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/component-base/cli"
+
+	"go.opendefense.cloud/kit/apiserver/conventions"
+)
+
+func main() {
+	os.Exit(cli.Run(newCommand()))
+}
+
+func newCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "kit-vet DIR...",
+		Short: "Check API types for violations of the Kubernetes API conventions",
+		Long: `kit-vet checks the API types in the given package directories for violations of the
+Kubernetes API conventions, e.g. fields without +optional or +required markers, before code is
+generated from them. It exits with a non-zero code if any violation is found.`,
+		Example: `  kit-vet ./api/foo/v1alpha1 ./api/foo/v1beta1`,
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(c *cobra.Command, dirs []string) error {
+			count := 0
+			for _, dir := range dirs {
+				violations, err := conventions.Check(dir)
+				if err != nil {
+					return err
+				}
+				for _, v := range violations {
+					fmt.Fprintln(c.OutOrStdout(), v)
+				}
+				count += len(violations)
+			}
+			if count > 0 {
+				return fmt.Errorf("found %d violations of the API conventions", count)
+			}
+
+			return nil
+		},
+	}
+}
@@ -6,6 +6,8 @@ package envtest
 import (
 	"errors"
 	"io"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/ironcore-dev/controller-utils/buildutils"
@@ -20,13 +22,15 @@ import (
 type ProcessArgs = utilapiserver.ProcessArgs
 
 type Environment struct {
-	cfg       *rest.Config
-	env       *envtest.Environment
-	ext       *utilsenvtest.EnvironmentExtensions
-	k8sClient client.Client
-	apiServer *utilapiserver.APIServer
-	mainPath  string
-	extraArgs ProcessArgs
+	cfg        *rest.Config
+	env        *envtest.Environment
+	ext        *utilsenvtest.EnvironmentExtensions
+	k8sClient  client.Client
+	apiServer  *utilapiserver.APIServer
+	mainPath   string
+	extraArgs  ProcessArgs
+	writer     io.Writer
+	kubeconfig string
 }
 
 func NewEnvironment(mainPath string, crdDirectoryPaths, apiServiceDirectoryPaths []string) (*Environment, error) {
@@ -60,17 +64,8 @@ func (e *Environment) Start(scheme *runtime.Scheme, writer io.Writer) (client.Cl
 		return nil, errors.Join(err, e.Stop())
 	}
 
-	apiServer, err := utilapiserver.New(cfg, utilapiserver.Options{
-		MainPath:     e.mainPath,
-		Args:         e.extraArgs,
-		BuildOptions: []buildutils.BuildOption{buildutils.ModModeMod},
-		ETCDServers:  []string{e.env.ControlPlane.Etcd.URL.String()},
-		Host:         e.ext.APIServiceInstallOptions.LocalServingHost,
-		Port:         e.ext.APIServiceInstallOptions.LocalServingPort,
-		CertDir:      e.ext.APIServiceInstallOptions.LocalServingCertDir,
-		Stdout:       writer,
-		Stderr:       writer,
-	})
+	e.writer = writer
+	apiServer, err := e.newAPIServer(cfg, e.mainPath)
 	if err != nil {
 		return nil, errors.Join(err, e.Stop())
 	}
@@ -86,6 +81,22 @@ func (e *Environment) Start(scheme *runtime.Scheme, writer io.Writer) (client.Cl
 	return k8sClient, nil
 }
 
+// newAPIServer returns the API server built from the main package at mainPath, serving from the
+// etcd of the environment.
+func (e *Environment) newAPIServer(cfg *rest.Config, mainPath string) (*utilapiserver.APIServer, error) {
+	return utilapiserver.New(cfg, utilapiserver.Options{
+		MainPath:     mainPath,
+		Args:         e.extraArgs,
+		BuildOptions: []buildutils.BuildOption{buildutils.ModModeMod},
+		ETCDServers:  []string{e.env.ControlPlane.Etcd.URL.String()},
+		Host:         e.ext.APIServiceInstallOptions.LocalServingHost,
+		Port:         e.ext.APIServiceInstallOptions.LocalServingPort,
+		CertDir:      e.ext.APIServiceInstallOptions.LocalServingCertDir,
+		Stdout:       e.writer,
+		Stderr:       e.writer,
+	})
+}
+
 func (e *Environment) Stop() error {
 	var err error
 	if e.apiServer != nil {
@@ -94,6 +105,10 @@ func (e *Environment) Stop() error {
 	if e.ext != nil {
 		err = errors.Join(err, utilsenvtest.StopWithExtensions(e.env, e.ext))
 	}
+	if e.kubeconfig != "" {
+		err = errors.Join(err, os.RemoveAll(filepath.Dir(e.kubeconfig)))
+		e.kubeconfig = ""
+	}
 
 	return err
 }
@@ -0,0 +1,109 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package envtest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const envKubebuilderAssets = "KUBEBUILDER_ASSETS"
+
+// Kubectl runs the kubectl binary shipped with the envtest assets against the test environment.
+type Kubectl struct {
+	// Path is the path of the kubectl binary.
+	Path string
+	// Kubeconfig is the path of a kubeconfig pointing at the test environment.
+	Kubeconfig string
+	// Env holds additional environment variables, e.g. KUBE_EDITOR for kubectl edit.
+	Env []string
+}
+
+// Kubectl returns a Kubectl configured for the running test environment.
+// The kubeconfig is written on first use and removed when the environment stops.
+func (e *Environment) Kubectl() (*Kubectl, error) {
+	if e.cfg == nil {
+		return nil, fmt.Errorf("test environment is not started")
+	}
+	path, err := e.kubectlPath()
+	if err != nil {
+		return nil, err
+	}
+	if e.kubeconfig == "" {
+		if e.kubeconfig, err = e.writeKubeconfig(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Kubectl{Path: path, Kubeconfig: e.kubeconfig}, nil
+}
+
+func (e *Environment) kubectlPath() (string, error) {
+	for _, dir := range []string{e.env.BinaryAssetsDirectory, os.Getenv(envKubebuilderAssets)} {
+		if dir == "" {
+			continue
+		}
+		path := filepath.Join(dir, "kubectl")
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+
+	return exec.LookPath("kubectl")
+}
+
+func (e *Environment) writeKubeconfig() (string, error) {
+	dir, err := os.MkdirTemp("", "envtest-kubectl-")
+	if err != nil {
+		return "", err
+	}
+	config := clientcmdapi.NewConfig()
+	config.Clusters["envtest"] = &clientcmdapi.Cluster{
+		Server:                   e.cfg.Host,
+		CertificateAuthorityData: e.cfg.CAData,
+	}
+	config.AuthInfos["envtest"] = &clientcmdapi.AuthInfo{
+		ClientCertificateData: e.cfg.CertData,
+		ClientKeyData:         e.cfg.KeyData,
+		Token:                 e.cfg.BearerToken,
+	}
+	config.Contexts["envtest"] = &clientcmdapi.Context{Cluster: "envtest", AuthInfo: "envtest"}
+	config.CurrentContext = "envtest"
+
+	path := filepath.Join(dir, "kubeconfig")
+	if err := clientcmd.WriteToFile(*config, path); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// Run executes kubectl with the given arguments and returns its stdout and stderr.
+func (k *Kubectl) Run(ctx context.Context, args ...string) (string, string, error) {
+	return k.RunWithStdin(ctx, nil, args...)
+}
+
+// RunWithStdin executes kubectl with the given arguments, feeding stdin to the process.
+func (k *Kubectl) RunWithStdin(ctx context.Context, stdin io.Reader, args ...string) (string, string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, k.Path, append([]string{"--kubeconfig", k.Kubeconfig}, args...)...)
+	cmd.Stdin = stdin
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.Env = append(os.Environ(), k.Env...)
+	err := cmd.Run()
+	if err != nil {
+		err = fmt.Errorf("kubectl %v: %w: %s", args, err, stderr.String())
+	}
+
+	return stdout.String(), stderr.String(), err
+}
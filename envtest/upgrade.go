@@ -0,0 +1,36 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package envtest
+
+import (
+	"errors"
+	"fmt"
+)
+
+// UpgradeAPIServer stops the API server under test and starts the one built from the main
+// package at mainPath against the same etcd, e.g. to verify that objects written by the previous
+// release of a server can still be read, updated and converted by the next one. The environment is
+// typically started with the main package of the previous release, e.g. a copy kept in testdata,
+// and upgraded to the current one. Clients reconnect once the upgraded API server is ready, see
+// WaitUntilReadyWithTimeout. Flags changed between the releases are set with
+// SetAPIServerExtraArgs before the upgrade.
+func (e *Environment) UpgradeAPIServer(mainPath string) error {
+	if e.apiServer == nil {
+		return fmt.Errorf("test environment is not started")
+	}
+	apiServer, err := e.newAPIServer(e.cfg, mainPath)
+	if err != nil {
+		return err
+	}
+	if err := e.apiServer.Stop(); err != nil {
+		return fmt.Errorf("stopping the API server: %w", err)
+	}
+	if err := apiServer.Start(); err != nil {
+		return errors.Join(fmt.Errorf("starting the upgraded API server from %s: %w", mainPath, err), apiServer.Stop())
+	}
+	e.apiServer = apiServer
+	e.mainPath = mainPath
+
+	return nil
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package envtest
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	watchtools "k8s.io/client-go/tools/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// RestartAPIServer stops and starts the API server under test, which interrupts all watches
+// served by it. Clients watching through the kube-apiserver reconnect once the API server is
+// ready again, see WaitUntilReadyWithTimeout. Watch cache sizes are configured for the next
+// start with SetAPIServerExtraArgs, e.g. --default-watch-cache-size.
+func (e *Environment) RestartAPIServer() error {
+	if e.apiServer == nil {
+		return fmt.Errorf("test environment is not started")
+	}
+	if err := e.apiServer.Stop(); err != nil {
+		return fmt.Errorf("stopping the API server: %w", err)
+	}
+	if err := e.apiServer.Start(); err != nil {
+		return fmt.Errorf("starting the API server: %w", err)
+	}
+
+	return nil
+}
+
+// watcherFunc implements cache.WatcherWithContext.
+type watcherFunc func(ctx context.Context, options metav1.ListOptions) (watch.Interface, error)
+
+func (f watcherFunc) WatchWithContext(ctx context.Context, options metav1.ListOptions) (watch.Interface, error) {
+	return f(ctx, options)
+}
+
+// WatchRecorder records the events of a watch which resumes from the resourceVersion of the last
+// received event whenever it is interrupted, as informers do. Comparing the recorded
+// resourceVersions with those of the writes made by a test shows whether events were missed.
+type WatchRecorder struct {
+	watcher *watchtools.RetryWatcher
+
+	mu     sync.Mutex
+	events []watch.Event
+}
+
+// RecordWatch watches the objects of the type of list from resourceVersion on, which is
+// typically the resourceVersion of a list made before the first write of the test. The recorder
+// is stopped once ctx is done or Stop is called.
+func RecordWatch(ctx context.Context, c client.WithWatch, list client.ObjectList, resourceVersion string, opts ...client.ListOption) (*WatchRecorder, error) {
+	w := watcherFunc(func(ctx context.Context, options metav1.ListOptions) (watch.Interface, error) {
+		listOpts := append(slices.Clone(opts), &client.ListOptions{Raw: &options})
+
+		return c.Watch(ctx, list.DeepCopyObject().(client.ObjectList), listOpts...)
+	})
+	watcher, err := watchtools.NewRetryWatcherWithContext(ctx, resourceVersion, w)
+	if err != nil {
+		return nil, err
+	}
+	r := &WatchRecorder{watcher: watcher}
+	go func() {
+		for event := range watcher.ResultChan() {
+			r.mu.Lock()
+			r.events = append(r.events, event)
+			r.mu.Unlock()
+		}
+	}()
+
+	return r, nil
+}
+
+// Stop stops the watch.
+func (r *WatchRecorder) Stop() {
+	r.watcher.Stop()
+	<-r.watcher.Done()
+}
+
+// Events returns the events received so far.
+func (r *WatchRecorder) Events() []watch.Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return slices.Clone(r.events)
+}
+
+// ResourceVersions returns the resourceVersions of the objects of the events received so far.
+func (r *WatchRecorder) ResourceVersions() []string {
+	events := r.Events()
+	rvs := make([]string, 0, len(events))
+	for _, event := range events {
+		if m, err := meta.Accessor(event.Object); err == nil && event.Type != watch.Error {
+			rvs = append(rvs, m.GetResourceVersion())
+		}
+	}
+
+	return rvs
+}
+
+// Missing returns the given resourceVersions, e.g. of the objects returned by writes, which have
+// not been received yet.
+func (r *WatchRecorder) Missing(resourceVersions ...string) []string {
+	received := r.ResourceVersions()
+	var missing []string
+	for _, rv := range resourceVersions {
+		if !slices.Contains(received, rv) {
+			missing = append(missing, rv)
+		}
+	}
+
+	return missing
+}
+
+// Err returns an error if an error event has been received or events have been received more
+// than once or out of order, which indicates that the watch resumed at a wrong resourceVersion.
+// The resourceVersions are compared as the revisions of etcd they are in this environment.
+func (r *WatchRecorder) Err() error {
+	last := uint64(0)
+	for _, event := range r.Events() {
+		if event.Type == watch.Error {
+			return fmt.Errorf("received an error event: %v", event.Object)
+		}
+		m, err := meta.Accessor(event.Object)
+		if err != nil {
+			return err
+		}
+		rv, err := strconv.ParseUint(m.GetResourceVersion(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid resourceVersion %q: %w", m.GetResourceVersion(), err)
+		}
+		if rv <= last {
+			return fmt.Errorf("received resourceVersion %d after %d", rv, last)
+		}
+		last = rv
+	}
+
+	return nil
+}
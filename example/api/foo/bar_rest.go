@@ -4,14 +4,25 @@
 package foo
 
 import (
+	"context"
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
 
 	"go.opendefense.cloud/kit/apiserver/resource"
+	"go.opendefense.cloud/kit/apiserver/rest"
 )
 
-var _ resource.Object = &Bar{}
+var (
+	_ resource.Object                      = &Bar{}
+	_ resource.ObjectWithStatusSubResource = &Bar{}
+	_ rest.TableConverter                  = &Bar{}
+	_ rest.ShortNamesProvider              = &Bar{}
+	_ rest.CategoriesProvider              = &Bar{}
+)
 
 func (o *Bar) GetObjectMeta() *metav1.ObjectMeta {
 	return &o.ObjectMeta
@@ -33,6 +44,40 @@ func (o *Bar) GetGroupResource() schema.GroupResource {
 	return SchemeGroupVersion.WithResource("bars").GroupResource()
 }
 
+// CopyStatusTo serves the status subresource of Bars, updates of Bars keep their status and
+// updates of their status keep everything else.
+func (o *Bar) CopyStatusTo(obj runtime.Object) {
+	if bar, ok := obj.(*Bar); ok {
+		o.Status.DeepCopyInto(&bar.Status)
+	}
+}
+
+// ConvertToTable renders Bars with their message and phase, e.g. for kubectl get.
+func (o *Bar) ConvertToTable(ctx context.Context, tableOptions runtime.Object) (*metav1.Table, error) {
+	return &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+			{Name: "Message", Type: "string", Description: "The message of the Bar."},
+			{Name: "Phase", Type: "string", Description: "The lifecycle phase of the Bar."},
+			{Name: "Age", Type: "date", Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]},
+		},
+		Rows: []metav1.TableRow{{
+			Cells:  []any{o.Name, o.Spec.Message, string(o.Status.Phase), duration.HumanDuration(time.Since(o.CreationTimestamp.Time))},
+			Object: runtime.RawExtension{Object: o},
+		}},
+	}, nil
+}
+
+// ShortNames lets kubectl resolve "br" to Bars.
+func (o *Bar) ShortNames() []string {
+	return []string{"br"}
+}
+
+// Categories adds Bars to "kubectl get all".
+func (o *Bar) Categories() []string {
+	return []string{"all"}
+}
+
 var _ resource.Object = &ClusterBar{}
 
 func (o *ClusterBar) GetObjectMeta() *metav1.ObjectMeta {
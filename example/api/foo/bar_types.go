@@ -11,7 +11,31 @@ type BarSpec struct {
 	Message string `json:"message"`
 }
 
+// BarPhase is the lifecycle phase of a Bar.
+type BarPhase string
+
+const (
+	// BarPhasePending is the phase of Bars which have not been processed yet.
+	BarPhasePending BarPhase = "Pending"
+	// BarPhaseReady is the phase of Bars whose message has been delivered.
+	BarPhaseReady BarPhase = "Ready"
+)
+
+// BarConditionReady is the type of the condition reporting whether the message of a Bar has
+// been delivered.
+const BarConditionReady = "Ready"
+
+// BarStatus is the observed state of a Bar. It is written through the status subresource,
+// updates of the Bar itself keep it unchanged.
 type BarStatus struct {
+	// Phase is the lifecycle phase of the Bar.
+	// +optional
+	Phase BarPhase `json:"phase,omitempty"`
+	// Conditions are the latest observations of the state of the Bar.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +genclient
@@ -8,10 +8,36 @@ import (
 )
 
 type BarSpec struct {
+	// Message is the message delivered by the Bar.
+	// +required
 	Message string `json:"message"`
 }
 
+// BarPhase is the lifecycle phase of a Bar.
+type BarPhase string
+
+const (
+	// BarPhasePending is the phase of Bars which have not been processed yet.
+	BarPhasePending BarPhase = "Pending"
+	// BarPhaseReady is the phase of Bars whose message has been delivered.
+	BarPhaseReady BarPhase = "Ready"
+)
+
+// BarConditionReady is the type of the condition reporting whether the message of a Bar has
+// been delivered.
+const BarConditionReady = "Ready"
+
+// BarStatus is the observed state of a Bar. It is written through the status subresource,
+// updates of the Bar itself keep it unchanged.
 type BarStatus struct {
+	// Phase is the lifecycle phase of the Bar.
+	// +optional
+	Phase BarPhase `json:"phase,omitempty"`
+	// Conditions are the latest observations of the state of the Bar.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +genclient
@@ -22,7 +48,9 @@ type Bar struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
 
-	Spec   BarSpec   `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Spec BarSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
 	Status BarStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
 }
 
@@ -45,7 +73,9 @@ type ClusterBar struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
 
-	Spec   BarSpec   `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Spec BarSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
 	Status BarStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
 }
 
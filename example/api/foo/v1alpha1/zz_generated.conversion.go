@@ -9,6 +9,7 @@ import (
 	unsafe "unsafe"
 
 	foo "go.opendefense.cloud/kit/example/api/foo"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -158,6 +159,8 @@ func Convert_foo_BarSpec_To_v1alpha1_BarSpec(in *foo.BarSpec, out *BarSpec, s co
 }
 
 func autoConvert_v1alpha1_BarStatus_To_foo_BarStatus(in *BarStatus, out *foo.BarStatus, s conversion.Scope) error {
+	out.Phase = foo.BarPhase(in.Phase)
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
@@ -167,6 +170,8 @@ func Convert_v1alpha1_BarStatus_To_foo_BarStatus(in *BarStatus, out *foo.BarStat
 }
 
 func autoConvert_foo_BarStatus_To_v1alpha1_BarStatus(in *foo.BarStatus, out *BarStatus, s conversion.Scope) error {
+	out.Phase = BarPhase(in.Phase)
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
@@ -3,7 +3,6 @@
 package v1alpha1
 
 import (
-	foov1alpha1 "go.opendefense.cloud/kit/example/api/foo/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
@@ -16,8 +15,8 @@ import (
 type BarApplyConfiguration struct {
 	v1.TypeMetaApplyConfiguration    `json:",inline"`
 	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
-	Spec                             *BarSpecApplyConfiguration `json:"spec,omitempty"`
-	Status                           *foov1alpha1.BarStatus     `json:"status,omitempty"`
+	Spec                             *BarSpecApplyConfiguration   `json:"spec,omitempty"`
+	Status                           *BarStatusApplyConfiguration `json:"status,omitempty"`
 }
 
 // Bar constructs a declarative configuration of the Bar type for use with
@@ -202,8 +201,8 @@ func (b *BarApplyConfiguration) WithSpec(value *BarSpecApplyConfiguration) *BarA
 // WithStatus sets the Status field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Status field is set to the value of the last call.
-func (b *BarApplyConfiguration) WithStatus(value foov1alpha1.BarStatus) *BarApplyConfiguration {
-	b.Status = &value
+func (b *BarApplyConfiguration) WithStatus(value *BarStatusApplyConfiguration) *BarApplyConfiguration {
+	b.Status = value
 	return b
 }
 
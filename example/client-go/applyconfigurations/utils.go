@@ -20,6 +20,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &foov1alpha1.BarApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("BarSpec"):
 		return &foov1alpha1.BarSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("BarStatus"):
+		return &foov1alpha1.BarStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ClusterBar"):
 		return &foov1alpha1.ClusterBarApplyConfiguration{}
 
@@ -0,0 +1,12 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package openapi
+
+import (
+	"go.opendefense.cloud/kit/apiserver/kitapi"
+)
+
+func init() {
+	kitapi.RegisterOpenAPIDefinitions(GetOpenAPIDefinitions)
+}
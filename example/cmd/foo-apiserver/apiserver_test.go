@@ -4,9 +4,17 @@
 package main_test
 
 import (
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	kitclient "go.opendefense.cloud/kit/client"
 	"go.opendefense.cloud/kit/envtest"
 	"go.opendefense.cloud/kit/example/api/foo/v1alpha1"
 
@@ -65,3 +73,171 @@ var _ = Describe("Bar", func() {
 		})
 	})
 })
+
+var _ = Describe("Preconditions", func() {
+	var (
+		ctx = envtest.Context()
+		ns  = SetupTest(ctx)
+	)
+
+	newBar := func() *v1alpha1.Bar {
+		bar := &v1alpha1.Bar{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-",
+			},
+		}
+		Expect(k8sClient.Create(ctx, bar)).To(Succeed())
+
+		return bar
+	}
+
+	It("should not delete a bar with another UID", func() {
+		bar := newBar()
+		p := kitclient.PreconditionsFor(bar)
+		p.UID = ptr.To(types.UID("other"))
+		Expect(apierrors.IsConflict(kitclient.Delete(ctx, k8sClient, bar, p))).To(BeTrue())
+
+		Expect(kitclient.Delete(ctx, k8sClient, bar, kitclient.PreconditionsFor(bar))).To(Succeed())
+	})
+
+	It("should not write a stale bar", func() {
+		bar := newBar()
+		p := kitclient.PreconditionsFor(bar)
+		changed := bar.DeepCopy()
+		changed.Spec.Message = "changed"
+		Expect(k8sClient.Update(ctx, changed)).To(Succeed())
+
+		bar.Spec.Message = "stale"
+		Expect(apierrors.IsConflict(kitclient.Update(ctx, k8sClient, bar, p))).To(BeTrue())
+		Expect(apierrors.IsConflict(kitclient.Delete(ctx, k8sClient, bar, p))).To(BeTrue())
+		p.Generation = nil
+		Expect(apierrors.IsConflict(kitclient.Delete(ctx, k8sClient, bar, p))).To(BeTrue())
+
+		Expect(kitclient.Delete(ctx, k8sClient, bar, kitclient.PreconditionsFor(changed))).To(Succeed())
+	})
+})
+
+var _ = Describe("Status", func() {
+	var (
+		ctx = envtest.Context()
+		ns  = SetupTest(ctx)
+		bar *v1alpha1.Bar
+	)
+
+	BeforeEach(func() {
+		bar = &v1alpha1.Bar{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns.Name, GenerateName: "test-"},
+			Spec:       v1alpha1.BarSpec{Message: "hello"},
+		}
+		Expect(k8sClient.Create(ctx, bar)).To(Succeed())
+	})
+
+	It("should update the status through the status subresource", func() {
+		bar.Status.Phase = v1alpha1.BarPhaseReady
+		meta.SetStatusCondition(&bar.Status.Conditions, metav1.Condition{
+			Type:    v1alpha1.BarConditionReady,
+			Status:  metav1.ConditionTrue,
+			Reason:  "Delivered",
+			Message: "the message has been delivered",
+		})
+		Expect(k8sClient.Status().Update(ctx, bar)).To(Succeed())
+
+		stored := &v1alpha1.Bar{}
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(bar), stored)).To(Succeed())
+		Expect(stored.Status.Phase).To(Equal(v1alpha1.BarPhaseReady))
+		Expect(meta.IsStatusConditionTrue(stored.Status.Conditions, v1alpha1.BarConditionReady)).To(BeTrue())
+	})
+
+	It("should keep the spec on updates of the status", func() {
+		bar.Spec.Message = "changed"
+		bar.Status.Phase = v1alpha1.BarPhasePending
+		Expect(k8sClient.Status().Update(ctx, bar)).To(Succeed())
+		Expect(bar.Spec.Message).To(Equal("hello"))
+		Expect(bar.Status.Phase).To(Equal(v1alpha1.BarPhasePending))
+	})
+
+	It("should keep the status on updates of the bar", func() {
+		bar.Spec.Message = "changed"
+		bar.Status.Phase = v1alpha1.BarPhaseReady
+		Expect(k8sClient.Update(ctx, bar)).To(Succeed())
+		Expect(bar.Spec.Message).To(Equal("changed"))
+		Expect(bar.Status.Phase).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Pagination", func() {
+	var (
+		ctx = envtest.Context()
+		ns  = SetupTest(ctx)
+	)
+
+	BeforeEach(func() {
+		for range 3 {
+			Expect(k8sClient.Create(ctx, &v1alpha1.Bar{
+				ObjectMeta: metav1.ObjectMeta{Namespace: ns.Name, GenerateName: "test-"},
+			})).To(Succeed())
+		}
+	})
+
+	It("should continue lists after the limit", func() {
+		list := &v1alpha1.BarList{}
+		Expect(k8sClient.List(ctx, list, client.InNamespace(ns.Name), client.Limit(2))).To(Succeed())
+		Expect(list.Items).To(HaveLen(2))
+		Expect(list.Continue).NotTo(BeEmpty())
+		Expect(list.RemainingItemCount).To(Equal(ptr.To[int64](1)))
+
+		rest := &v1alpha1.BarList{}
+		Expect(k8sClient.List(ctx, rest, client.InNamespace(ns.Name), client.Limit(2), client.Continue(list.Continue))).To(Succeed())
+		Expect(rest.Items).To(HaveLen(1))
+		Expect(rest.Continue).To(BeEmpty())
+	})
+
+	It("should list all bars without a limit", func() {
+		list := &v1alpha1.BarList{}
+		Expect(k8sClient.List(ctx, list, client.InNamespace(ns.Name))).To(Succeed())
+		Expect(list.Items).To(HaveLen(3))
+		Expect(list.Continue).To(BeEmpty())
+	})
+
+	It("should reject invalid continue tokens", func() {
+		list := &v1alpha1.BarList{}
+		err := k8sClient.List(ctx, list, client.InNamespace(ns.Name), client.Limit(2), client.Continue("invalid"))
+		Expect(apierrors.IsBadRequest(err)).To(BeTrue())
+	})
+})
+
+var _ = Describe("Watch", Serial, func() {
+	var (
+		ctx = envtest.Context()
+		ns  = SetupTest(ctx)
+	)
+
+	It("should resume watches interrupted by a restart without missing events", func() {
+		c, err := client.NewWithWatch(testEnv.GetRESTConfig(), client.Options{Scheme: scheme.Scheme})
+		Expect(err).NotTo(HaveOccurred())
+		list := &v1alpha1.BarList{}
+		Expect(c.List(ctx, list, client.InNamespace(ns.Name))).To(Succeed())
+		recorder, err := envtest.RecordWatch(ctx, c, &v1alpha1.BarList{}, list.ResourceVersion, client.InNamespace(ns.Name))
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(recorder.Stop)
+
+		var written []string
+		create := func() {
+			bar := &v1alpha1.Bar{ObjectMeta: metav1.ObjectMeta{Namespace: ns.Name, GenerateName: "test-"}}
+			Expect(c.Create(ctx, bar)).To(Succeed())
+			written = append(written, bar.ResourceVersion)
+		}
+		create()
+		Eventually(func() []string { return recorder.Missing(written...) }).Should(BeEmpty())
+
+		By("restarting the API server")
+		Expect(testEnv.RestartAPIServer()).To(Succeed())
+		Expect(testEnv.WaitUntilReadyWithTimeout(apiServiceTimeout)).To(Succeed())
+		create()
+		create()
+
+		Eventually(func() []string { return recorder.Missing(written...) }).WithTimeout(time.Minute).Should(BeEmpty())
+		Expect(recorder.Err()).To(Succeed())
+	})
+})
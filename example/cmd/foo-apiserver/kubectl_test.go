@@ -0,0 +1,128 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"go.opendefense.cloud/kit/envtest"
+	"go.opendefense.cloud/kit/example/api/foo/v1alpha1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("kubectl", Ordered, func() {
+	var (
+		ctx     = envtest.Context()
+		ns      = &corev1.Namespace{}
+		kubectl *envtest.Kubectl
+	)
+
+	BeforeAll(func() {
+		var err error
+		kubectl, err = testEnv.Kubectl()
+		Expect(err).NotTo(HaveOccurred())
+
+		// All specs share one namespace, as they build upon each other.
+		*ns = corev1.Namespace{ObjectMeta: metav1.ObjectMeta{GenerateName: "testns-"}}
+		Expect(k8sClient.Create(ctx, ns)).To(Succeed(), "failed to create test namespace")
+		DeferCleanup(k8sClient.Delete, ctx, ns)
+	})
+
+	run := func(args ...string) string {
+		GinkgoHelper()
+		stdout, _, err := kubectl.Run(ctx, args...)
+		Expect(err).NotTo(HaveOccurred())
+		return stdout
+	}
+
+	apply := func(manifest string) string {
+		GinkgoHelper()
+		stdout, _, err := kubectl.RunWithStdin(ctx, strings.NewReader(manifest), "apply", "-n", ns.Name, "-f", "-")
+		Expect(err).NotTo(HaveOccurred())
+		return stdout
+	}
+
+	barManifest := func(message string) string {
+		return fmt.Sprintf(`apiVersion: %s
+kind: Bar
+metadata:
+  name: kubectl-bar
+spec:
+  message: %s
+`, v1alpha1.SchemeGroupVersion, message)
+	}
+
+	It("should discover the served resources", func() {
+		out := run("api-resources", "--api-group", v1alpha1.GroupName, "--no-headers")
+		Expect(out).To(MatchRegexp(`(?m)^bars\s+br\s+%s\s+true\s+Bar$`, v1alpha1.SchemeGroupVersion))
+		Expect(out).To(MatchRegexp(`(?m)^clusterbars\s+.*%s\s+false\s+ClusterBar$`, v1alpha1.SchemeGroupVersion))
+	})
+
+	It("should explain the resource from the OpenAPI document", func() {
+		out := run("explain", "bars.spec")
+		Expect(out).To(ContainSubstring("message"))
+	})
+
+	It("should create a bar with apply", func() {
+		Expect(apply(barManifest("hello"))).To(ContainSubstring("bar.foo.opendefense.cloud/kubectl-bar created"))
+	})
+
+	It("should render bars as a table", func() {
+		out := run("get", "bars", "-n", ns.Name)
+		lines := strings.Split(strings.TrimSpace(out), "\n")
+		Expect(lines).To(HaveLen(2))
+		Expect(lines[0]).To(MatchRegexp(`^NAME\s+MESSAGE\s+PHASE\s+AGE$`))
+		Expect(lines[1]).To(MatchRegexp(`^kubectl-bar\s+hello\s+`))
+	})
+
+	It("should get bars by their short name", func() {
+		Expect(run("get", "br", "-n", ns.Name, "-o", "name")).To(Equal("bar.foo.opendefense.cloud/kubectl-bar\n"))
+	})
+
+	It("should get bars in the all category", func() {
+		Expect(run("get", "all", "-n", ns.Name, "-o", "name")).To(ContainSubstring("bar.foo.opendefense.cloud/kubectl-bar"))
+	})
+
+	It("should return the bar as json", func() {
+		out := run("get", "bar", "kubectl-bar", "-n", ns.Name, "-o", "json")
+		bar := &v1alpha1.Bar{}
+		Expect(json.Unmarshal([]byte(out), bar)).To(Succeed())
+		Expect(bar.Spec.Message).To(Equal("hello"))
+	})
+
+	It("should describe the bar", func() {
+		out := run("describe", "bar", "kubectl-bar", "-n", ns.Name)
+		Expect(out).To(MatchRegexp(`(?m)^Name:\s+kubectl-bar$`))
+		Expect(out).To(MatchRegexp(`(?m)^Namespace:\s+%s$`, ns.Name))
+		Expect(out).To(ContainSubstring("hello"))
+	})
+
+	It("should re-apply an unchanged bar without changes", func() {
+		Expect(apply(barManifest("hello"))).To(ContainSubstring("unchanged"))
+	})
+
+	It("should patch the bar", func() {
+		run("patch", "bar", "kubectl-bar", "-n", ns.Name, "--type", "merge", "-p", `{"spec":{"message":"patched"}}`)
+		run("wait", "bar/kubectl-bar", "-n", ns.Name, "--for", "jsonpath={.spec.message}=patched", "--timeout", "10s")
+	})
+
+	It("should edit the bar", func() {
+		kubectl.Env = []string{"KUBE_EDITOR=sed -i s/patched/edited/"}
+		DeferCleanup(func() { kubectl.Env = nil })
+		run("edit", "bar", "kubectl-bar", "-n", ns.Name)
+		Expect(run("get", "bar", "kubectl-bar", "-n", ns.Name, "-o", "jsonpath={.spec.message}")).To(Equal("edited"))
+	})
+
+	It("should delete the bar and wait for its removal", func() {
+		Expect(run("delete", "bar", "kubectl-bar", "-n", ns.Name, "--wait")).To(ContainSubstring(`"kubectl-bar" deleted`))
+		run("wait", "bar/kubectl-bar", "-n", ns.Name, "--for", "delete", "--timeout", "10s")
+	})
+})
@@ -0,0 +1,125 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+)
+
+// tableAcceptHeader requests the resources as a Table converted by the server, with the
+// metadata of each object to print its namespace.
+const tableAcceptHeader = "application/json;as=Table;v=v1;g=meta.k8s.io"
+
+// resources maps the accepted resource names to the served resource and whether it is namespaced.
+var resources = map[string]struct {
+	name       string
+	namespaced bool
+}{
+	"bar":         {name: "bars", namespaced: true},
+	"bars":        {name: "bars", namespaced: true},
+	"clusterbar":  {name: "clusterbars"},
+	"clusterbars": {name: "clusterbars"},
+}
+
+type getOptions struct {
+	name          string
+	selector      string
+	allNamespaces bool
+	wide          bool
+}
+
+// get fetches the requested resources as a Table, so the columns are defined by the server
+// like for kubectl get.
+func (o *getOptions) get(ctx context.Context, client rest.Interface, resource, namespace string) (*table, error) {
+	r, ok := resources[strings.ToLower(resource)]
+	if !ok {
+		return nil, fmt.Errorf("the server doesn't have a resource type %q", resource)
+	}
+	req := client.Get().
+		NamespaceIfScoped(namespace, r.namespaced).
+		Resource(r.name).
+		SetHeader("Accept", tableAcceptHeader).
+		Param("includeObject", string(metav1.IncludeMetadata))
+	if o.name != "" {
+		req = req.Name(o.name)
+	} else if o.selector != "" {
+		req = req.Param("labelSelector", o.selector)
+	}
+	raw, err := req.DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t := &table{withNamespace: r.namespaced && o.allNamespaces, wide: o.wide}
+	if err := json.Unmarshal(raw, &t.Table); err != nil {
+		return nil, fmt.Errorf("decoding table: %w", err)
+	}
+
+	return t, nil
+}
+
+// table prints a Table in the column layout used by kubectl get. Columns with a priority
+// greater than zero are only printed when wide.
+type table struct {
+	metav1.Table
+	withNamespace bool
+	wide          bool
+}
+
+func (t *table) headers() []string {
+	headers := []string{}
+	if t.withNamespace {
+		headers = append(headers, "NAMESPACE")
+	}
+	for _, c := range t.ColumnDefinitions {
+		if t.wide || c.Priority == 0 {
+			headers = append(headers, strings.ToUpper(c.Name))
+		}
+	}
+
+	return headers
+}
+
+func (t *table) row(r metav1.TableRow) []string {
+	row := []string{}
+	if t.withNamespace {
+		m := metav1.PartialObjectMetadata{}
+		_ = json.Unmarshal(r.Object.Raw, &m)
+		row = append(row, m.Namespace)
+	}
+	for i, cell := range r.Cells {
+		if i >= len(t.ColumnDefinitions) || !t.wide && t.ColumnDefinitions[i].Priority > 0 {
+			continue
+		}
+		if cell == nil {
+			row = append(row, "<none>")
+			continue
+		}
+		row = append(row, fmt.Sprint(cell))
+	}
+
+	return row
+}
+
+// Print writes the table to w.
+func (t *table) Print(w io.Writer) error {
+	if len(t.Rows) == 0 {
+		_, err := fmt.Fprintln(w, "No resources found.")
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 8, 3, ' ', 0)
+	_, _ = fmt.Fprintln(tw, strings.Join(t.headers(), "\t"))
+	for _, r := range t.Rows {
+		_, _ = fmt.Fprintln(tw, strings.Join(t.row(r), "\t"))
+	}
+
+	return tw.Flush()
+}
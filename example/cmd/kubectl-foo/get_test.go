@@ -0,0 +1,109 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+
+	"go.opendefense.cloud/kit/example/client-go/clientset/versioned"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func tableRow(namespace, name, message string) metav1.TableRow {
+	m, _ := json.Marshal(metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}})
+
+	return metav1.TableRow{Cells: []any{name, "5m", message}, Object: runtime.RawExtension{Raw: m}}
+}
+
+var _ = Describe("get", func() {
+	var (
+		ctx     = context.Background()
+		request *http.Request
+		client  rest.Interface
+	)
+
+	BeforeEach(func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			request = r
+			t := metav1.Table{
+				ColumnDefinitions: []metav1.TableColumnDefinition{
+					{Name: "Name"}, {Name: "Age"}, {Name: "Message", Priority: 1},
+				},
+				Rows: []metav1.TableRow{tableRow("a", "one", "first"), tableRow("b", "two", "second")},
+			}
+			if r.URL.Query().Get("labelSelector") == "tier=none" {
+				t.Rows = nil
+			}
+			_ = json.NewEncoder(w).Encode(t)
+		}))
+		DeferCleanup(server.Close)
+
+		clientset, err := versioned.NewForConfig(&rest.Config{Host: server.URL})
+		Expect(err).NotTo(HaveOccurred())
+		client = clientset.FooV1alpha1().RESTClient()
+	})
+
+	printLines := func(t *table) []string {
+		GinkgoHelper()
+		buf := &bytes.Buffer{}
+		Expect(t.Print(buf)).To(Succeed())
+		return strings.Split(strings.TrimSpace(buf.String()), "\n")
+	}
+
+	It("should request bars of a namespace as a table", func() {
+		o := &getOptions{selector: "tier=gold"}
+		t, err := o.get(ctx, client, "bars", "a")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(request.URL.Path).To(Equal("/apis/foo.opendefense.cloud/v1alpha1/namespaces/a/bars"))
+		Expect(request.URL.Query()).To(Equal(url.Values{"labelSelector": {"tier=gold"}, "includeObject": {"Metadata"}}))
+		Expect(request.Header.Get("Accept")).To(Equal(tableAcceptHeader))
+
+		lines := printLines(t)
+		Expect(lines).To(HaveLen(3))
+		Expect(lines[0]).To(MatchRegexp(`^NAME\s+AGE$`))
+		Expect(lines[1]).To(MatchRegexp(`^one\s+5m$`))
+	})
+
+	It("should add the namespace column across all namespaces and columns with priority when wide", func() {
+		o := &getOptions{allNamespaces: true, wide: true}
+		t, err := o.get(ctx, client, "bars", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(request.URL.Path).To(Equal("/apis/foo.opendefense.cloud/v1alpha1/bars"))
+		lines := printLines(t)
+		Expect(lines[0]).To(MatchRegexp(`^NAMESPACE\s+NAME\s+AGE\s+MESSAGE$`))
+		Expect(lines[2]).To(MatchRegexp(`^b\s+two\s+5m\s+second$`))
+	})
+
+	It("should get a single cluster bar by name", func() {
+		o := &getOptions{name: "global"}
+		_, err := o.get(ctx, client, "clusterbar", "a")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(request.URL.Path).To(Equal("/apis/foo.opendefense.cloud/v1alpha1/clusterbars/global"))
+	})
+
+	It("should reject unknown resources", func() {
+		o := &getOptions{}
+		_, err := o.get(ctx, client, "foos", "")
+		Expect(err).To(MatchError(ContainSubstring(`resource type "foos"`)))
+	})
+
+	It("should report when no resources are found", func() {
+		o := &getOptions{selector: "tier=none"}
+		t, err := o.get(ctx, client, "bars", "a")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(printLines(t)).To(Equal([]string{"No resources found."}))
+	})
+})
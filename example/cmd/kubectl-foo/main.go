@@ -0,0 +1,82 @@
+// Copyright 2026 BWI GmbH and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// kubectl-foo is an example kubectl plugin for the foo API built on the
+// generated clientset. Install it into the PATH and call it as "kubectl foo".
+// Resources are printed with the columns of the Table converted by the server.
+package main
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/component-base/cli"
+
+	"go.opendefense.cloud/kit/example/client-go/clientset/versioned"
+)
+
+func main() {
+	os.Exit(cli.Run(newCommand()))
+}
+
+func newCommand() *cobra.Command {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	overrides := &clientcmd.ConfigOverrides{}
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, overrides)
+
+	cmd := &cobra.Command{
+		Use:   "kubectl-foo",
+		Short: "Interact with the foo.opendefense.cloud API",
+	}
+	flags := cmd.PersistentFlags()
+	flags.StringVar(&loadingRules.ExplicitPath, "kubeconfig", "", "Path to the kubeconfig file.")
+	clientcmd.BindOverrideFlags(overrides, flags, clientcmd.RecommendedConfigOverrideFlags(""))
+
+	cmd.AddCommand(newGetCommand(clientConfig))
+
+	return cmd
+}
+
+func newGetCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	opts := getOptions{}
+	cmd := &cobra.Command{
+		Use:       "get (bars | clusterbars) [NAME]",
+		Short:     "Display one or many resources",
+		Example:   "  kubectl foo get bars --wide\n  kubectl foo get clusterbars my-bar",
+		Args:      cobra.RangeArgs(1, 2),
+		ValidArgs: []string{"bars", "clusterbars"},
+		RunE: func(c *cobra.Command, args []string) error {
+			restConfig, err := clientConfig.ClientConfig()
+			if err != nil {
+				return err
+			}
+			namespace, _, err := clientConfig.Namespace()
+			if err != nil {
+				return err
+			}
+			clientset, err := versioned.NewForConfig(restConfig)
+			if err != nil {
+				return err
+			}
+			if len(args) == 2 {
+				opts.name = args[1]
+			}
+			if opts.allNamespaces {
+				namespace = ""
+			}
+
+			table, err := opts.get(c.Context(), clientset.FooV1alpha1().RESTClient(), args[0], namespace)
+			if err != nil {
+				return err
+			}
+
+			return table.Print(c.OutOrStdout())
+		},
+	}
+	cmd.Flags().BoolVarP(&opts.allNamespaces, "all-namespaces", "A", false, "List the requested resources across all namespaces.")
+	cmd.Flags().StringVarP(&opts.selector, "selector", "l", "", "Label selector to filter on.")
+	cmd.Flags().BoolVar(&opts.wide, "wide", false, "Print additional columns.")
+
+	return cmd
+}
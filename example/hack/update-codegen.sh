@@ -16,6 +16,8 @@ CODEGEN_PKG=$(go list -m -f '{{.Dir}}' k8s.io/code-generator)
 # shellcheck disable=SC1091 # we trust kube_codegen.sh
 source "${CODEGEN_PKG}/kube_codegen.sh"
 
+go run go.opendefense.cloud/kit/cmd/kit-vet "${PROJECT_DIR}/api/foo/v1alpha1"
+
 kube::codegen::gen_helpers \
     --boilerplate "${SCRIPT_DIR}/boilerplate.go.txt" \
     "${PROJECT_DIR}/api"